@@ -1,6 +1,9 @@
 // @title Landing Backend API
 // @version 1.0
 // @description OpenAPI documentation for Landing backend.
+// @description Errors are RFC 7807 problem documents (application/problem+json) with a stable `code`:
+// @description validation_failed (400, per-field problems in `errors`), invalid_body (400), unauthorized (401),
+// @description not_found (404), conflict (409), payload_too_large (413), unsupported_media_type (415), internal_error (500).
 // @BasePath /api
 //
 // @securityDefinitions.apikey ApiKeyAuth
@@ -11,7 +14,7 @@ package main
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
@@ -23,10 +26,16 @@ import (
 	"github.com/gofiber/fiber/v2"
 	_ "landing/backend/docs"
 
+	"landing/backend/internal/ai/embeddings"
+	"landing/backend/internal/apperr"
 	"landing/backend/internal/config"
 	"landing/backend/internal/db"
+	"landing/backend/internal/logging"
+	"landing/backend/internal/metrics"
 	"landing/backend/internal/middleware"
 	"landing/backend/internal/routes"
+	"landing/backend/internal/storage"
+	"landing/backend/internal/tracing"
 )
 
 func main() {
@@ -35,18 +44,44 @@ func main() {
 
 	cfg := config.Load()
 
+	// Structured logging (slog); the standard log package writes through it too.
+	logger := logging.Setup(cfg)
+
+	// OpenTelemetry tracer provider and W3C trace context propagation
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg)
+	if err != nil {
+		fatal("tracing initialization failed", err)
+	}
+
+	// Media uploads get a larger limit of their own (see middleware.Register).
+	bodyLimit := cfg.MaxBodyBytes
+	if bodyLimit <= 0 {
+		bodyLimit = fiber.DefaultBodyLimit
+	}
 	app := fiber.New(fiber.Config{
 		AppName:               cfg.AppName,
 		EnablePrintRoutes:     cfg.IsDevelopment(),
 		DisableStartupMessage: false,
+		BodyLimit:             bodyLimit,
+		// Errors are RFC 7807 problem documents (application/problem+json).
+		ErrorHandler: apperr.Handler,
 	})
 
+	// Media storage backend (local filesystem or S3-compatible)
+	store, err := storage.New(cfg)
+	if err != nil {
+		fatal("media storage initialization failed", err)
+	}
+
+	// In-memory embedding cache, shared by handlers and chunk hooks
+	embeddings.DefaultCache = embeddings.NewCache(cfg.EmbeddingCacheSize)
+
 	// Initialize database (Ent)
 	{
 		ctx := context.Background()
 		client, err := db.OpenClient(ctx, cfg)
 		if err != nil {
-			log.Fatalf("database initialization failed: %v", err)
+			fatal("database initialization failed", err)
 		}
         // Set global client fallback
         db.SetGlobalClient(client)
@@ -54,6 +89,15 @@ func main() {
 		if cfg.IsDevelopment() {
 			db.SeedDev(ctx, client, cfg)
 		}
+		// Corpus statistics for the v2 embedder, and the vectors they invalidate;
+		// embeddings still work unweighted without them.
+		if _, err := db.RefreshEmbeddings(ctx, client, cfg); err != nil {
+			logger.Warn("embedding refresh failed", logging.Err(err))
+		}
+		refreshCtx, stopRefresh := context.WithCancel(ctx)
+		if cfg.EmbeddingRefreshInterval > 0 {
+			go db.RunEmbeddingRefresh(refreshCtx, client, cfg, cfg.EmbeddingRefreshInterval)
+		}
         // Attach the initialized Ent client to each request (kept open for app lifetime).
         app.Use(func(c *fiber.Ctx) error {
             c.Locals("ent", client)
@@ -61,14 +105,15 @@ func main() {
         })
 		// Ensure DB is closed on app shutdown
 		app.Hooks().OnShutdown(func() error {
-			log.Println("OnShutdown: closing Ent DB client...")
+			stopRefresh()
+			logger.Info("closing Ent DB client")
 			// Allow the wrapped driver to actually close at shutdown time.
 			db.EnableDBClose()
 			if err := client.Close(); err != nil {
-				log.Printf("error closing db client: %v", err)
+				logger.Error("closing Ent DB client failed", logging.Err(err))
 				return err
 			}
-			log.Println("OnShutdown: Ent DB client closed")
+			logger.Info("Ent DB client closed")
 			return nil
 		})
 	}
@@ -77,27 +122,40 @@ func main() {
 	middleware.Register(app, cfg)
 
 	// Routes
-	routes.Register(app, cfg)
+	routes.Register(app, cfg, store)
 
 	// Start server with graceful shutdown
 	addr := fmt.Sprintf(":%d", cfg.Port)
 
 	srvErr := make(chan error, 1)
 	go func() {
-		log.Printf("starting %s on %s (env=%s)\n", cfg.AppName, addr, cfg.Env)
+		logger.Info("starting server", "app", cfg.AppName, "addr", addr, "env", cfg.Env, "version", cfg.VersionString())
 		srvErr <- app.Listen(addr)
 	}()
 
+	// Admin listener for Prometheus, kept off the public port; on loopback
+	// when no API key protects the main port.
+	var metricsSrv *http.Server
+	if metricsAddr := cfg.MetricsListenAddr(); metricsAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", metrics.Handler())
+		metricsSrv = &http.Server{Addr: metricsAddr, Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+		go func() {
+			logger.Info("serving metrics", "addr", metricsAddr, "path", "/metrics")
+			srvErr <- metricsSrv.ListenAndServe()
+		}()
+	}
+
 	// Listen for SIGINT/SIGTERM
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 
 	select {
 	case sig := <-quit:
-		log.Printf("received signal: %s, shutting down...\n", sig)
+		logger.Info("shutting down", "signal", sig.String())
 	case err := <-srvErr:
 		if err != nil && err != http.ErrServerClosed {
-			log.Fatalf("server error: %v", err)
+			fatal("server error", err)
 		}
 	}
 
@@ -105,8 +163,23 @@ func main() {
 	defer cancel()
 
 	if err := app.ShutdownWithContext(ctx); err != nil {
-		log.Printf("graceful shutdown failed: %v", err)
+		logger.Error("graceful shutdown failed", logging.Err(err))
+	}
+	if metricsSrv != nil {
+		if err := metricsSrv.Shutdown(ctx); err != nil {
+			logger.Error("metrics shutdown failed", logging.Err(err))
+		}
+	}
+	// Flush spans still buffered by the batcher.
+	if err := shutdownTracing(ctx); err != nil {
+		logger.Error("tracing shutdown failed", logging.Err(err))
 	}
 
-	log.Println("server stopped")
+	logger.Info("server stopped")
+}
+
+// fatal logs err and exits.
+func fatal(msg string, err error) {
+	slog.Error(msg, logging.Err(err))
+	os.Exit(1)
 }
@@ -0,0 +1,71 @@
+// Command embedbench compares the offline embedding models on a labeled set of
+// related and unrelated blog post pairs.
+//
+//	go run ./cmd/embedbench [-data pairs.json] [-dim 512]
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+
+	"landing/backend/internal/ai/embeddings"
+)
+
+//go:embed pairs.json
+var defaultData []byte
+
+// dataset is the benchmark file format: documents keyed by ID and labeled pairs of IDs.
+type dataset struct {
+	Docs  map[string]string `json:"docs"`
+	Pairs []embeddings.Pair `json:"pairs"`
+}
+
+func main() {
+	dataPath := flag.String("data", "", "benchmark JSON file (defaults to the built-in set)")
+	dim := flag.Int("dim", embeddings.DefaultDim, "v2 vector size")
+	flag.Parse()
+
+	raw := defaultData
+	if *dataPath != "" {
+		b, err := os.ReadFile(*dataPath)
+		if err != nil {
+			log.Fatalf("embedbench: %v", err)
+		}
+		raw = b
+	}
+	var ds dataset
+	if err := json.Unmarshal(raw, &ds); err != nil {
+		log.Fatalf("embedbench: parse data: %v", err)
+	}
+
+	texts := make([]string, 0, len(ds.Docs))
+	for _, t := range ds.Docs {
+		texts = append(texts, t)
+	}
+	idf := embeddings.NewIDF(texts)
+	models := []struct {
+		name  string
+		embed func(string) []float32
+	}{
+		{"v1", embeddings.EmbedV1},
+		{"v2 (no idf)", embeddings.Encoder{Dim: *dim}.Embed},
+		{"v2", embeddings.Encoder{Dim: *dim, IDF: idf}.Embed},
+	}
+
+	fmt.Printf("%d documents, %d pairs\n\n", len(ds.Docs), len(ds.Pairs))
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "model\tAUC\tP@1\tmean related\tmean unrelated\t")
+	for _, m := range models {
+		res, err := embeddings.Evaluate(m.embed, ds.Docs, ds.Pairs)
+		if err != nil {
+			log.Fatalf("embedbench: %s: %v", m.name, err)
+		}
+		fmt.Fprintf(w, "%s\t%.3f\t%.3f\t%.3f\t%.3f\t\n", m.name, res.AUC, res.Precision1, res.MeanRelated, res.MeanUnrelated)
+	}
+	_ = w.Flush()
+}
@@ -2,12 +2,14 @@ package main
 
 import (
 	"context"
-	"log"
+	"log/slog"
+	"os"
 
 	"github.com/joho/godotenv"
 
 	"landing/backend/internal/config"
 	"landing/backend/internal/db"
+	"landing/backend/internal/logging"
 )
 
 func main() {
@@ -15,24 +17,57 @@ func main() {
 	_ = godotenv.Load()
 
 	cfg := config.Load()
+	logger := logging.Setup(cfg)
 	ctx := context.Background()
 
 	client, err := db.OpenClient(ctx, cfg)
 	if err != nil {
-		log.Fatalf("migrate: database initialization failed: %v", err)
+		fatal("migrate: database initialization failed", err)
 	}
 	// Ensure DB is closed on exit
 	db.EnableDBClose()
 	defer func() {
 		if err := client.Close(); err != nil {
-			log.Printf("migrate: error closing db client: %v", err)
+			logger.Error("migrate: closing db client failed", logging.Err(err))
 		}
 	}()
 
 	// Run schema migrations (idempotent)
 	if err := client.Schema.Create(ctx); err != nil {
-		log.Fatalf("migrate: schema migration failed: %v", err)
+		fatal("migrate: schema migration failed", err)
 	}
 
-	log.Println("migrate: schema migration completed successfully")
+	// Bring stored slugs in line with slug.Make before anything looks them up.
+	if err := db.BackfillSlugs(ctx, client); err != nil {
+		fatal("migrate: backfill failed", err)
+	}
+	// Convert legacy free-form category strings into Category entities.
+	if err := db.BackfillCategories(ctx, client); err != nil {
+		fatal("migrate: backfill failed", err)
+	}
+	// Fill author display names from the legacy name column.
+	if err := db.BackfillDisplayNames(ctx, client, cfg); err != nil {
+		fatal("migrate: backfill failed", err)
+	}
+	if err := db.BackfillReadingStats(ctx, client); err != nil {
+		fatal("migrate: backfill failed", err)
+	}
+	if err := db.BackfillSummaries(ctx, client); err != nil {
+		fatal("migrate: backfill failed", err)
+	}
+	if err := db.BackfillKeywords(ctx, client); err != nil {
+		fatal("migrate: backfill failed", err)
+	}
+	// Chunk posts and embed them with the statistics of the current corpus.
+	if _, err := db.RefreshEmbeddings(ctx, client, cfg); err != nil {
+		fatal("migrate: backfill failed", err)
+	}
+
+	logger.Info("migrate: schema migration completed successfully")
+}
+
+// fatal logs err and exits.
+func fatal(msg string, err error) {
+	slog.Error(msg, logging.Err(err))
+	os.Exit(1)
 }
@@ -15,6 +15,183 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/ask": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "ask"
+                ],
+                "summary": "Ask a question about the blog posts",
+                "parameters": [
+                    {
+                        "description": "Question",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AskRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AskResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/authors": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "authors"
+                ],
+                "summary": "Create an author",
+                "parameters": [
+                    {
+                        "description": "Author payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CreateAuthorRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/ent.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/authors/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "authors"
+                ],
+                "summary": "Get author with posts",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Author ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
         "/blogs": {
             "get": {
                 "security": [
@@ -32,7 +209,7 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Filter by category",
+                        "description": "Filter by category slug",
                         "name": "category",
                         "in": "query"
                     }
@@ -47,13 +224,16 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     }
                 }
@@ -95,149 +275,2890 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     },
                     "409": {
                         "description": "Conflict",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     }
                 }
             }
         },
-        "/blogs/{path}": {
-            "get": {
+        "/blogs/analyze": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
                     "blogs"
                 ],
-                "summary": "Get blog by path",
+                "summary": "Extract keywords and a summary from draft text",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Blog path",
-                        "name": "path",
-                        "in": "path",
-                        "required": true
+                        "description": "Draft payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AnalyzeRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ent.Blog"
+                            "$ref": "#/definitions/handlers.AnalyzeResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     }
                 }
             }
         },
-        "/healthz": {
-            "get": {
+        "/blogs/audit": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "system"
+                    "blogs"
+                ],
+                "summary": "Audit draft blog HTML for SEO issues",
+                "parameters": [
+                    {
+                        "description": "Draft payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.AuditRequest"
+                        }
+                    }
                 ],
-                "summary": "Health check",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/seo.Report"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "ent.Blog": {
-            "type": "object",
-            "properties": {
-                "category": {
-                    "description": "Category holds the value of the \"category\" field.",
-                    "type": "string"
-                },
-                "id": {
-                    "description": "ID of the ent.",
-                    "type": "integer"
-                },
-                "path": {
-                    "description": "Path holds the value of the \"path\" field.",
-                    "type": "string"
-                },
-                "text": {
-                    "description": "Text holds the value of the \"text\" field.",
-                    "type": "string"
-                }
-            }
+        },
+        "/blogs/{path}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blogs"
+                ],
+                "summary": "Get blog by path",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Blog path",
+                        "name": "path",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ent.Blog"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blogs"
+                ],
+                "summary": "Update a blog post",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Blog path",
+                        "name": "path",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Blog payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateBlogRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ent.Blog"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/blogs/{path}/audit": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blogs"
+                ],
+                "summary": "Audit a blog post for SEO issues",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Blog path",
+                        "name": "path",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Focus keyword (defaults to the first tag)",
+                        "name": "keyword",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/seo.Report"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/categories": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "List categories",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handlers.CategoryWithCount"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Create a category",
+                "parameters": [
+                    {
+                        "description": "Category payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/ent.Category"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/categories/{slug}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Get category by slug",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Category slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CategoryWithCount"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Update a category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Category slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Category payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CategoryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ent.Category"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "Delete a category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Category slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/cta-blocks": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cta"
+                ],
+                "summary": "List CTA blocks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by category slug",
+                        "name": "category",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ent.CTABlock"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cta"
+                ],
+                "summary": "Create a CTA block",
+                "parameters": [
+                    {
+                        "description": "CTA block payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CTABlockRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/ent.CTABlock"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/cta-blocks/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cta"
+                ],
+                "summary": "Update a CTA block",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "CTA block ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "CTA block payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.CTABlockRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ent.CTABlock"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "cta"
+                ],
+                "summary": "Delete a CTA block",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "CTA block ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/embeddings/refresh": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "system"
+                ],
+                "summary": "Refresh corpus statistics and re-embed",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/db.EmbeddingRefresh"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/embeddings/stats": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "system"
+                ],
+                "summary": "Embedding cache statistics",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.EmbeddingStats"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/healthz": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "system"
+                ],
+                "summary": "Health check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/media": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "media"
+                ],
+                "summary": "List media",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ent.Media"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "media"
+                ],
+                "summary": "Upload an image",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "Image file (JPEG, PNG, GIF or WebP)",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "maxLength": 500,
+                        "type": "string",
+                        "description": "Alternative text",
+                        "name": "alt",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/ent.Media"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "413": {
+                        "description": "Request Entity Too Large",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "415": {
+                        "description": "Unsupported Media Type",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/media/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "media"
+                ],
+                "summary": "Get media by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Media ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ent.Media"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "media"
+                ],
+                "summary": "Update media alt text",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Media ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Media metadata",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UpdateMediaRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ent.Media"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "media"
+                ],
+                "summary": "Delete media",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Media ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/placeholders": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "placeholders"
+                ],
+                "summary": "List placeholders",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handlers.PlaceholderInfo"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "placeholders"
+                ],
+                "summary": "Create a placeholder",
+                "parameters": [
+                    {
+                        "description": "Placeholder payload",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.PlaceholderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/ent.Placeholder"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/placeholders/{name}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "placeholders"
+                ],
+                "summary": "Update a placeholder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Placeholder name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Placeholder payload (name is ignored)",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handlers.PlaceholderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ent.Placeholder"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "placeholders"
+                ],
+                "summary": "Delete a placeholder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Placeholder name",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/search": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "blogs"
+                ],
+                "summary": "Search blog posts by meaning",
+                "parameters": [
+                    {
+                        "maxLength": 1000,
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "maximum": 50,
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "Maximum number of posts (default 10)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "max",
+                            "mean"
+                        ],
+                        "type": "string",
+                        "description": "Chunk score aggregation",
+                        "name": "agg",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/rag.PostHit"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "List tags",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handlers.TagWithCount"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{slug}/blogs": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "List blogs by tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Tag slug",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ent.Blog"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/apperr.Problem"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "apperr.FieldError": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Code is a stable field-level code.",
+                    "type": "string",
+                    "enum": [
+                        "required",
+                        "invalid",
+                        "unknown",
+                        "too_long",
+                        "too_short",
+                        "not_allowed",
+                        "out_of_range"
+                    ],
+                    "example": "required"
+                },
+                "field": {
+                    "description": "Field is the JSON name of the field (dotted for nested fields).",
+                    "type": "string",
+                    "example": "path"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "path is required"
+                }
+            }
+        },
+        "apperr.Problem": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "Code is the stable error code clients should switch on.",
+                    "type": "string",
+                    "enum": [
+                        "validation_failed",
+                        "invalid_body",
+                        "unauthorized",
+                        "not_found",
+                        "conflict",
+                        "payload_too_large",
+                        "unsupported_media_type",
+                        "internal_error"
+                    ],
+                    "example": "not_found"
+                },
+                "detail": {
+                    "type": "string",
+                    "example": "blog not found"
+                },
+                "errors": {
+                    "description": "Errors lists the invalid fields of a validation_failed problem.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/apperr.FieldError"
+                    }
+                },
+                "instance": {
+                    "description": "Instance is the request path.",
+                    "type": "string",
+                    "example": "/api/blogs/missing"
+                },
+                "request_id": {
+                    "description": "RequestID matches the X-Request-ID response header and the server logs.",
+                    "type": "string",
+                    "example": "5805817e-0046-434a-abb6-e4f2f990e47b"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Not Found"
+                },
+                "type": {
+                    "description": "Type is \"urn:landing:problem:\" followed by the code.",
+                    "type": "string",
+                    "example": "urn:landing:problem:not_found"
+                }
+            }
+        },
+        "blog.Format": {
+            "type": "string",
+            "enum": [
+                "html",
+                "html",
+                "markdown"
+            ],
+            "x-enum-varnames": [
+                "DefaultFormat",
+                "FormatHTML",
+                "FormatMarkdown"
+            ]
+        },
+        "content.ReadingStats": {
+            "type": "object",
+            "properties": {
+                "chars": {
+                    "description": "Chars counts non-space characters of the visible text.",
+                    "type": "integer"
+                },
+                "code_words": {
+                    "type": "integer"
+                },
+                "images": {
+                    "type": "integer"
+                },
+                "minutes": {
+                    "description": "Minutes is the estimated reading time, at least 1.",
+                    "type": "integer"
+                },
+                "persian_words": {
+                    "description": "PersianWords is the part of Words written in Arabic script.",
+                    "type": "integer"
+                },
+                "words": {
+                    "description": "Words counts prose and code words; Persian words joined by a half-space count once.",
+                    "type": "integer"
+                }
+            }
+        },
+        "ctablock.Placement": {
+            "type": "string",
+            "enum": [
+                "end",
+                "end",
+                "after_paragraph"
+            ],
+            "x-enum-varnames": [
+                "DefaultPlacement",
+                "PlacementEnd",
+                "PlacementAfterParagraph"
+            ]
+        },
+        "db.EmbeddingRefresh": {
+            "type": "object",
+            "properties": {
+                "model": {
+                    "description": "Model is the embedding version after the refresh, IDF generation included.",
+                    "type": "string"
+                },
+                "posts": {
+                    "description": "Posts is the number of posts checked; Reembedded those whose vectors\nwere regenerated.",
+                    "type": "integer"
+                },
+                "reembedded": {
+                    "type": "integer"
+                }
+            }
+        },
+        "embeddings.CacheStats": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "integer"
+                },
+                "hits": {
+                    "type": "integer"
+                },
+                "misses": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "integer"
+                }
+            }
+        },
+        "ent.Blog": {
+            "type": "object",
+            "properties": {
+                "author_id": {
+                    "description": "AuthorID holds the value of the \"author_id\" field.",
+                    "type": "integer"
+                },
+                "category": {
+                    "description": "Category holds the value of the \"category\" field.",
+                    "type": "string"
+                },
+                "category_id": {
+                    "description": "CategoryID holds the value of the \"category_id\" field.",
+                    "type": "integer"
+                },
+                "char_count": {
+                    "description": "CharCount holds the value of the \"char_count\" field.",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "disable_cta": {
+                    "description": "DisableCta holds the value of the \"disable_cta\" field.",
+                    "type": "boolean"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the BlogQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.BlogEdges"
+                        }
+                    ]
+                },
+                "embedding": {
+                    "description": "Embedding holds the value of the \"embedding\" field.",
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "embedding_hash": {
+                    "description": "EmbeddingHash holds the value of the \"embedding_hash\" field.",
+                    "type": "string"
+                },
+                "excerpt": {
+                    "description": "Excerpt holds the value of the \"excerpt\" field.",
+                    "type": "string"
+                },
+                "excerpt_custom": {
+                    "description": "ExcerptCustom holds the value of the \"excerpt_custom\" field.",
+                    "type": "boolean"
+                },
+                "featured_image_id": {
+                    "description": "FeaturedImageID holds the value of the \"featured_image_id\" field.",
+                    "type": "integer"
+                },
+                "format": {
+                    "description": "Format holds the value of the \"format\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/blog.Format"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "integer"
+                },
+                "keywords": {
+                    "description": "Keywords holds the value of the \"keywords\" field.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "meta_description": {
+                    "description": "MetaDescription holds the value of the \"meta_description\" field.",
+                    "type": "string"
+                },
+                "meta_description_custom": {
+                    "description": "MetaDescriptionCustom holds the value of the \"meta_description_custom\" field.",
+                    "type": "boolean"
+                },
+                "path": {
+                    "description": "Path holds the value of the \"path\" field.",
+                    "type": "string"
+                },
+                "reading_minutes": {
+                    "description": "ReadingMinutes holds the value of the \"reading_minutes\" field.",
+                    "type": "integer"
+                },
+                "schema_json": {
+                    "description": "SchemaJSON holds the value of the \"schema_json\" field.",
+                    "type": "string"
+                },
+                "source": {
+                    "description": "Source holds the value of the \"source\" field.",
+                    "type": "string"
+                },
+                "text": {
+                    "description": "Text holds the value of the \"text\" field.",
+                    "type": "string"
+                },
+                "updated_at": {
+                    "description": "UpdatedAt holds the value of the \"updated_at\" field.",
+                    "type": "string"
+                },
+                "word_count": {
+                    "description": "WordCount holds the value of the \"word_count\" field.",
+                    "type": "integer"
+                }
+            }
+        },
+        "ent.BlogChunk": {
+            "type": "object",
+            "properties": {
+                "blog_id": {
+                    "description": "BlogID holds the value of the \"blog_id\" field.",
+                    "type": "integer"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the BlogChunkQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.BlogChunkEdges"
+                        }
+                    ]
+                },
+                "embedding": {
+                    "description": "Embedding holds the value of the \"embedding\" field.",
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "embedding_hash": {
+                    "description": "EmbeddingHash holds the value of the \"embedding_hash\" field.",
+                    "type": "string"
+                },
+                "heading": {
+                    "description": "Heading holds the value of the \"heading\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "integer"
+                },
+                "position": {
+                    "description": "Position holds the value of the \"position\" field.",
+                    "type": "integer"
+                },
+                "text": {
+                    "description": "Text holds the value of the \"text\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.BlogChunkEdges": {
+            "type": "object",
+            "properties": {
+                "blog": {
+                    "description": "Blog holds the value of the blog edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Blog"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.BlogEdges": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "description": "Author holds the value of the author edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.User"
+                        }
+                    ]
+                },
+                "chunks": {
+                    "description": "Chunks holds the value of the chunks edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.BlogChunk"
+                    }
+                },
+                "featured_image": {
+                    "description": "FeaturedImage holds the value of the featured_image edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Media"
+                        }
+                    ]
+                },
+                "primary_category": {
+                    "description": "PrimaryCategory holds the value of the primary_category edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Category"
+                        }
+                    ]
+                },
+                "tags": {
+                    "description": "Tags holds the value of the tags edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Tag"
+                    }
+                }
+            }
+        },
+        "ent.CTABlock": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "description": "Active holds the value of the \"active\" field.",
+                    "type": "boolean"
+                },
+                "body": {
+                    "description": "Body holds the value of the \"body\" field.",
+                    "type": "string"
+                },
+                "button_text": {
+                    "description": "ButtonText holds the value of the \"button_text\" field.",
+                    "type": "string"
+                },
+                "button_url": {
+                    "description": "ButtonURL holds the value of the \"button_url\" field.",
+                    "type": "string"
+                },
+                "category_id": {
+                    "description": "CategoryID holds the value of the \"category_id\" field.",
+                    "type": "integer"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the CTABlockQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.CTABlockEdges"
+                        }
+                    ]
+                },
+                "heading": {
+                    "description": "Heading holds the value of the \"heading\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "paragraph": {
+                    "description": "Paragraph holds the value of the \"paragraph\" field.",
+                    "type": "integer"
+                },
+                "placement": {
+                    "description": "Placement holds the value of the \"placement\" field.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ctablock.Placement"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.CTABlockEdges": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "Category holds the value of the category edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Category"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.Category": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "Description holds the value of the \"description\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the CategoryQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.CategoryEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "parent_id": {
+                    "description": "ParentID holds the value of the \"parent_id\" field.",
+                    "type": "integer"
+                },
+                "slug": {
+                    "description": "Slug holds the value of the \"slug\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.CategoryEdges": {
+            "type": "object",
+            "properties": {
+                "blogs": {
+                    "description": "Blogs holds the value of the blogs edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Blog"
+                    }
+                },
+                "children": {
+                    "description": "Children holds the value of the children edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Category"
+                    }
+                },
+                "cta_blocks": {
+                    "description": "CtaBlocks holds the value of the cta_blocks edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.CTABlock"
+                    }
+                },
+                "parent": {
+                    "description": "Parent holds the value of the parent edge.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.Category"
+                        }
+                    ]
+                }
+            }
+        },
+        "ent.Media": {
+            "type": "object",
+            "properties": {
+                "alt": {
+                    "description": "Alt holds the value of the \"alt\" field.",
+                    "type": "string"
+                },
+                "content_type": {
+                    "description": "ContentType holds the value of the \"content_type\" field.",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "CreatedAt holds the value of the \"created_at\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the MediaQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.MediaEdges"
+                        }
+                    ]
+                },
+                "height": {
+                    "description": "Height holds the value of the \"height\" field.",
+                    "type": "integer"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "integer"
+                },
+                "key": {
+                    "description": "Key holds the value of the \"key\" field.",
+                    "type": "string"
+                },
+                "size": {
+                    "description": "Size holds the value of the \"size\" field.",
+                    "type": "integer"
+                },
+                "url": {
+                    "description": "URL holds the value of the \"url\" field.",
+                    "type": "string"
+                },
+                "variants": {
+                    "description": "Variants holds the value of the \"variants\" field.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/imaging.Variant"
+                    }
+                },
+                "width": {
+                    "description": "Width holds the value of the \"width\" field.",
+                    "type": "integer"
+                }
+            }
+        },
+        "ent.MediaEdges": {
+            "type": "object",
+            "properties": {
+                "featured_in": {
+                    "description": "FeaturedIn holds the value of the featured_in edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Blog"
+                    }
+                }
+            }
+        },
+        "ent.Placeholder": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "Description holds the value of the \"description\" field.",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "value": {
+                    "description": "Value holds the value of the \"value\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.Tag": {
+            "type": "object",
+            "properties": {
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the TagQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.TagEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "slug": {
+                    "description": "Slug holds the value of the \"slug\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "ent.TagEdges": {
+            "type": "object",
+            "properties": {
+                "blogs": {
+                    "description": "Blogs holds the value of the blogs edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Blog"
+                    }
+                }
+            }
+        },
+        "ent.User": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "description": "Avatar holds the value of the \"avatar\" field.",
+                    "type": "string"
+                },
+                "bio": {
+                    "description": "Bio holds the value of the \"bio\" field.",
+                    "type": "string"
+                },
+                "display_name": {
+                    "description": "DisplayName holds the value of the \"display_name\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the UserQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.UserEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "integer"
+                },
+                "social_links": {
+                    "description": "SocialLinks holds the value of the \"social_links\" field.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "ent.UserEdges": {
+            "type": "object",
+            "properties": {
+                "posts": {
+                    "description": "Posts holds the value of the posts edge.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ent.Blog"
+                    }
+                }
+            }
+        },
+        "handlers.AnalyzeRequest": {
+            "type": "object",
+            "required": [
+                "text"
+            ],
+            "properties": {
+                "format": {
+                    "description": "Format is \"html\" (default) or \"markdown\".",
+                    "type": "string",
+                    "enum": [
+                        "html",
+                        "markdown"
+                    ]
+                },
+                "keywords": {
+                    "description": "Keywords is the number of keywords to return (default 10).",
+                    "type": "integer",
+                    "maximum": 50,
+                    "minimum": 0
+                },
+                "sentences": {
+                    "description": "Sentences is the length of the extractive summary (default 3).",
+                    "type": "integer",
+                    "maximum": 50,
+                    "minimum": 0
+                },
+                "text": {
+                    "type": "string",
+                    "maxLength": 200000
+                }
+            }
+        },
+        "handlers.AnalyzeResponse": {
+            "type": "object",
+            "properties": {
+                "keywords": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/nlp.Keyword"
+                    }
+                },
+                "stats": {
+                    "$ref": "#/definitions/content.ReadingStats"
+                },
+                "summary": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handlers.AskRequest": {
+            "type": "object",
+            "required": [
+                "question"
+            ],
+            "properties": {
+                "k": {
+                    "description": "K is the number of passages to retrieve (default ASK_TOP_K).",
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 0
+                },
+                "question": {
+                    "type": "string",
+                    "maxLength": 1000
+                }
+            }
+        },
+        "handlers.AskResponse": {
+            "type": "object",
+            "properties": {
+                "answer": {
+                    "type": "string"
+                },
+                "sources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/rag.Source"
+                    }
+                }
+            }
+        },
+        "handlers.AuditRequest": {
+            "type": "object",
+            "required": [
+                "text"
+            ],
+            "properties": {
+                "format": {
+                    "description": "Format is \"html\" (default) or \"markdown\".",
+                    "type": "string",
+                    "enum": [
+                        "html",
+                        "markdown"
+                    ]
+                },
+                "keyword": {
+                    "description": "Keyword is the focus keyword used for the density check.",
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "meta_description": {
+                    "description": "MetaDescription is used when the text has no \u003cmeta name=\"description\"\u003e.",
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "text": {
+                    "type": "string",
+                    "maxLength": 200000
+                }
+            }
+        },
+        "handlers.CTABlockRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "body": {
+                    "type": "string",
+                    "maxLength": 2000
+                },
+                "button_text": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "button_url": {
+                    "description": "ButtonURL is a site-relative path or an http(s) URL.",
+                    "type": "string",
+                    "maxLength": 2048
+                },
+                "category": {
+                    "description": "Category is a category slug; empty applies the block to categories without their own.",
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "heading": {
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "paragraph": {
+                    "description": "Paragraph is N for after_paragraph placement (default 3).",
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 1
+                },
+                "placement": {
+                    "description": "Placement is \"end\" (default) or \"after_paragraph\".",
+                    "type": "string",
+                    "enum": [
+                        "end",
+                        "after_paragraph"
+                    ]
+                }
+            }
+        },
+        "handlers.CategoryRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 2000
+                },
+                "name": {
+                    "description": "Name maps language codes to display names, e.g. {\"fa\": \"هوش مصنوعی\"}.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "parent": {
+                    "description": "Parent is the slug of the parent category; empty means top-level.",
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "slug": {
+                    "description": "Slug is required when creating; it is normalized, so \"AI\" becomes \"ai\".",
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "handlers.CategoryWithCount": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "Description holds the value of the \"description\" field.",
+                    "type": "string"
+                },
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the CategoryQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.CategoryEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "parent_id": {
+                    "description": "ParentID holds the value of the \"parent_id\" field.",
+                    "type": "integer"
+                },
+                "post_count": {
+                    "type": "integer"
+                },
+                "slug": {
+                    "description": "Slug holds the value of the \"slug\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.CreateAuthorRequest": {
+            "type": "object",
+            "required": [
+                "display_name",
+                "email"
+            ],
+            "properties": {
+                "avatar": {
+                    "type": "string",
+                    "maxLength": 2048
+                },
+                "bio": {
+                    "type": "string",
+                    "maxLength": 2000
+                },
+                "display_name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "email": {
+                    "description": "Email is an address such as jane@example.com; it is stored lower-cased.",
+                    "type": "string",
+                    "maxLength": 254
+                },
+                "social_links": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
         },
         "handlers.CreateBlogRequest": {
+            "type": "object",
+            "required": [
+                "category",
+                "path"
+            ],
+            "properties": {
+                "author_id": {
+                    "type": "integer"
+                },
+                "category": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "disable_cta": {
+                    "description": "DisableCTA opts the post out of the automatic call-to-action block.",
+                    "type": "boolean"
+                },
+                "excerpt": {
+                    "description": "Excerpt and MetaDescription override the summaries generated from the text.",
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "featured_image_id": {
+                    "description": "FeaturedImageID references an uploaded Media item used for {FEATURED_IMAGE}.",
+                    "type": "integer"
+                },
+                "format": {
+                    "description": "Format is \"html\" (default) or \"markdown\"; Markdown is rendered to HTML on publish.",
+                    "type": "string",
+                    "enum": [
+                        "html",
+                        "markdown"
+                    ]
+                },
+                "meta_description": {
+                    "type": "string",
+                    "maxLength": 320
+                },
+                "path": {
+                    "description": "Path is the post's URL segment: no whitespace, \"/\", \"?\", \"#\" or \"%\".",
+                    "type": "string",
+                    "maxLength": 200
+                },
+                "tags": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "text": {
+                    "type": "string",
+                    "maxLength": 200000
+                }
+            }
+        },
+        "handlers.EmbeddingStats": {
+            "type": "object",
+            "properties": {
+                "cache": {
+                    "$ref": "#/definitions/embeddings.CacheStats"
+                },
+                "model": {
+                    "description": "Model is the version embedded in content hashes, e.g. \"v2/512\" or, once\ncorpus statistics are loaded, \"v2/512/idf-9f86d081884c7d65\".",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.PlaceholderInfo": {
+            "type": "object",
+            "properties": {
+                "builtin": {
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                },
+                "value": {
+                    "description": "Value is set for site-defined placeholders only; built-in values depend on the post.",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.PlaceholderRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "name": {
+                    "description": "Name is upper-case letters, digits and underscores, e.g. SUPPORT_PHONE;\n\"support_phone\" and \"{SUPPORT_PHONE}\" are accepted too.",
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "value": {
+                    "type": "string",
+                    "maxLength": 2000
+                }
+            }
+        },
+        "handlers.TagWithCount": {
+            "type": "object",
+            "properties": {
+                "edges": {
+                    "description": "Edges holds the relations/edges for other nodes in the graph.\nThe values are being populated by the TagQuery when eager-loading is set.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/ent.TagEdges"
+                        }
+                    ]
+                },
+                "id": {
+                    "description": "ID of the ent.",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "Name holds the value of the \"name\" field.",
+                    "type": "string"
+                },
+                "post_count": {
+                    "type": "integer"
+                },
+                "slug": {
+                    "description": "Slug holds the value of the \"slug\" field.",
+                    "type": "string"
+                }
+            }
+        },
+        "handlers.UpdateBlogRequest": {
             "type": "object",
             "properties": {
+                "author_id": {
+                    "description": "AuthorID reassigns the post when present; null or 0 removes the author.",
+                    "type": "integer",
+                    "x-nullable": true
+                },
                 "category": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "disable_cta": {
+                    "description": "DisableCTA changes the call-to-action opt-out when present.",
+                    "type": "boolean"
+                },
+                "excerpt": {
+                    "description": "Excerpt and MetaDescription set author overrides when present; an empty\nstring switches back to generating them from the text.",
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "featured_image_id": {
+                    "description": "FeaturedImageID replaces the featured image when present; null or 0\nremoves it.",
+                    "type": "integer",
+                    "x-nullable": true
+                },
+                "format": {
+                    "description": "Format changes the authoring format when present; text is then required.",
+                    "type": "string",
+                    "enum": [
+                        "html",
+                        "markdown"
+                    ]
+                },
+                "meta_description": {
+                    "type": "string",
+                    "maxLength": 320
+                },
+                "tags": {
+                    "description": "Tags replaces the post's tags when present; omit it to keep the current tags.",
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "text": {
+                    "type": "string",
+                    "maxLength": 200000
+                }
+            }
+        },
+        "handlers.UpdateMediaRequest": {
+            "type": "object",
+            "properties": {
+                "alt": {
+                    "type": "string",
+                    "maxLength": 500
+                }
+            }
+        },
+        "imaging.Variant": {
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "type": "string"
+                },
+                "format": {
+                    "description": "\"webp\" or \"jpeg\"",
+                    "type": "string"
+                },
+                "height": {
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "url": {
+                    "type": "string"
+                },
+                "width": {
+                    "type": "integer"
+                }
+            }
+        },
+        "nlp.Keyword": {
+            "type": "object",
+            "properties": {
+                "score": {
+                    "type": "number"
+                },
+                "term": {
+                    "type": "string"
+                }
+            }
+        },
+        "rag.PostHit": {
+            "type": "object",
+            "properties": {
+                "best": {
+                    "description": "Best is the post's highest-scoring passage.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/rag.Source"
+                        }
+                    ]
+                },
+                "path": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "number"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "rag.Source": {
+            "type": "object",
+            "properties": {
+                "heading": {
                     "type": "string"
                 },
                 "path": {
                     "type": "string"
                 },
+                "score": {
+                    "type": "number"
+                },
                 "text": {
                     "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "seo.Check": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "weight": {
+                    "type": "integer"
+                }
+            }
+        },
+        "seo.Report": {
+            "type": "object",
+            "properties": {
+                "checks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/seo.Check"
+                    }
+                },
+                "score": {
+                    "type": "integer"
+                },
+                "stats": {
+                    "$ref": "#/definitions/seo.Stats"
+                }
+            }
+        },
+        "seo.Stats": {
+            "type": "object",
+            "properties": {
+                "broken_links": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "external_links": {
+                    "type": "integer"
+                },
+                "h1_count": {
+                    "type": "integer"
+                },
+                "has_cta": {
+                    "type": "boolean"
+                },
+                "images": {
+                    "type": "integer"
+                },
+                "images_missing_alt": {
+                    "type": "integer"
+                },
+                "internal_links": {
+                    "type": "integer"
+                },
+                "keyword_density": {
+                    "type": "number"
+                },
+                "keyword_occurrences": {
+                    "type": "integer"
+                },
+                "meta_description": {
+                    "type": "string"
+                },
+                "meta_description_length": {
+                    "type": "integer"
+                },
+                "placeholders": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "words": {
+                    "type": "integer"
                 }
             }
         }
@@ -258,7 +3179,7 @@ var SwaggerInfo = &swag.Spec{
 	BasePath:         "/api",
 	Schemes:          []string{},
 	Title:            "Landing Backend API",
-	Description:      "OpenAPI documentation for Landing backend.",
+	Description:      "OpenAPI documentation for Landing backend.\nErrors are RFC 7807 problem documents (application/problem+json) with a stable `code`:\nvalidation_failed (400, per-field problems in `errors`), invalid_body (400), unauthorized (401),\nnot_found (404), conflict (409), payload_too_large (413), unsupported_media_type (415), internal_error (500).",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
 	LeftDelim:        "{{",
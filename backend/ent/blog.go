@@ -6,7 +6,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"landing/backend/ent/blog"
+	"landing/backend/ent/category"
+	"landing/backend/ent/media"
+	"landing/backend/ent/user"
 	"strings"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -21,24 +25,135 @@ type Blog struct {
 	Category string `json:"category,omitempty"`
 	// Text holds the value of the "text" field.
 	Text string `json:"text,omitempty"`
+	// Format holds the value of the "format" field.
+	Format blog.Format `json:"format,omitempty"`
+	// Source holds the value of the "source" field.
+	Source string `json:"source,omitempty"`
 	// Path holds the value of the "path" field.
 	Path string `json:"path,omitempty"`
 	// Embedding holds the value of the "embedding" field.
-	Embedding    []float32 `json:"embedding,omitempty"`
+	Embedding []float32 `json:"embedding,omitempty"`
+	// EmbeddingHash holds the value of the "embedding_hash" field.
+	EmbeddingHash string `json:"embedding_hash,omitempty"`
+	// SchemaJSON holds the value of the "schema_json" field.
+	SchemaJSON string `json:"schema_json,omitempty"`
+	// CategoryID holds the value of the "category_id" field.
+	CategoryID *int `json:"category_id,omitempty"`
+	// AuthorID holds the value of the "author_id" field.
+	AuthorID *int `json:"author_id,omitempty"`
+	// FeaturedImageID holds the value of the "featured_image_id" field.
+	FeaturedImageID *int `json:"featured_image_id,omitempty"`
+	// WordCount holds the value of the "word_count" field.
+	WordCount int `json:"word_count,omitempty"`
+	// CharCount holds the value of the "char_count" field.
+	CharCount int `json:"char_count,omitempty"`
+	// ReadingMinutes holds the value of the "reading_minutes" field.
+	ReadingMinutes int `json:"reading_minutes,omitempty"`
+	// Excerpt holds the value of the "excerpt" field.
+	Excerpt string `json:"excerpt,omitempty"`
+	// MetaDescription holds the value of the "meta_description" field.
+	MetaDescription string `json:"meta_description,omitempty"`
+	// ExcerptCustom holds the value of the "excerpt_custom" field.
+	ExcerptCustom bool `json:"excerpt_custom,omitempty"`
+	// MetaDescriptionCustom holds the value of the "meta_description_custom" field.
+	MetaDescriptionCustom bool `json:"meta_description_custom,omitempty"`
+	// Keywords holds the value of the "keywords" field.
+	Keywords []string `json:"keywords,omitempty"`
+	// DisableCta holds the value of the "disable_cta" field.
+	DisableCta bool `json:"disable_cta,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BlogQuery when eager-loading is set.
+	Edges        BlogEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// BlogEdges holds the relations/edges for other nodes in the graph.
+type BlogEdges struct {
+	// PrimaryCategory holds the value of the primary_category edge.
+	PrimaryCategory *Category `json:"primary_category,omitempty"`
+	// Tags holds the value of the tags edge.
+	Tags []*Tag `json:"tags,omitempty"`
+	// Author holds the value of the author edge.
+	Author *User `json:"author,omitempty"`
+	// FeaturedImage holds the value of the featured_image edge.
+	FeaturedImage *Media `json:"featured_image,omitempty"`
+	// Chunks holds the value of the chunks edge.
+	Chunks []*BlogChunk `json:"chunks,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [5]bool
+}
+
+// PrimaryCategoryOrErr returns the PrimaryCategory value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BlogEdges) PrimaryCategoryOrErr() (*Category, error) {
+	if e.PrimaryCategory != nil {
+		return e.PrimaryCategory, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: category.Label}
+	}
+	return nil, &NotLoadedError{edge: "primary_category"}
+}
+
+// TagsOrErr returns the Tags value or an error if the edge
+// was not loaded in eager-loading.
+func (e BlogEdges) TagsOrErr() ([]*Tag, error) {
+	if e.loadedTypes[1] {
+		return e.Tags, nil
+	}
+	return nil, &NotLoadedError{edge: "tags"}
+}
+
+// AuthorOrErr returns the Author value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BlogEdges) AuthorOrErr() (*User, error) {
+	if e.Author != nil {
+		return e.Author, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "author"}
+}
+
+// FeaturedImageOrErr returns the FeaturedImage value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BlogEdges) FeaturedImageOrErr() (*Media, error) {
+	if e.FeaturedImage != nil {
+		return e.FeaturedImage, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: media.Label}
+	}
+	return nil, &NotLoadedError{edge: "featured_image"}
+}
+
+// ChunksOrErr returns the Chunks value or an error if the edge
+// was not loaded in eager-loading.
+func (e BlogEdges) ChunksOrErr() ([]*BlogChunk, error) {
+	if e.loadedTypes[4] {
+		return e.Chunks, nil
+	}
+	return nil, &NotLoadedError{edge: "chunks"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Blog) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case blog.FieldEmbedding:
+		case blog.FieldEmbedding, blog.FieldKeywords:
 			values[i] = new([]byte)
-		case blog.FieldID:
+		case blog.FieldExcerptCustom, blog.FieldMetaDescriptionCustom, blog.FieldDisableCta:
+			values[i] = new(sql.NullBool)
+		case blog.FieldID, blog.FieldCategoryID, blog.FieldAuthorID, blog.FieldFeaturedImageID, blog.FieldWordCount, blog.FieldCharCount, blog.FieldReadingMinutes:
 			values[i] = new(sql.NullInt64)
-		case blog.FieldCategory, blog.FieldText, blog.FieldPath:
+		case blog.FieldCategory, blog.FieldText, blog.FieldFormat, blog.FieldSource, blog.FieldPath, blog.FieldEmbeddingHash, blog.FieldSchemaJSON, blog.FieldExcerpt, blog.FieldMetaDescription:
 			values[i] = new(sql.NullString)
+		case blog.FieldCreatedAt, blog.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -72,6 +187,18 @@ func (_m *Blog) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Text = value.String
 			}
+		case blog.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				_m.Format = blog.Format(value.String)
+			}
+		case blog.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
 		case blog.FieldPath:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field path", values[i])
@@ -86,6 +213,107 @@ func (_m *Blog) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field embedding: %w", err)
 				}
 			}
+		case blog.FieldEmbeddingHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding_hash", values[i])
+			} else if value.Valid {
+				_m.EmbeddingHash = value.String
+			}
+		case blog.FieldSchemaJSON:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field schema_json", values[i])
+			} else if value.Valid {
+				_m.SchemaJSON = value.String
+			}
+		case blog.FieldCategoryID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field category_id", values[i])
+			} else if value.Valid {
+				_m.CategoryID = new(int)
+				*_m.CategoryID = int(value.Int64)
+			}
+		case blog.FieldAuthorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field author_id", values[i])
+			} else if value.Valid {
+				_m.AuthorID = new(int)
+				*_m.AuthorID = int(value.Int64)
+			}
+		case blog.FieldFeaturedImageID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field featured_image_id", values[i])
+			} else if value.Valid {
+				_m.FeaturedImageID = new(int)
+				*_m.FeaturedImageID = int(value.Int64)
+			}
+		case blog.FieldWordCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field word_count", values[i])
+			} else if value.Valid {
+				_m.WordCount = int(value.Int64)
+			}
+		case blog.FieldCharCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field char_count", values[i])
+			} else if value.Valid {
+				_m.CharCount = int(value.Int64)
+			}
+		case blog.FieldReadingMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reading_minutes", values[i])
+			} else if value.Valid {
+				_m.ReadingMinutes = int(value.Int64)
+			}
+		case blog.FieldExcerpt:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field excerpt", values[i])
+			} else if value.Valid {
+				_m.Excerpt = value.String
+			}
+		case blog.FieldMetaDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field meta_description", values[i])
+			} else if value.Valid {
+				_m.MetaDescription = value.String
+			}
+		case blog.FieldExcerptCustom:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field excerpt_custom", values[i])
+			} else if value.Valid {
+				_m.ExcerptCustom = value.Bool
+			}
+		case blog.FieldMetaDescriptionCustom:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field meta_description_custom", values[i])
+			} else if value.Valid {
+				_m.MetaDescriptionCustom = value.Bool
+			}
+		case blog.FieldKeywords:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field keywords", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Keywords); err != nil {
+					return fmt.Errorf("unmarshal field keywords: %w", err)
+				}
+			}
+		case blog.FieldDisableCta:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field disable_cta", values[i])
+			} else if value.Valid {
+				_m.DisableCta = value.Bool
+			}
+		case blog.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case blog.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -99,6 +327,31 @@ func (_m *Blog) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryPrimaryCategory queries the "primary_category" edge of the Blog entity.
+func (_m *Blog) QueryPrimaryCategory() *CategoryQuery {
+	return NewBlogClient(_m.config).QueryPrimaryCategory(_m)
+}
+
+// QueryTags queries the "tags" edge of the Blog entity.
+func (_m *Blog) QueryTags() *TagQuery {
+	return NewBlogClient(_m.config).QueryTags(_m)
+}
+
+// QueryAuthor queries the "author" edge of the Blog entity.
+func (_m *Blog) QueryAuthor() *UserQuery {
+	return NewBlogClient(_m.config).QueryAuthor(_m)
+}
+
+// QueryFeaturedImage queries the "featured_image" edge of the Blog entity.
+func (_m *Blog) QueryFeaturedImage() *MediaQuery {
+	return NewBlogClient(_m.config).QueryFeaturedImage(_m)
+}
+
+// QueryChunks queries the "chunks" edge of the Blog entity.
+func (_m *Blog) QueryChunks() *BlogChunkQuery {
+	return NewBlogClient(_m.config).QueryChunks(_m)
+}
+
 // Update returns a builder for updating this Blog.
 // Note that you need to call Blog.Unwrap() before calling this method if this Blog
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -128,11 +381,71 @@ func (_m *Blog) String() string {
 	builder.WriteString("text=")
 	builder.WriteString(_m.Text)
 	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Format))
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
 	builder.WriteString("path=")
 	builder.WriteString(_m.Path)
 	builder.WriteString(", ")
 	builder.WriteString("embedding=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Embedding))
+	builder.WriteString(", ")
+	builder.WriteString("embedding_hash=")
+	builder.WriteString(_m.EmbeddingHash)
+	builder.WriteString(", ")
+	builder.WriteString("schema_json=")
+	builder.WriteString(_m.SchemaJSON)
+	builder.WriteString(", ")
+	if v := _m.CategoryID; v != nil {
+		builder.WriteString("category_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.AuthorID; v != nil {
+		builder.WriteString("author_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.FeaturedImageID; v != nil {
+		builder.WriteString("featured_image_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("word_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WordCount))
+	builder.WriteString(", ")
+	builder.WriteString("char_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CharCount))
+	builder.WriteString(", ")
+	builder.WriteString("reading_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReadingMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("excerpt=")
+	builder.WriteString(_m.Excerpt)
+	builder.WriteString(", ")
+	builder.WriteString("meta_description=")
+	builder.WriteString(_m.MetaDescription)
+	builder.WriteString(", ")
+	builder.WriteString("excerpt_custom=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExcerptCustom))
+	builder.WriteString(", ")
+	builder.WriteString("meta_description_custom=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MetaDescriptionCustom))
+	builder.WriteString(", ")
+	builder.WriteString("keywords=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Keywords))
+	builder.WriteString(", ")
+	builder.WriteString("disable_cta=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DisableCta))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
 	return builder.String()
 }
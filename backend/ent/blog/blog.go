@@ -3,7 +3,11 @@
 package blog
 
 import (
+	"fmt"
+	"time"
+
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -15,12 +19,91 @@ const (
 	FieldCategory = "category"
 	// FieldText holds the string denoting the text field in the database.
 	FieldText = "text"
+	// FieldFormat holds the string denoting the format field in the database.
+	FieldFormat = "format"
+	// FieldSource holds the string denoting the source field in the database.
+	FieldSource = "source"
 	// FieldPath holds the string denoting the path field in the database.
 	FieldPath = "path"
 	// FieldEmbedding holds the string denoting the embedding field in the database.
 	FieldEmbedding = "embedding"
+	// FieldEmbeddingHash holds the string denoting the embedding_hash field in the database.
+	FieldEmbeddingHash = "embedding_hash"
+	// FieldSchemaJSON holds the string denoting the schema_json field in the database.
+	FieldSchemaJSON = "schema_json"
+	// FieldCategoryID holds the string denoting the category_id field in the database.
+	FieldCategoryID = "category_id"
+	// FieldAuthorID holds the string denoting the author_id field in the database.
+	FieldAuthorID = "author_id"
+	// FieldFeaturedImageID holds the string denoting the featured_image_id field in the database.
+	FieldFeaturedImageID = "featured_image_id"
+	// FieldWordCount holds the string denoting the word_count field in the database.
+	FieldWordCount = "word_count"
+	// FieldCharCount holds the string denoting the char_count field in the database.
+	FieldCharCount = "char_count"
+	// FieldReadingMinutes holds the string denoting the reading_minutes field in the database.
+	FieldReadingMinutes = "reading_minutes"
+	// FieldExcerpt holds the string denoting the excerpt field in the database.
+	FieldExcerpt = "excerpt"
+	// FieldMetaDescription holds the string denoting the meta_description field in the database.
+	FieldMetaDescription = "meta_description"
+	// FieldExcerptCustom holds the string denoting the excerpt_custom field in the database.
+	FieldExcerptCustom = "excerpt_custom"
+	// FieldMetaDescriptionCustom holds the string denoting the meta_description_custom field in the database.
+	FieldMetaDescriptionCustom = "meta_description_custom"
+	// FieldKeywords holds the string denoting the keywords field in the database.
+	FieldKeywords = "keywords"
+	// FieldDisableCta holds the string denoting the disable_cta field in the database.
+	FieldDisableCta = "disable_cta"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgePrimaryCategory holds the string denoting the primary_category edge name in mutations.
+	EdgePrimaryCategory = "primary_category"
+	// EdgeTags holds the string denoting the tags edge name in mutations.
+	EdgeTags = "tags"
+	// EdgeAuthor holds the string denoting the author edge name in mutations.
+	EdgeAuthor = "author"
+	// EdgeFeaturedImage holds the string denoting the featured_image edge name in mutations.
+	EdgeFeaturedImage = "featured_image"
+	// EdgeChunks holds the string denoting the chunks edge name in mutations.
+	EdgeChunks = "chunks"
 	// Table holds the table name of the blog in the database.
 	Table = "blogs"
+	// PrimaryCategoryTable is the table that holds the primary_category relation/edge.
+	PrimaryCategoryTable = "blogs"
+	// PrimaryCategoryInverseTable is the table name for the Category entity.
+	// It exists in this package in order to avoid circular dependency with the "category" package.
+	PrimaryCategoryInverseTable = "categories"
+	// PrimaryCategoryColumn is the table column denoting the primary_category relation/edge.
+	PrimaryCategoryColumn = "category_id"
+	// TagsTable is the table that holds the tags relation/edge. The primary key declared below.
+	TagsTable = "blog_tags"
+	// TagsInverseTable is the table name for the Tag entity.
+	// It exists in this package in order to avoid circular dependency with the "tag" package.
+	TagsInverseTable = "tags"
+	// AuthorTable is the table that holds the author relation/edge.
+	AuthorTable = "blogs"
+	// AuthorInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	AuthorInverseTable = "users"
+	// AuthorColumn is the table column denoting the author relation/edge.
+	AuthorColumn = "author_id"
+	// FeaturedImageTable is the table that holds the featured_image relation/edge.
+	FeaturedImageTable = "blogs"
+	// FeaturedImageInverseTable is the table name for the Media entity.
+	// It exists in this package in order to avoid circular dependency with the "media" package.
+	FeaturedImageInverseTable = "media"
+	// FeaturedImageColumn is the table column denoting the featured_image relation/edge.
+	FeaturedImageColumn = "featured_image_id"
+	// ChunksTable is the table that holds the chunks relation/edge.
+	ChunksTable = "blog_chunks"
+	// ChunksInverseTable is the table name for the BlogChunk entity.
+	// It exists in this package in order to avoid circular dependency with the "blogchunk" package.
+	ChunksInverseTable = "blog_chunks"
+	// ChunksColumn is the table column denoting the chunks relation/edge.
+	ChunksColumn = "blog_id"
 )
 
 // Columns holds all SQL columns for blog fields.
@@ -28,10 +111,34 @@ var Columns = []string{
 	FieldID,
 	FieldCategory,
 	FieldText,
+	FieldFormat,
+	FieldSource,
 	FieldPath,
 	FieldEmbedding,
+	FieldEmbeddingHash,
+	FieldSchemaJSON,
+	FieldCategoryID,
+	FieldAuthorID,
+	FieldFeaturedImageID,
+	FieldWordCount,
+	FieldCharCount,
+	FieldReadingMinutes,
+	FieldExcerpt,
+	FieldMetaDescription,
+	FieldExcerptCustom,
+	FieldMetaDescriptionCustom,
+	FieldKeywords,
+	FieldDisableCta,
+	FieldCreatedAt,
+	FieldUpdatedAt,
 }
 
+var (
+	// TagsPrimaryKey and TagsColumn2 are the table columns denoting the
+	// primary key for the tags relation (M2M).
+	TagsPrimaryKey = []string{"blog_id", "tag_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -47,8 +154,58 @@ var (
 	CategoryValidator func(string) error
 	// PathValidator is a validator for the "path" field. It is called by the builders before save.
 	PathValidator func(string) error
+	// DefaultWordCount holds the default value on creation for the "word_count" field.
+	DefaultWordCount int
+	// WordCountValidator is a validator for the "word_count" field. It is called by the builders before save.
+	WordCountValidator func(int) error
+	// DefaultCharCount holds the default value on creation for the "char_count" field.
+	DefaultCharCount int
+	// CharCountValidator is a validator for the "char_count" field. It is called by the builders before save.
+	CharCountValidator func(int) error
+	// DefaultReadingMinutes holds the default value on creation for the "reading_minutes" field.
+	DefaultReadingMinutes int
+	// ReadingMinutesValidator is a validator for the "reading_minutes" field. It is called by the builders before save.
+	ReadingMinutesValidator func(int) error
+	// DefaultExcerptCustom holds the default value on creation for the "excerpt_custom" field.
+	DefaultExcerptCustom bool
+	// DefaultMetaDescriptionCustom holds the default value on creation for the "meta_description_custom" field.
+	DefaultMetaDescriptionCustom bool
+	// DefaultDisableCta holds the default value on creation for the "disable_cta" field.
+	DefaultDisableCta bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Format defines the type for the "format" enum field.
+type Format string
+
+// FormatHTML is the default value of the Format enum.
+const DefaultFormat = FormatHTML
+
+// Format values.
+const (
+	FormatHTML     Format = "html"
+	FormatMarkdown Format = "markdown"
 )
 
+func (f Format) String() string {
+	return string(f)
+}
+
+// FormatValidator is a validator for the "format" field enum values. It is called by the builders before save.
+func FormatValidator(f Format) error {
+	switch f {
+	case FormatHTML, FormatMarkdown:
+		return nil
+	default:
+		return fmt.Errorf("blog: invalid enum value for format field: %q", f)
+	}
+}
+
 // OrderOption defines the ordering options for the Blog queries.
 type OrderOption func(*sql.Selector)
 
@@ -67,7 +224,176 @@ func ByText(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldText, opts...).ToFunc()
 }
 
+// ByFormat orders the results by the format field.
+func ByFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFormat, opts...).ToFunc()
+}
+
+// BySource orders the results by the source field.
+func BySource(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSource, opts...).ToFunc()
+}
+
 // ByPath orders the results by the path field.
 func ByPath(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPath, opts...).ToFunc()
 }
+
+// ByEmbeddingHash orders the results by the embedding_hash field.
+func ByEmbeddingHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmbeddingHash, opts...).ToFunc()
+}
+
+// BySchemaJSON orders the results by the schema_json field.
+func BySchemaJSON(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSchemaJSON, opts...).ToFunc()
+}
+
+// ByCategoryID orders the results by the category_id field.
+func ByCategoryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategoryID, opts...).ToFunc()
+}
+
+// ByAuthorID orders the results by the author_id field.
+func ByAuthorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAuthorID, opts...).ToFunc()
+}
+
+// ByFeaturedImageID orders the results by the featured_image_id field.
+func ByFeaturedImageID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFeaturedImageID, opts...).ToFunc()
+}
+
+// ByWordCount orders the results by the word_count field.
+func ByWordCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWordCount, opts...).ToFunc()
+}
+
+// ByCharCount orders the results by the char_count field.
+func ByCharCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCharCount, opts...).ToFunc()
+}
+
+// ByReadingMinutes orders the results by the reading_minutes field.
+func ByReadingMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReadingMinutes, opts...).ToFunc()
+}
+
+// ByExcerpt orders the results by the excerpt field.
+func ByExcerpt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExcerpt, opts...).ToFunc()
+}
+
+// ByMetaDescription orders the results by the meta_description field.
+func ByMetaDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMetaDescription, opts...).ToFunc()
+}
+
+// ByExcerptCustom orders the results by the excerpt_custom field.
+func ByExcerptCustom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExcerptCustom, opts...).ToFunc()
+}
+
+// ByMetaDescriptionCustom orders the results by the meta_description_custom field.
+func ByMetaDescriptionCustom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMetaDescriptionCustom, opts...).ToFunc()
+}
+
+// ByDisableCta orders the results by the disable_cta field.
+func ByDisableCta(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisableCta, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByPrimaryCategoryField orders the results by primary_category field.
+func ByPrimaryCategoryField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPrimaryCategoryStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByTagsCount orders the results by tags count.
+func ByTagsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTagsStep(), opts...)
+	}
+}
+
+// ByTags orders the results by tags terms.
+func ByTags(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTagsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAuthorField orders the results by author field.
+func ByAuthorField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAuthorStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByFeaturedImageField orders the results by featured_image field.
+func ByFeaturedImageField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFeaturedImageStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByChunksCount orders the results by chunks count.
+func ByChunksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChunksStep(), opts...)
+	}
+}
+
+// ByChunks orders the results by chunks terms.
+func ByChunks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChunksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newPrimaryCategoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PrimaryCategoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PrimaryCategoryTable, PrimaryCategoryColumn),
+	)
+}
+func newTagsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TagsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, TagsTable, TagsPrimaryKey...),
+	)
+}
+func newAuthorStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AuthorInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AuthorTable, AuthorColumn),
+	)
+}
+func newFeaturedImageStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FeaturedImageInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, FeaturedImageTable, FeaturedImageColumn),
+	)
+}
+func newChunksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChunksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChunksTable, ChunksColumn),
+	)
+}
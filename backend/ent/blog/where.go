@@ -4,8 +4,10 @@ package blog
 
 import (
 	"landing/backend/ent/predicate"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // ID filters vertices based on their ID field.
@@ -63,11 +65,91 @@ func Text(v string) predicate.Blog {
 	return predicate.Blog(sql.FieldEQ(FieldText, v))
 }
 
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldSource, v))
+}
+
 // Path applies equality check predicate on the "path" field. It's identical to PathEQ.
 func Path(v string) predicate.Blog {
 	return predicate.Blog(sql.FieldEQ(FieldPath, v))
 }
 
+// EmbeddingHash applies equality check predicate on the "embedding_hash" field. It's identical to EmbeddingHashEQ.
+func EmbeddingHash(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldEmbeddingHash, v))
+}
+
+// SchemaJSON applies equality check predicate on the "schema_json" field. It's identical to SchemaJSONEQ.
+func SchemaJSON(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldSchemaJSON, v))
+}
+
+// CategoryID applies equality check predicate on the "category_id" field. It's identical to CategoryIDEQ.
+func CategoryID(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// AuthorID applies equality check predicate on the "author_id" field. It's identical to AuthorIDEQ.
+func AuthorID(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldAuthorID, v))
+}
+
+// FeaturedImageID applies equality check predicate on the "featured_image_id" field. It's identical to FeaturedImageIDEQ.
+func FeaturedImageID(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldFeaturedImageID, v))
+}
+
+// WordCount applies equality check predicate on the "word_count" field. It's identical to WordCountEQ.
+func WordCount(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldWordCount, v))
+}
+
+// CharCount applies equality check predicate on the "char_count" field. It's identical to CharCountEQ.
+func CharCount(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldCharCount, v))
+}
+
+// ReadingMinutes applies equality check predicate on the "reading_minutes" field. It's identical to ReadingMinutesEQ.
+func ReadingMinutes(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldReadingMinutes, v))
+}
+
+// Excerpt applies equality check predicate on the "excerpt" field. It's identical to ExcerptEQ.
+func Excerpt(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldExcerpt, v))
+}
+
+// MetaDescription applies equality check predicate on the "meta_description" field. It's identical to MetaDescriptionEQ.
+func MetaDescription(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldMetaDescription, v))
+}
+
+// ExcerptCustom applies equality check predicate on the "excerpt_custom" field. It's identical to ExcerptCustomEQ.
+func ExcerptCustom(v bool) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldExcerptCustom, v))
+}
+
+// MetaDescriptionCustom applies equality check predicate on the "meta_description_custom" field. It's identical to MetaDescriptionCustomEQ.
+func MetaDescriptionCustom(v bool) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldMetaDescriptionCustom, v))
+}
+
+// DisableCta applies equality check predicate on the "disable_cta" field. It's identical to DisableCtaEQ.
+func DisableCta(v bool) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldDisableCta, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
 // CategoryEQ applies the EQ predicate on the "category" field.
 func CategoryEQ(v string) predicate.Blog {
 	return predicate.Blog(sql.FieldEQ(FieldCategory, v))
@@ -198,6 +280,101 @@ func TextContainsFold(v string) predicate.Blog {
 	return predicate.Blog(sql.FieldContainsFold(FieldText, v))
 }
 
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v Format) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v Format) predicate.Blog {
+	return predicate.Blog(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...Format) predicate.Blog {
+	return predicate.Blog(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...Format) predicate.Blog {
+	return predicate.Blog(sql.FieldNotIn(FieldFormat, vs...))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.Blog {
+	return predicate.Blog(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.Blog {
+	return predicate.Blog(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceIsNil applies the IsNil predicate on the "source" field.
+func SourceIsNil() predicate.Blog {
+	return predicate.Blog(sql.FieldIsNull(FieldSource))
+}
+
+// SourceNotNil applies the NotNil predicate on the "source" field.
+func SourceNotNil() predicate.Blog {
+	return predicate.Blog(sql.FieldNotNull(FieldSource))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldContainsFold(FieldSource, v))
+}
+
 // PathEQ applies the EQ predicate on the "path" field.
 func PathEQ(v string) predicate.Blog {
 	return predicate.Blog(sql.FieldEQ(FieldPath, v))
@@ -273,6 +450,751 @@ func EmbeddingNotNil() predicate.Blog {
 	return predicate.Blog(sql.FieldNotNull(FieldEmbedding))
 }
 
+// EmbeddingHashEQ applies the EQ predicate on the "embedding_hash" field.
+func EmbeddingHashEQ(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashNEQ applies the NEQ predicate on the "embedding_hash" field.
+func EmbeddingHashNEQ(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldNEQ(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashIn applies the In predicate on the "embedding_hash" field.
+func EmbeddingHashIn(vs ...string) predicate.Blog {
+	return predicate.Blog(sql.FieldIn(FieldEmbeddingHash, vs...))
+}
+
+// EmbeddingHashNotIn applies the NotIn predicate on the "embedding_hash" field.
+func EmbeddingHashNotIn(vs ...string) predicate.Blog {
+	return predicate.Blog(sql.FieldNotIn(FieldEmbeddingHash, vs...))
+}
+
+// EmbeddingHashGT applies the GT predicate on the "embedding_hash" field.
+func EmbeddingHashGT(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldGT(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashGTE applies the GTE predicate on the "embedding_hash" field.
+func EmbeddingHashGTE(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldGTE(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashLT applies the LT predicate on the "embedding_hash" field.
+func EmbeddingHashLT(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldLT(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashLTE applies the LTE predicate on the "embedding_hash" field.
+func EmbeddingHashLTE(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldLTE(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashContains applies the Contains predicate on the "embedding_hash" field.
+func EmbeddingHashContains(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldContains(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashHasPrefix applies the HasPrefix predicate on the "embedding_hash" field.
+func EmbeddingHashHasPrefix(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldHasPrefix(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashHasSuffix applies the HasSuffix predicate on the "embedding_hash" field.
+func EmbeddingHashHasSuffix(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldHasSuffix(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashIsNil applies the IsNil predicate on the "embedding_hash" field.
+func EmbeddingHashIsNil() predicate.Blog {
+	return predicate.Blog(sql.FieldIsNull(FieldEmbeddingHash))
+}
+
+// EmbeddingHashNotNil applies the NotNil predicate on the "embedding_hash" field.
+func EmbeddingHashNotNil() predicate.Blog {
+	return predicate.Blog(sql.FieldNotNull(FieldEmbeddingHash))
+}
+
+// EmbeddingHashEqualFold applies the EqualFold predicate on the "embedding_hash" field.
+func EmbeddingHashEqualFold(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldEqualFold(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashContainsFold applies the ContainsFold predicate on the "embedding_hash" field.
+func EmbeddingHashContainsFold(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldContainsFold(FieldEmbeddingHash, v))
+}
+
+// SchemaJSONEQ applies the EQ predicate on the "schema_json" field.
+func SchemaJSONEQ(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldSchemaJSON, v))
+}
+
+// SchemaJSONNEQ applies the NEQ predicate on the "schema_json" field.
+func SchemaJSONNEQ(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldNEQ(FieldSchemaJSON, v))
+}
+
+// SchemaJSONIn applies the In predicate on the "schema_json" field.
+func SchemaJSONIn(vs ...string) predicate.Blog {
+	return predicate.Blog(sql.FieldIn(FieldSchemaJSON, vs...))
+}
+
+// SchemaJSONNotIn applies the NotIn predicate on the "schema_json" field.
+func SchemaJSONNotIn(vs ...string) predicate.Blog {
+	return predicate.Blog(sql.FieldNotIn(FieldSchemaJSON, vs...))
+}
+
+// SchemaJSONGT applies the GT predicate on the "schema_json" field.
+func SchemaJSONGT(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldGT(FieldSchemaJSON, v))
+}
+
+// SchemaJSONGTE applies the GTE predicate on the "schema_json" field.
+func SchemaJSONGTE(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldGTE(FieldSchemaJSON, v))
+}
+
+// SchemaJSONLT applies the LT predicate on the "schema_json" field.
+func SchemaJSONLT(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldLT(FieldSchemaJSON, v))
+}
+
+// SchemaJSONLTE applies the LTE predicate on the "schema_json" field.
+func SchemaJSONLTE(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldLTE(FieldSchemaJSON, v))
+}
+
+// SchemaJSONContains applies the Contains predicate on the "schema_json" field.
+func SchemaJSONContains(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldContains(FieldSchemaJSON, v))
+}
+
+// SchemaJSONHasPrefix applies the HasPrefix predicate on the "schema_json" field.
+func SchemaJSONHasPrefix(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldHasPrefix(FieldSchemaJSON, v))
+}
+
+// SchemaJSONHasSuffix applies the HasSuffix predicate on the "schema_json" field.
+func SchemaJSONHasSuffix(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldHasSuffix(FieldSchemaJSON, v))
+}
+
+// SchemaJSONIsNil applies the IsNil predicate on the "schema_json" field.
+func SchemaJSONIsNil() predicate.Blog {
+	return predicate.Blog(sql.FieldIsNull(FieldSchemaJSON))
+}
+
+// SchemaJSONNotNil applies the NotNil predicate on the "schema_json" field.
+func SchemaJSONNotNil() predicate.Blog {
+	return predicate.Blog(sql.FieldNotNull(FieldSchemaJSON))
+}
+
+// SchemaJSONEqualFold applies the EqualFold predicate on the "schema_json" field.
+func SchemaJSONEqualFold(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldEqualFold(FieldSchemaJSON, v))
+}
+
+// SchemaJSONContainsFold applies the ContainsFold predicate on the "schema_json" field.
+func SchemaJSONContainsFold(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldContainsFold(FieldSchemaJSON, v))
+}
+
+// CategoryIDEQ applies the EQ predicate on the "category_id" field.
+func CategoryIDEQ(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// CategoryIDNEQ applies the NEQ predicate on the "category_id" field.
+func CategoryIDNEQ(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldNEQ(FieldCategoryID, v))
+}
+
+// CategoryIDIn applies the In predicate on the "category_id" field.
+func CategoryIDIn(vs ...int) predicate.Blog {
+	return predicate.Blog(sql.FieldIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDNotIn applies the NotIn predicate on the "category_id" field.
+func CategoryIDNotIn(vs ...int) predicate.Blog {
+	return predicate.Blog(sql.FieldNotIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDIsNil applies the IsNil predicate on the "category_id" field.
+func CategoryIDIsNil() predicate.Blog {
+	return predicate.Blog(sql.FieldIsNull(FieldCategoryID))
+}
+
+// CategoryIDNotNil applies the NotNil predicate on the "category_id" field.
+func CategoryIDNotNil() predicate.Blog {
+	return predicate.Blog(sql.FieldNotNull(FieldCategoryID))
+}
+
+// AuthorIDEQ applies the EQ predicate on the "author_id" field.
+func AuthorIDEQ(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldAuthorID, v))
+}
+
+// AuthorIDNEQ applies the NEQ predicate on the "author_id" field.
+func AuthorIDNEQ(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldNEQ(FieldAuthorID, v))
+}
+
+// AuthorIDIn applies the In predicate on the "author_id" field.
+func AuthorIDIn(vs ...int) predicate.Blog {
+	return predicate.Blog(sql.FieldIn(FieldAuthorID, vs...))
+}
+
+// AuthorIDNotIn applies the NotIn predicate on the "author_id" field.
+func AuthorIDNotIn(vs ...int) predicate.Blog {
+	return predicate.Blog(sql.FieldNotIn(FieldAuthorID, vs...))
+}
+
+// AuthorIDIsNil applies the IsNil predicate on the "author_id" field.
+func AuthorIDIsNil() predicate.Blog {
+	return predicate.Blog(sql.FieldIsNull(FieldAuthorID))
+}
+
+// AuthorIDNotNil applies the NotNil predicate on the "author_id" field.
+func AuthorIDNotNil() predicate.Blog {
+	return predicate.Blog(sql.FieldNotNull(FieldAuthorID))
+}
+
+// FeaturedImageIDEQ applies the EQ predicate on the "featured_image_id" field.
+func FeaturedImageIDEQ(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldFeaturedImageID, v))
+}
+
+// FeaturedImageIDNEQ applies the NEQ predicate on the "featured_image_id" field.
+func FeaturedImageIDNEQ(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldNEQ(FieldFeaturedImageID, v))
+}
+
+// FeaturedImageIDIn applies the In predicate on the "featured_image_id" field.
+func FeaturedImageIDIn(vs ...int) predicate.Blog {
+	return predicate.Blog(sql.FieldIn(FieldFeaturedImageID, vs...))
+}
+
+// FeaturedImageIDNotIn applies the NotIn predicate on the "featured_image_id" field.
+func FeaturedImageIDNotIn(vs ...int) predicate.Blog {
+	return predicate.Blog(sql.FieldNotIn(FieldFeaturedImageID, vs...))
+}
+
+// FeaturedImageIDIsNil applies the IsNil predicate on the "featured_image_id" field.
+func FeaturedImageIDIsNil() predicate.Blog {
+	return predicate.Blog(sql.FieldIsNull(FieldFeaturedImageID))
+}
+
+// FeaturedImageIDNotNil applies the NotNil predicate on the "featured_image_id" field.
+func FeaturedImageIDNotNil() predicate.Blog {
+	return predicate.Blog(sql.FieldNotNull(FieldFeaturedImageID))
+}
+
+// WordCountEQ applies the EQ predicate on the "word_count" field.
+func WordCountEQ(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldWordCount, v))
+}
+
+// WordCountNEQ applies the NEQ predicate on the "word_count" field.
+func WordCountNEQ(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldNEQ(FieldWordCount, v))
+}
+
+// WordCountIn applies the In predicate on the "word_count" field.
+func WordCountIn(vs ...int) predicate.Blog {
+	return predicate.Blog(sql.FieldIn(FieldWordCount, vs...))
+}
+
+// WordCountNotIn applies the NotIn predicate on the "word_count" field.
+func WordCountNotIn(vs ...int) predicate.Blog {
+	return predicate.Blog(sql.FieldNotIn(FieldWordCount, vs...))
+}
+
+// WordCountGT applies the GT predicate on the "word_count" field.
+func WordCountGT(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldGT(FieldWordCount, v))
+}
+
+// WordCountGTE applies the GTE predicate on the "word_count" field.
+func WordCountGTE(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldGTE(FieldWordCount, v))
+}
+
+// WordCountLT applies the LT predicate on the "word_count" field.
+func WordCountLT(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldLT(FieldWordCount, v))
+}
+
+// WordCountLTE applies the LTE predicate on the "word_count" field.
+func WordCountLTE(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldLTE(FieldWordCount, v))
+}
+
+// CharCountEQ applies the EQ predicate on the "char_count" field.
+func CharCountEQ(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldCharCount, v))
+}
+
+// CharCountNEQ applies the NEQ predicate on the "char_count" field.
+func CharCountNEQ(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldNEQ(FieldCharCount, v))
+}
+
+// CharCountIn applies the In predicate on the "char_count" field.
+func CharCountIn(vs ...int) predicate.Blog {
+	return predicate.Blog(sql.FieldIn(FieldCharCount, vs...))
+}
+
+// CharCountNotIn applies the NotIn predicate on the "char_count" field.
+func CharCountNotIn(vs ...int) predicate.Blog {
+	return predicate.Blog(sql.FieldNotIn(FieldCharCount, vs...))
+}
+
+// CharCountGT applies the GT predicate on the "char_count" field.
+func CharCountGT(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldGT(FieldCharCount, v))
+}
+
+// CharCountGTE applies the GTE predicate on the "char_count" field.
+func CharCountGTE(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldGTE(FieldCharCount, v))
+}
+
+// CharCountLT applies the LT predicate on the "char_count" field.
+func CharCountLT(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldLT(FieldCharCount, v))
+}
+
+// CharCountLTE applies the LTE predicate on the "char_count" field.
+func CharCountLTE(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldLTE(FieldCharCount, v))
+}
+
+// ReadingMinutesEQ applies the EQ predicate on the "reading_minutes" field.
+func ReadingMinutesEQ(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldReadingMinutes, v))
+}
+
+// ReadingMinutesNEQ applies the NEQ predicate on the "reading_minutes" field.
+func ReadingMinutesNEQ(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldNEQ(FieldReadingMinutes, v))
+}
+
+// ReadingMinutesIn applies the In predicate on the "reading_minutes" field.
+func ReadingMinutesIn(vs ...int) predicate.Blog {
+	return predicate.Blog(sql.FieldIn(FieldReadingMinutes, vs...))
+}
+
+// ReadingMinutesNotIn applies the NotIn predicate on the "reading_minutes" field.
+func ReadingMinutesNotIn(vs ...int) predicate.Blog {
+	return predicate.Blog(sql.FieldNotIn(FieldReadingMinutes, vs...))
+}
+
+// ReadingMinutesGT applies the GT predicate on the "reading_minutes" field.
+func ReadingMinutesGT(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldGT(FieldReadingMinutes, v))
+}
+
+// ReadingMinutesGTE applies the GTE predicate on the "reading_minutes" field.
+func ReadingMinutesGTE(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldGTE(FieldReadingMinutes, v))
+}
+
+// ReadingMinutesLT applies the LT predicate on the "reading_minutes" field.
+func ReadingMinutesLT(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldLT(FieldReadingMinutes, v))
+}
+
+// ReadingMinutesLTE applies the LTE predicate on the "reading_minutes" field.
+func ReadingMinutesLTE(v int) predicate.Blog {
+	return predicate.Blog(sql.FieldLTE(FieldReadingMinutes, v))
+}
+
+// ExcerptEQ applies the EQ predicate on the "excerpt" field.
+func ExcerptEQ(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldExcerpt, v))
+}
+
+// ExcerptNEQ applies the NEQ predicate on the "excerpt" field.
+func ExcerptNEQ(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldNEQ(FieldExcerpt, v))
+}
+
+// ExcerptIn applies the In predicate on the "excerpt" field.
+func ExcerptIn(vs ...string) predicate.Blog {
+	return predicate.Blog(sql.FieldIn(FieldExcerpt, vs...))
+}
+
+// ExcerptNotIn applies the NotIn predicate on the "excerpt" field.
+func ExcerptNotIn(vs ...string) predicate.Blog {
+	return predicate.Blog(sql.FieldNotIn(FieldExcerpt, vs...))
+}
+
+// ExcerptGT applies the GT predicate on the "excerpt" field.
+func ExcerptGT(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldGT(FieldExcerpt, v))
+}
+
+// ExcerptGTE applies the GTE predicate on the "excerpt" field.
+func ExcerptGTE(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldGTE(FieldExcerpt, v))
+}
+
+// ExcerptLT applies the LT predicate on the "excerpt" field.
+func ExcerptLT(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldLT(FieldExcerpt, v))
+}
+
+// ExcerptLTE applies the LTE predicate on the "excerpt" field.
+func ExcerptLTE(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldLTE(FieldExcerpt, v))
+}
+
+// ExcerptContains applies the Contains predicate on the "excerpt" field.
+func ExcerptContains(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldContains(FieldExcerpt, v))
+}
+
+// ExcerptHasPrefix applies the HasPrefix predicate on the "excerpt" field.
+func ExcerptHasPrefix(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldHasPrefix(FieldExcerpt, v))
+}
+
+// ExcerptHasSuffix applies the HasSuffix predicate on the "excerpt" field.
+func ExcerptHasSuffix(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldHasSuffix(FieldExcerpt, v))
+}
+
+// ExcerptIsNil applies the IsNil predicate on the "excerpt" field.
+func ExcerptIsNil() predicate.Blog {
+	return predicate.Blog(sql.FieldIsNull(FieldExcerpt))
+}
+
+// ExcerptNotNil applies the NotNil predicate on the "excerpt" field.
+func ExcerptNotNil() predicate.Blog {
+	return predicate.Blog(sql.FieldNotNull(FieldExcerpt))
+}
+
+// ExcerptEqualFold applies the EqualFold predicate on the "excerpt" field.
+func ExcerptEqualFold(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldEqualFold(FieldExcerpt, v))
+}
+
+// ExcerptContainsFold applies the ContainsFold predicate on the "excerpt" field.
+func ExcerptContainsFold(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldContainsFold(FieldExcerpt, v))
+}
+
+// MetaDescriptionEQ applies the EQ predicate on the "meta_description" field.
+func MetaDescriptionEQ(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldMetaDescription, v))
+}
+
+// MetaDescriptionNEQ applies the NEQ predicate on the "meta_description" field.
+func MetaDescriptionNEQ(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldNEQ(FieldMetaDescription, v))
+}
+
+// MetaDescriptionIn applies the In predicate on the "meta_description" field.
+func MetaDescriptionIn(vs ...string) predicate.Blog {
+	return predicate.Blog(sql.FieldIn(FieldMetaDescription, vs...))
+}
+
+// MetaDescriptionNotIn applies the NotIn predicate on the "meta_description" field.
+func MetaDescriptionNotIn(vs ...string) predicate.Blog {
+	return predicate.Blog(sql.FieldNotIn(FieldMetaDescription, vs...))
+}
+
+// MetaDescriptionGT applies the GT predicate on the "meta_description" field.
+func MetaDescriptionGT(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldGT(FieldMetaDescription, v))
+}
+
+// MetaDescriptionGTE applies the GTE predicate on the "meta_description" field.
+func MetaDescriptionGTE(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldGTE(FieldMetaDescription, v))
+}
+
+// MetaDescriptionLT applies the LT predicate on the "meta_description" field.
+func MetaDescriptionLT(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldLT(FieldMetaDescription, v))
+}
+
+// MetaDescriptionLTE applies the LTE predicate on the "meta_description" field.
+func MetaDescriptionLTE(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldLTE(FieldMetaDescription, v))
+}
+
+// MetaDescriptionContains applies the Contains predicate on the "meta_description" field.
+func MetaDescriptionContains(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldContains(FieldMetaDescription, v))
+}
+
+// MetaDescriptionHasPrefix applies the HasPrefix predicate on the "meta_description" field.
+func MetaDescriptionHasPrefix(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldHasPrefix(FieldMetaDescription, v))
+}
+
+// MetaDescriptionHasSuffix applies the HasSuffix predicate on the "meta_description" field.
+func MetaDescriptionHasSuffix(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldHasSuffix(FieldMetaDescription, v))
+}
+
+// MetaDescriptionIsNil applies the IsNil predicate on the "meta_description" field.
+func MetaDescriptionIsNil() predicate.Blog {
+	return predicate.Blog(sql.FieldIsNull(FieldMetaDescription))
+}
+
+// MetaDescriptionNotNil applies the NotNil predicate on the "meta_description" field.
+func MetaDescriptionNotNil() predicate.Blog {
+	return predicate.Blog(sql.FieldNotNull(FieldMetaDescription))
+}
+
+// MetaDescriptionEqualFold applies the EqualFold predicate on the "meta_description" field.
+func MetaDescriptionEqualFold(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldEqualFold(FieldMetaDescription, v))
+}
+
+// MetaDescriptionContainsFold applies the ContainsFold predicate on the "meta_description" field.
+func MetaDescriptionContainsFold(v string) predicate.Blog {
+	return predicate.Blog(sql.FieldContainsFold(FieldMetaDescription, v))
+}
+
+// ExcerptCustomEQ applies the EQ predicate on the "excerpt_custom" field.
+func ExcerptCustomEQ(v bool) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldExcerptCustom, v))
+}
+
+// ExcerptCustomNEQ applies the NEQ predicate on the "excerpt_custom" field.
+func ExcerptCustomNEQ(v bool) predicate.Blog {
+	return predicate.Blog(sql.FieldNEQ(FieldExcerptCustom, v))
+}
+
+// MetaDescriptionCustomEQ applies the EQ predicate on the "meta_description_custom" field.
+func MetaDescriptionCustomEQ(v bool) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldMetaDescriptionCustom, v))
+}
+
+// MetaDescriptionCustomNEQ applies the NEQ predicate on the "meta_description_custom" field.
+func MetaDescriptionCustomNEQ(v bool) predicate.Blog {
+	return predicate.Blog(sql.FieldNEQ(FieldMetaDescriptionCustom, v))
+}
+
+// KeywordsIsNil applies the IsNil predicate on the "keywords" field.
+func KeywordsIsNil() predicate.Blog {
+	return predicate.Blog(sql.FieldIsNull(FieldKeywords))
+}
+
+// KeywordsNotNil applies the NotNil predicate on the "keywords" field.
+func KeywordsNotNil() predicate.Blog {
+	return predicate.Blog(sql.FieldNotNull(FieldKeywords))
+}
+
+// DisableCtaEQ applies the EQ predicate on the "disable_cta" field.
+func DisableCtaEQ(v bool) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldDisableCta, v))
+}
+
+// DisableCtaNEQ applies the NEQ predicate on the "disable_cta" field.
+func DisableCtaNEQ(v bool) predicate.Blog {
+	return predicate.Blog(sql.FieldNEQ(FieldDisableCta, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Blog {
+	return predicate.Blog(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Blog {
+	return predicate.Blog(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Blog {
+	return predicate.Blog(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Blog {
+	return predicate.Blog(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Blog {
+	return predicate.Blog(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Blog {
+	return predicate.Blog(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Blog {
+	return predicate.Blog(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Blog {
+	return predicate.Blog(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Blog {
+	return predicate.Blog(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Blog {
+	return predicate.Blog(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Blog {
+	return predicate.Blog(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Blog {
+	return predicate.Blog(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Blog {
+	return predicate.Blog(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Blog {
+	return predicate.Blog(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Blog {
+	return predicate.Blog(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasPrimaryCategory applies the HasEdge predicate on the "primary_category" edge.
+func HasPrimaryCategory() predicate.Blog {
+	return predicate.Blog(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PrimaryCategoryTable, PrimaryCategoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPrimaryCategoryWith applies the HasEdge predicate on the "primary_category" edge with a given conditions (other predicates).
+func HasPrimaryCategoryWith(preds ...predicate.Category) predicate.Blog {
+	return predicate.Blog(func(s *sql.Selector) {
+		step := newPrimaryCategoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTags applies the HasEdge predicate on the "tags" edge.
+func HasTags() predicate.Blog {
+	return predicate.Blog(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, TagsTable, TagsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTagsWith applies the HasEdge predicate on the "tags" edge with a given conditions (other predicates).
+func HasTagsWith(preds ...predicate.Tag) predicate.Blog {
+	return predicate.Blog(func(s *sql.Selector) {
+		step := newTagsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAuthor applies the HasEdge predicate on the "author" edge.
+func HasAuthor() predicate.Blog {
+	return predicate.Blog(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AuthorTable, AuthorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAuthorWith applies the HasEdge predicate on the "author" edge with a given conditions (other predicates).
+func HasAuthorWith(preds ...predicate.User) predicate.Blog {
+	return predicate.Blog(func(s *sql.Selector) {
+		step := newAuthorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFeaturedImage applies the HasEdge predicate on the "featured_image" edge.
+func HasFeaturedImage() predicate.Blog {
+	return predicate.Blog(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, FeaturedImageTable, FeaturedImageColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFeaturedImageWith applies the HasEdge predicate on the "featured_image" edge with a given conditions (other predicates).
+func HasFeaturedImageWith(preds ...predicate.Media) predicate.Blog {
+	return predicate.Blog(func(s *sql.Selector) {
+		step := newFeaturedImageStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChunks applies the HasEdge predicate on the "chunks" edge.
+func HasChunks() predicate.Blog {
+	return predicate.Blog(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChunksTable, ChunksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChunksWith applies the HasEdge predicate on the "chunks" edge with a given conditions (other predicates).
+func HasChunksWith(preds ...predicate.BlogChunk) predicate.Blog {
+	return predicate.Blog(func(s *sql.Selector) {
+		step := newChunksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Blog) predicate.Blog {
 	return predicate.Blog(sql.AndPredicates(predicates...))
@@ -7,6 +7,12 @@ import (
 	"errors"
 	"fmt"
 	"landing/backend/ent/blog"
+	"landing/backend/ent/blogchunk"
+	"landing/backend/ent/category"
+	"landing/backend/ent/media"
+	"landing/backend/ent/tag"
+	"landing/backend/ent/user"
+	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
@@ -31,6 +37,34 @@ func (_c *BlogCreate) SetText(v string) *BlogCreate {
 	return _c
 }
 
+// SetFormat sets the "format" field.
+func (_c *BlogCreate) SetFormat(v blog.Format) *BlogCreate {
+	_c.mutation.SetFormat(v)
+	return _c
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_c *BlogCreate) SetNillableFormat(v *blog.Format) *BlogCreate {
+	if v != nil {
+		_c.SetFormat(*v)
+	}
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *BlogCreate) SetSource(v string) *BlogCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_c *BlogCreate) SetNillableSource(v *string) *BlogCreate {
+	if v != nil {
+		_c.SetSource(*v)
+	}
+	return _c
+}
+
 // SetPath sets the "path" field.
 func (_c *BlogCreate) SetPath(v string) *BlogCreate {
 	_c.mutation.SetPath(v)
@@ -43,6 +77,281 @@ func (_c *BlogCreate) SetEmbedding(v []float32) *BlogCreate {
 	return _c
 }
 
+// SetEmbeddingHash sets the "embedding_hash" field.
+func (_c *BlogCreate) SetEmbeddingHash(v string) *BlogCreate {
+	_c.mutation.SetEmbeddingHash(v)
+	return _c
+}
+
+// SetNillableEmbeddingHash sets the "embedding_hash" field if the given value is not nil.
+func (_c *BlogCreate) SetNillableEmbeddingHash(v *string) *BlogCreate {
+	if v != nil {
+		_c.SetEmbeddingHash(*v)
+	}
+	return _c
+}
+
+// SetSchemaJSON sets the "schema_json" field.
+func (_c *BlogCreate) SetSchemaJSON(v string) *BlogCreate {
+	_c.mutation.SetSchemaJSON(v)
+	return _c
+}
+
+// SetNillableSchemaJSON sets the "schema_json" field if the given value is not nil.
+func (_c *BlogCreate) SetNillableSchemaJSON(v *string) *BlogCreate {
+	if v != nil {
+		_c.SetSchemaJSON(*v)
+	}
+	return _c
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_c *BlogCreate) SetCategoryID(v int) *BlogCreate {
+	_c.mutation.SetCategoryID(v)
+	return _c
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_c *BlogCreate) SetNillableCategoryID(v *int) *BlogCreate {
+	if v != nil {
+		_c.SetCategoryID(*v)
+	}
+	return _c
+}
+
+// SetAuthorID sets the "author_id" field.
+func (_c *BlogCreate) SetAuthorID(v int) *BlogCreate {
+	_c.mutation.SetAuthorID(v)
+	return _c
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (_c *BlogCreate) SetNillableAuthorID(v *int) *BlogCreate {
+	if v != nil {
+		_c.SetAuthorID(*v)
+	}
+	return _c
+}
+
+// SetFeaturedImageID sets the "featured_image_id" field.
+func (_c *BlogCreate) SetFeaturedImageID(v int) *BlogCreate {
+	_c.mutation.SetFeaturedImageID(v)
+	return _c
+}
+
+// SetNillableFeaturedImageID sets the "featured_image_id" field if the given value is not nil.
+func (_c *BlogCreate) SetNillableFeaturedImageID(v *int) *BlogCreate {
+	if v != nil {
+		_c.SetFeaturedImageID(*v)
+	}
+	return _c
+}
+
+// SetWordCount sets the "word_count" field.
+func (_c *BlogCreate) SetWordCount(v int) *BlogCreate {
+	_c.mutation.SetWordCount(v)
+	return _c
+}
+
+// SetNillableWordCount sets the "word_count" field if the given value is not nil.
+func (_c *BlogCreate) SetNillableWordCount(v *int) *BlogCreate {
+	if v != nil {
+		_c.SetWordCount(*v)
+	}
+	return _c
+}
+
+// SetCharCount sets the "char_count" field.
+func (_c *BlogCreate) SetCharCount(v int) *BlogCreate {
+	_c.mutation.SetCharCount(v)
+	return _c
+}
+
+// SetNillableCharCount sets the "char_count" field if the given value is not nil.
+func (_c *BlogCreate) SetNillableCharCount(v *int) *BlogCreate {
+	if v != nil {
+		_c.SetCharCount(*v)
+	}
+	return _c
+}
+
+// SetReadingMinutes sets the "reading_minutes" field.
+func (_c *BlogCreate) SetReadingMinutes(v int) *BlogCreate {
+	_c.mutation.SetReadingMinutes(v)
+	return _c
+}
+
+// SetNillableReadingMinutes sets the "reading_minutes" field if the given value is not nil.
+func (_c *BlogCreate) SetNillableReadingMinutes(v *int) *BlogCreate {
+	if v != nil {
+		_c.SetReadingMinutes(*v)
+	}
+	return _c
+}
+
+// SetExcerpt sets the "excerpt" field.
+func (_c *BlogCreate) SetExcerpt(v string) *BlogCreate {
+	_c.mutation.SetExcerpt(v)
+	return _c
+}
+
+// SetNillableExcerpt sets the "excerpt" field if the given value is not nil.
+func (_c *BlogCreate) SetNillableExcerpt(v *string) *BlogCreate {
+	if v != nil {
+		_c.SetExcerpt(*v)
+	}
+	return _c
+}
+
+// SetMetaDescription sets the "meta_description" field.
+func (_c *BlogCreate) SetMetaDescription(v string) *BlogCreate {
+	_c.mutation.SetMetaDescription(v)
+	return _c
+}
+
+// SetNillableMetaDescription sets the "meta_description" field if the given value is not nil.
+func (_c *BlogCreate) SetNillableMetaDescription(v *string) *BlogCreate {
+	if v != nil {
+		_c.SetMetaDescription(*v)
+	}
+	return _c
+}
+
+// SetExcerptCustom sets the "excerpt_custom" field.
+func (_c *BlogCreate) SetExcerptCustom(v bool) *BlogCreate {
+	_c.mutation.SetExcerptCustom(v)
+	return _c
+}
+
+// SetNillableExcerptCustom sets the "excerpt_custom" field if the given value is not nil.
+func (_c *BlogCreate) SetNillableExcerptCustom(v *bool) *BlogCreate {
+	if v != nil {
+		_c.SetExcerptCustom(*v)
+	}
+	return _c
+}
+
+// SetMetaDescriptionCustom sets the "meta_description_custom" field.
+func (_c *BlogCreate) SetMetaDescriptionCustom(v bool) *BlogCreate {
+	_c.mutation.SetMetaDescriptionCustom(v)
+	return _c
+}
+
+// SetNillableMetaDescriptionCustom sets the "meta_description_custom" field if the given value is not nil.
+func (_c *BlogCreate) SetNillableMetaDescriptionCustom(v *bool) *BlogCreate {
+	if v != nil {
+		_c.SetMetaDescriptionCustom(*v)
+	}
+	return _c
+}
+
+// SetKeywords sets the "keywords" field.
+func (_c *BlogCreate) SetKeywords(v []string) *BlogCreate {
+	_c.mutation.SetKeywords(v)
+	return _c
+}
+
+// SetDisableCta sets the "disable_cta" field.
+func (_c *BlogCreate) SetDisableCta(v bool) *BlogCreate {
+	_c.mutation.SetDisableCta(v)
+	return _c
+}
+
+// SetNillableDisableCta sets the "disable_cta" field if the given value is not nil.
+func (_c *BlogCreate) SetNillableDisableCta(v *bool) *BlogCreate {
+	if v != nil {
+		_c.SetDisableCta(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BlogCreate) SetCreatedAt(v time.Time) *BlogCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BlogCreate) SetNillableCreatedAt(v *time.Time) *BlogCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BlogCreate) SetUpdatedAt(v time.Time) *BlogCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BlogCreate) SetNillableUpdatedAt(v *time.Time) *BlogCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetPrimaryCategoryID sets the "primary_category" edge to the Category entity by ID.
+func (_c *BlogCreate) SetPrimaryCategoryID(id int) *BlogCreate {
+	_c.mutation.SetPrimaryCategoryID(id)
+	return _c
+}
+
+// SetNillablePrimaryCategoryID sets the "primary_category" edge to the Category entity by ID if the given value is not nil.
+func (_c *BlogCreate) SetNillablePrimaryCategoryID(id *int) *BlogCreate {
+	if id != nil {
+		_c = _c.SetPrimaryCategoryID(*id)
+	}
+	return _c
+}
+
+// SetPrimaryCategory sets the "primary_category" edge to the Category entity.
+func (_c *BlogCreate) SetPrimaryCategory(v *Category) *BlogCreate {
+	return _c.SetPrimaryCategoryID(v.ID)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_c *BlogCreate) AddTagIDs(ids ...int) *BlogCreate {
+	_c.mutation.AddTagIDs(ids...)
+	return _c
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_c *BlogCreate) AddTags(v ...*Tag) *BlogCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTagIDs(ids...)
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (_c *BlogCreate) SetAuthor(v *User) *BlogCreate {
+	return _c.SetAuthorID(v.ID)
+}
+
+// SetFeaturedImage sets the "featured_image" edge to the Media entity.
+func (_c *BlogCreate) SetFeaturedImage(v *Media) *BlogCreate {
+	return _c.SetFeaturedImageID(v.ID)
+}
+
+// AddChunkIDs adds the "chunks" edge to the BlogChunk entity by IDs.
+func (_c *BlogCreate) AddChunkIDs(ids ...int) *BlogCreate {
+	_c.mutation.AddChunkIDs(ids...)
+	return _c
+}
+
+// AddChunks adds the "chunks" edges to the BlogChunk entity.
+func (_c *BlogCreate) AddChunks(v ...*BlogChunk) *BlogCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChunkIDs(ids...)
+}
+
 // Mutation returns the BlogMutation object of the builder.
 func (_c *BlogCreate) Mutation() *BlogMutation {
 	return _c.mutation
@@ -50,6 +359,7 @@ func (_c *BlogCreate) Mutation() *BlogMutation {
 
 // Save creates the Blog in the database.
 func (_c *BlogCreate) Save(ctx context.Context) (*Blog, error) {
+	_c.defaults()
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -75,6 +385,46 @@ func (_c *BlogCreate) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_c *BlogCreate) defaults() {
+	if _, ok := _c.mutation.Format(); !ok {
+		v := blog.DefaultFormat
+		_c.mutation.SetFormat(v)
+	}
+	if _, ok := _c.mutation.WordCount(); !ok {
+		v := blog.DefaultWordCount
+		_c.mutation.SetWordCount(v)
+	}
+	if _, ok := _c.mutation.CharCount(); !ok {
+		v := blog.DefaultCharCount
+		_c.mutation.SetCharCount(v)
+	}
+	if _, ok := _c.mutation.ReadingMinutes(); !ok {
+		v := blog.DefaultReadingMinutes
+		_c.mutation.SetReadingMinutes(v)
+	}
+	if _, ok := _c.mutation.ExcerptCustom(); !ok {
+		v := blog.DefaultExcerptCustom
+		_c.mutation.SetExcerptCustom(v)
+	}
+	if _, ok := _c.mutation.MetaDescriptionCustom(); !ok {
+		v := blog.DefaultMetaDescriptionCustom
+		_c.mutation.SetMetaDescriptionCustom(v)
+	}
+	if _, ok := _c.mutation.DisableCta(); !ok {
+		v := blog.DefaultDisableCta
+		_c.mutation.SetDisableCta(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := blog.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := blog.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_c *BlogCreate) check() error {
 	if _, ok := _c.mutation.Category(); !ok {
@@ -88,6 +438,14 @@ func (_c *BlogCreate) check() error {
 	if _, ok := _c.mutation.Text(); !ok {
 		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "Blog.text"`)}
 	}
+	if _, ok := _c.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "Blog.format"`)}
+	}
+	if v, ok := _c.mutation.Format(); ok {
+		if err := blog.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "Blog.format": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Path(); !ok {
 		return &ValidationError{Name: "path", err: errors.New(`ent: missing required field "Blog.path"`)}
 	}
@@ -96,6 +454,45 @@ func (_c *BlogCreate) check() error {
 			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "Blog.path": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.WordCount(); !ok {
+		return &ValidationError{Name: "word_count", err: errors.New(`ent: missing required field "Blog.word_count"`)}
+	}
+	if v, ok := _c.mutation.WordCount(); ok {
+		if err := blog.WordCountValidator(v); err != nil {
+			return &ValidationError{Name: "word_count", err: fmt.Errorf(`ent: validator failed for field "Blog.word_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CharCount(); !ok {
+		return &ValidationError{Name: "char_count", err: errors.New(`ent: missing required field "Blog.char_count"`)}
+	}
+	if v, ok := _c.mutation.CharCount(); ok {
+		if err := blog.CharCountValidator(v); err != nil {
+			return &ValidationError{Name: "char_count", err: fmt.Errorf(`ent: validator failed for field "Blog.char_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ReadingMinutes(); !ok {
+		return &ValidationError{Name: "reading_minutes", err: errors.New(`ent: missing required field "Blog.reading_minutes"`)}
+	}
+	if v, ok := _c.mutation.ReadingMinutes(); ok {
+		if err := blog.ReadingMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "reading_minutes", err: fmt.Errorf(`ent: validator failed for field "Blog.reading_minutes": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExcerptCustom(); !ok {
+		return &ValidationError{Name: "excerpt_custom", err: errors.New(`ent: missing required field "Blog.excerpt_custom"`)}
+	}
+	if _, ok := _c.mutation.MetaDescriptionCustom(); !ok {
+		return &ValidationError{Name: "meta_description_custom", err: errors.New(`ent: missing required field "Blog.meta_description_custom"`)}
+	}
+	if _, ok := _c.mutation.DisableCta(); !ok {
+		return &ValidationError{Name: "disable_cta", err: errors.New(`ent: missing required field "Blog.disable_cta"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Blog.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Blog.updated_at"`)}
+	}
 	return nil
 }
 
@@ -130,6 +527,14 @@ func (_c *BlogCreate) createSpec() (*Blog, *sqlgraph.CreateSpec) {
 		_spec.SetField(blog.FieldText, field.TypeString, value)
 		_node.Text = value
 	}
+	if value, ok := _c.mutation.Format(); ok {
+		_spec.SetField(blog.FieldFormat, field.TypeEnum, value)
+		_node.Format = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(blog.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
 	if value, ok := _c.mutation.Path(); ok {
 		_spec.SetField(blog.FieldPath, field.TypeString, value)
 		_node.Path = value
@@ -138,6 +543,141 @@ func (_c *BlogCreate) createSpec() (*Blog, *sqlgraph.CreateSpec) {
 		_spec.SetField(blog.FieldEmbedding, field.TypeJSON, value)
 		_node.Embedding = value
 	}
+	if value, ok := _c.mutation.EmbeddingHash(); ok {
+		_spec.SetField(blog.FieldEmbeddingHash, field.TypeString, value)
+		_node.EmbeddingHash = value
+	}
+	if value, ok := _c.mutation.SchemaJSON(); ok {
+		_spec.SetField(blog.FieldSchemaJSON, field.TypeString, value)
+		_node.SchemaJSON = value
+	}
+	if value, ok := _c.mutation.WordCount(); ok {
+		_spec.SetField(blog.FieldWordCount, field.TypeInt, value)
+		_node.WordCount = value
+	}
+	if value, ok := _c.mutation.CharCount(); ok {
+		_spec.SetField(blog.FieldCharCount, field.TypeInt, value)
+		_node.CharCount = value
+	}
+	if value, ok := _c.mutation.ReadingMinutes(); ok {
+		_spec.SetField(blog.FieldReadingMinutes, field.TypeInt, value)
+		_node.ReadingMinutes = value
+	}
+	if value, ok := _c.mutation.Excerpt(); ok {
+		_spec.SetField(blog.FieldExcerpt, field.TypeString, value)
+		_node.Excerpt = value
+	}
+	if value, ok := _c.mutation.MetaDescription(); ok {
+		_spec.SetField(blog.FieldMetaDescription, field.TypeString, value)
+		_node.MetaDescription = value
+	}
+	if value, ok := _c.mutation.ExcerptCustom(); ok {
+		_spec.SetField(blog.FieldExcerptCustom, field.TypeBool, value)
+		_node.ExcerptCustom = value
+	}
+	if value, ok := _c.mutation.MetaDescriptionCustom(); ok {
+		_spec.SetField(blog.FieldMetaDescriptionCustom, field.TypeBool, value)
+		_node.MetaDescriptionCustom = value
+	}
+	if value, ok := _c.mutation.Keywords(); ok {
+		_spec.SetField(blog.FieldKeywords, field.TypeJSON, value)
+		_node.Keywords = value
+	}
+	if value, ok := _c.mutation.DisableCta(); ok {
+		_spec.SetField(blog.FieldDisableCta, field.TypeBool, value)
+		_node.DisableCta = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(blog.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(blog.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.PrimaryCategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blog.PrimaryCategoryTable,
+			Columns: []string{blog.PrimaryCategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CategoryID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   blog.TagsTable,
+			Columns: blog.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blog.AuthorTable,
+			Columns: []string{blog.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AuthorID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.FeaturedImageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blog.FeaturedImageTable,
+			Columns: []string{blog.FeaturedImageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.FeaturedImageID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChunksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.ChunksTable,
+			Columns: []string{blog.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogchunk.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -159,6 +699,7 @@ func (_c *BlogCreateBulk) Save(ctx context.Context) ([]*Blog, error) {
 	for i := range _c.builders {
 		func(i int, root context.Context) {
 			builder := _c.builders[i]
+			builder.defaults()
 			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
 				mutation, ok := m.(*BlogMutation)
 				if !ok {
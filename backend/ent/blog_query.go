@@ -4,9 +4,15 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"landing/backend/ent/blog"
+	"landing/backend/ent/blogchunk"
+	"landing/backend/ent/category"
+	"landing/backend/ent/media"
 	"landing/backend/ent/predicate"
+	"landing/backend/ent/tag"
+	"landing/backend/ent/user"
 	"math"
 
 	"entgo.io/ent"
@@ -18,10 +24,15 @@ import (
 // BlogQuery is the builder for querying Blog entities.
 type BlogQuery struct {
 	config
-	ctx        *QueryContext
-	order      []blog.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Blog
+	ctx                 *QueryContext
+	order               []blog.OrderOption
+	inters              []Interceptor
+	predicates          []predicate.Blog
+	withPrimaryCategory *CategoryQuery
+	withTags            *TagQuery
+	withAuthor          *UserQuery
+	withFeaturedImage   *MediaQuery
+	withChunks          *BlogChunkQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -58,6 +69,116 @@ func (_q *BlogQuery) Order(o ...blog.OrderOption) *BlogQuery {
 	return _q
 }
 
+// QueryPrimaryCategory chains the current query on the "primary_category" edge.
+func (_q *BlogQuery) QueryPrimaryCategory() *CategoryQuery {
+	query := (&CategoryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blog.Table, blog.FieldID, selector),
+			sqlgraph.To(category.Table, category.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, blog.PrimaryCategoryTable, blog.PrimaryCategoryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTags chains the current query on the "tags" edge.
+func (_q *BlogQuery) QueryTags() *TagQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blog.Table, blog.FieldID, selector),
+			sqlgraph.To(tag.Table, tag.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, blog.TagsTable, blog.TagsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAuthor chains the current query on the "author" edge.
+func (_q *BlogQuery) QueryAuthor() *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blog.Table, blog.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, blog.AuthorTable, blog.AuthorColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryFeaturedImage chains the current query on the "featured_image" edge.
+func (_q *BlogQuery) QueryFeaturedImage() *MediaQuery {
+	query := (&MediaClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blog.Table, blog.FieldID, selector),
+			sqlgraph.To(media.Table, media.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, blog.FeaturedImageTable, blog.FeaturedImageColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChunks chains the current query on the "chunks" edge.
+func (_q *BlogQuery) QueryChunks() *BlogChunkQuery {
+	query := (&BlogChunkClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(blog.Table, blog.FieldID, selector),
+			sqlgraph.To(blogchunk.Table, blogchunk.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, blog.ChunksTable, blog.ChunksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Blog entity from the query.
 // Returns a *NotFoundError when no Blog was found.
 func (_q *BlogQuery) First(ctx context.Context) (*Blog, error) {
@@ -245,17 +366,77 @@ func (_q *BlogQuery) Clone() *BlogQuery {
 		return nil
 	}
 	return &BlogQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]blog.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.Blog{}, _q.predicates...),
+		config:              _q.config,
+		ctx:                 _q.ctx.Clone(),
+		order:               append([]blog.OrderOption{}, _q.order...),
+		inters:              append([]Interceptor{}, _q.inters...),
+		predicates:          append([]predicate.Blog{}, _q.predicates...),
+		withPrimaryCategory: _q.withPrimaryCategory.Clone(),
+		withTags:            _q.withTags.Clone(),
+		withAuthor:          _q.withAuthor.Clone(),
+		withFeaturedImage:   _q.withFeaturedImage.Clone(),
+		withChunks:          _q.withChunks.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
 	}
 }
 
+// WithPrimaryCategory tells the query-builder to eager-load the nodes that are connected to
+// the "primary_category" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BlogQuery) WithPrimaryCategory(opts ...func(*CategoryQuery)) *BlogQuery {
+	query := (&CategoryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withPrimaryCategory = query
+	return _q
+}
+
+// WithTags tells the query-builder to eager-load the nodes that are connected to
+// the "tags" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BlogQuery) WithTags(opts ...func(*TagQuery)) *BlogQuery {
+	query := (&TagClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withTags = query
+	return _q
+}
+
+// WithAuthor tells the query-builder to eager-load the nodes that are connected to
+// the "author" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BlogQuery) WithAuthor(opts ...func(*UserQuery)) *BlogQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAuthor = query
+	return _q
+}
+
+// WithFeaturedImage tells the query-builder to eager-load the nodes that are connected to
+// the "featured_image" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BlogQuery) WithFeaturedImage(opts ...func(*MediaQuery)) *BlogQuery {
+	query := (&MediaClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withFeaturedImage = query
+	return _q
+}
+
+// WithChunks tells the query-builder to eager-load the nodes that are connected to
+// the "chunks" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *BlogQuery) WithChunks(opts ...func(*BlogChunkQuery)) *BlogQuery {
+	query := (&BlogChunkClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withChunks = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -332,8 +513,15 @@ func (_q *BlogQuery) prepareQuery(ctx context.Context) error {
 
 func (_q *BlogQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Blog, error) {
 	var (
-		nodes = []*Blog{}
-		_spec = _q.querySpec()
+		nodes       = []*Blog{}
+		_spec       = _q.querySpec()
+		loadedTypes = [5]bool{
+			_q.withPrimaryCategory != nil,
+			_q.withTags != nil,
+			_q.withAuthor != nil,
+			_q.withFeaturedImage != nil,
+			_q.withChunks != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Blog).scanValues(nil, columns)
@@ -341,6 +529,7 @@ func (_q *BlogQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Blog, e
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &Blog{config: _q.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -352,9 +541,229 @@ func (_q *BlogQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Blog, e
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := _q.withPrimaryCategory; query != nil {
+		if err := _q.loadPrimaryCategory(ctx, query, nodes, nil,
+			func(n *Blog, e *Category) { n.Edges.PrimaryCategory = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withTags; query != nil {
+		if err := _q.loadTags(ctx, query, nodes,
+			func(n *Blog) { n.Edges.Tags = []*Tag{} },
+			func(n *Blog, e *Tag) { n.Edges.Tags = append(n.Edges.Tags, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAuthor; query != nil {
+		if err := _q.loadAuthor(ctx, query, nodes, nil,
+			func(n *Blog, e *User) { n.Edges.Author = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withFeaturedImage; query != nil {
+		if err := _q.loadFeaturedImage(ctx, query, nodes, nil,
+			func(n *Blog, e *Media) { n.Edges.FeaturedImage = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withChunks; query != nil {
+		if err := _q.loadChunks(ctx, query, nodes,
+			func(n *Blog) { n.Edges.Chunks = []*BlogChunk{} },
+			func(n *Blog, e *BlogChunk) { n.Edges.Chunks = append(n.Edges.Chunks, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (_q *BlogQuery) loadPrimaryCategory(ctx context.Context, query *CategoryQuery, nodes []*Blog, init func(*Blog), assign func(*Blog, *Category)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Blog)
+	for i := range nodes {
+		if nodes[i].CategoryID == nil {
+			continue
+		}
+		fk := *nodes[i].CategoryID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(category.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "category_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *BlogQuery) loadTags(ctx context.Context, query *TagQuery, nodes []*Blog, init func(*Blog), assign func(*Blog, *Tag)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*Blog)
+	nids := make(map[int]map[*Blog]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(blog.TagsTable)
+		s.Join(joinT).On(s.C(tag.FieldID), joinT.C(blog.TagsPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(blog.TagsPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(blog.TagsPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*Blog]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*Tag](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "tags" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (_q *BlogQuery) loadAuthor(ctx context.Context, query *UserQuery, nodes []*Blog, init func(*Blog), assign func(*Blog, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Blog)
+	for i := range nodes {
+		if nodes[i].AuthorID == nil {
+			continue
+		}
+		fk := *nodes[i].AuthorID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "author_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *BlogQuery) loadFeaturedImage(ctx context.Context, query *MediaQuery, nodes []*Blog, init func(*Blog), assign func(*Blog, *Media)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Blog)
+	for i := range nodes {
+		if nodes[i].FeaturedImageID == nil {
+			continue
+		}
+		fk := *nodes[i].FeaturedImageID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(media.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "featured_image_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *BlogQuery) loadChunks(ctx context.Context, query *BlogChunkQuery, nodes []*Blog, init func(*Blog), assign func(*Blog, *BlogChunk)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Blog)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(blogchunk.FieldBlogID)
+	}
+	query.Where(predicate.BlogChunk(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(blog.ChunksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.BlogID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "blog_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (_q *BlogQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
 	_spec.Node.Columns = _q.ctx.Fields
@@ -380,6 +789,15 @@ func (_q *BlogQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if _q.withPrimaryCategory != nil {
+			_spec.Node.AddColumnOnce(blog.FieldCategoryID)
+		}
+		if _q.withAuthor != nil {
+			_spec.Node.AddColumnOnce(blog.FieldAuthorID)
+		}
+		if _q.withFeaturedImage != nil {
+			_spec.Node.AddColumnOnce(blog.FieldFeaturedImageID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -7,7 +7,13 @@ import (
 	"errors"
 	"fmt"
 	"landing/backend/ent/blog"
+	"landing/backend/ent/blogchunk"
+	"landing/backend/ent/category"
+	"landing/backend/ent/media"
 	"landing/backend/ent/predicate"
+	"landing/backend/ent/tag"
+	"landing/backend/ent/user"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -56,202 +62,1326 @@ func (_u *BlogUpdate) SetNillableText(v *string) *BlogUpdate {
 	return _u
 }
 
+// SetFormat sets the "format" field.
+func (_u *BlogUpdate) SetFormat(v blog.Format) *BlogUpdate {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *BlogUpdate) SetNillableFormat(v *blog.Format) *BlogUpdate {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *BlogUpdate) SetSource(v string) *BlogUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *BlogUpdate) SetNillableSource(v *string) *BlogUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// ClearSource clears the value of the "source" field.
+func (_u *BlogUpdate) ClearSource() *BlogUpdate {
+	_u.mutation.ClearSource()
+	return _u
+}
+
 // SetPath sets the "path" field.
 func (_u *BlogUpdate) SetPath(v string) *BlogUpdate {
 	_u.mutation.SetPath(v)
 	return _u
 }
 
-// SetNillablePath sets the "path" field if the given value is not nil.
-func (_u *BlogUpdate) SetNillablePath(v *string) *BlogUpdate {
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *BlogUpdate) SetNillablePath(v *string) *BlogUpdate {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// SetEmbedding sets the "embedding" field.
+func (_u *BlogUpdate) SetEmbedding(v []float32) *BlogUpdate {
+	_u.mutation.SetEmbedding(v)
+	return _u
+}
+
+// AppendEmbedding appends value to the "embedding" field.
+func (_u *BlogUpdate) AppendEmbedding(v []float32) *BlogUpdate {
+	_u.mutation.AppendEmbedding(v)
+	return _u
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (_u *BlogUpdate) ClearEmbedding() *BlogUpdate {
+	_u.mutation.ClearEmbedding()
+	return _u
+}
+
+// SetEmbeddingHash sets the "embedding_hash" field.
+func (_u *BlogUpdate) SetEmbeddingHash(v string) *BlogUpdate {
+	_u.mutation.SetEmbeddingHash(v)
+	return _u
+}
+
+// SetNillableEmbeddingHash sets the "embedding_hash" field if the given value is not nil.
+func (_u *BlogUpdate) SetNillableEmbeddingHash(v *string) *BlogUpdate {
+	if v != nil {
+		_u.SetEmbeddingHash(*v)
+	}
+	return _u
+}
+
+// ClearEmbeddingHash clears the value of the "embedding_hash" field.
+func (_u *BlogUpdate) ClearEmbeddingHash() *BlogUpdate {
+	_u.mutation.ClearEmbeddingHash()
+	return _u
+}
+
+// SetSchemaJSON sets the "schema_json" field.
+func (_u *BlogUpdate) SetSchemaJSON(v string) *BlogUpdate {
+	_u.mutation.SetSchemaJSON(v)
+	return _u
+}
+
+// SetNillableSchemaJSON sets the "schema_json" field if the given value is not nil.
+func (_u *BlogUpdate) SetNillableSchemaJSON(v *string) *BlogUpdate {
+	if v != nil {
+		_u.SetSchemaJSON(*v)
+	}
+	return _u
+}
+
+// ClearSchemaJSON clears the value of the "schema_json" field.
+func (_u *BlogUpdate) ClearSchemaJSON() *BlogUpdate {
+	_u.mutation.ClearSchemaJSON()
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *BlogUpdate) SetCategoryID(v int) *BlogUpdate {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *BlogUpdate) SetNillableCategoryID(v *int) *BlogUpdate {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *BlogUpdate) ClearCategoryID() *BlogUpdate {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetAuthorID sets the "author_id" field.
+func (_u *BlogUpdate) SetAuthorID(v int) *BlogUpdate {
+	_u.mutation.SetAuthorID(v)
+	return _u
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (_u *BlogUpdate) SetNillableAuthorID(v *int) *BlogUpdate {
+	if v != nil {
+		_u.SetAuthorID(*v)
+	}
+	return _u
+}
+
+// ClearAuthorID clears the value of the "author_id" field.
+func (_u *BlogUpdate) ClearAuthorID() *BlogUpdate {
+	_u.mutation.ClearAuthorID()
+	return _u
+}
+
+// SetFeaturedImageID sets the "featured_image_id" field.
+func (_u *BlogUpdate) SetFeaturedImageID(v int) *BlogUpdate {
+	_u.mutation.SetFeaturedImageID(v)
+	return _u
+}
+
+// SetNillableFeaturedImageID sets the "featured_image_id" field if the given value is not nil.
+func (_u *BlogUpdate) SetNillableFeaturedImageID(v *int) *BlogUpdate {
+	if v != nil {
+		_u.SetFeaturedImageID(*v)
+	}
+	return _u
+}
+
+// ClearFeaturedImageID clears the value of the "featured_image_id" field.
+func (_u *BlogUpdate) ClearFeaturedImageID() *BlogUpdate {
+	_u.mutation.ClearFeaturedImageID()
+	return _u
+}
+
+// SetWordCount sets the "word_count" field.
+func (_u *BlogUpdate) SetWordCount(v int) *BlogUpdate {
+	_u.mutation.ResetWordCount()
+	_u.mutation.SetWordCount(v)
+	return _u
+}
+
+// SetNillableWordCount sets the "word_count" field if the given value is not nil.
+func (_u *BlogUpdate) SetNillableWordCount(v *int) *BlogUpdate {
+	if v != nil {
+		_u.SetWordCount(*v)
+	}
+	return _u
+}
+
+// AddWordCount adds value to the "word_count" field.
+func (_u *BlogUpdate) AddWordCount(v int) *BlogUpdate {
+	_u.mutation.AddWordCount(v)
+	return _u
+}
+
+// SetCharCount sets the "char_count" field.
+func (_u *BlogUpdate) SetCharCount(v int) *BlogUpdate {
+	_u.mutation.ResetCharCount()
+	_u.mutation.SetCharCount(v)
+	return _u
+}
+
+// SetNillableCharCount sets the "char_count" field if the given value is not nil.
+func (_u *BlogUpdate) SetNillableCharCount(v *int) *BlogUpdate {
+	if v != nil {
+		_u.SetCharCount(*v)
+	}
+	return _u
+}
+
+// AddCharCount adds value to the "char_count" field.
+func (_u *BlogUpdate) AddCharCount(v int) *BlogUpdate {
+	_u.mutation.AddCharCount(v)
+	return _u
+}
+
+// SetReadingMinutes sets the "reading_minutes" field.
+func (_u *BlogUpdate) SetReadingMinutes(v int) *BlogUpdate {
+	_u.mutation.ResetReadingMinutes()
+	_u.mutation.SetReadingMinutes(v)
+	return _u
+}
+
+// SetNillableReadingMinutes sets the "reading_minutes" field if the given value is not nil.
+func (_u *BlogUpdate) SetNillableReadingMinutes(v *int) *BlogUpdate {
+	if v != nil {
+		_u.SetReadingMinutes(*v)
+	}
+	return _u
+}
+
+// AddReadingMinutes adds value to the "reading_minutes" field.
+func (_u *BlogUpdate) AddReadingMinutes(v int) *BlogUpdate {
+	_u.mutation.AddReadingMinutes(v)
+	return _u
+}
+
+// SetExcerpt sets the "excerpt" field.
+func (_u *BlogUpdate) SetExcerpt(v string) *BlogUpdate {
+	_u.mutation.SetExcerpt(v)
+	return _u
+}
+
+// SetNillableExcerpt sets the "excerpt" field if the given value is not nil.
+func (_u *BlogUpdate) SetNillableExcerpt(v *string) *BlogUpdate {
+	if v != nil {
+		_u.SetExcerpt(*v)
+	}
+	return _u
+}
+
+// ClearExcerpt clears the value of the "excerpt" field.
+func (_u *BlogUpdate) ClearExcerpt() *BlogUpdate {
+	_u.mutation.ClearExcerpt()
+	return _u
+}
+
+// SetMetaDescription sets the "meta_description" field.
+func (_u *BlogUpdate) SetMetaDescription(v string) *BlogUpdate {
+	_u.mutation.SetMetaDescription(v)
+	return _u
+}
+
+// SetNillableMetaDescription sets the "meta_description" field if the given value is not nil.
+func (_u *BlogUpdate) SetNillableMetaDescription(v *string) *BlogUpdate {
+	if v != nil {
+		_u.SetMetaDescription(*v)
+	}
+	return _u
+}
+
+// ClearMetaDescription clears the value of the "meta_description" field.
+func (_u *BlogUpdate) ClearMetaDescription() *BlogUpdate {
+	_u.mutation.ClearMetaDescription()
+	return _u
+}
+
+// SetExcerptCustom sets the "excerpt_custom" field.
+func (_u *BlogUpdate) SetExcerptCustom(v bool) *BlogUpdate {
+	_u.mutation.SetExcerptCustom(v)
+	return _u
+}
+
+// SetNillableExcerptCustom sets the "excerpt_custom" field if the given value is not nil.
+func (_u *BlogUpdate) SetNillableExcerptCustom(v *bool) *BlogUpdate {
+	if v != nil {
+		_u.SetExcerptCustom(*v)
+	}
+	return _u
+}
+
+// SetMetaDescriptionCustom sets the "meta_description_custom" field.
+func (_u *BlogUpdate) SetMetaDescriptionCustom(v bool) *BlogUpdate {
+	_u.mutation.SetMetaDescriptionCustom(v)
+	return _u
+}
+
+// SetNillableMetaDescriptionCustom sets the "meta_description_custom" field if the given value is not nil.
+func (_u *BlogUpdate) SetNillableMetaDescriptionCustom(v *bool) *BlogUpdate {
+	if v != nil {
+		_u.SetMetaDescriptionCustom(*v)
+	}
+	return _u
+}
+
+// SetKeywords sets the "keywords" field.
+func (_u *BlogUpdate) SetKeywords(v []string) *BlogUpdate {
+	_u.mutation.SetKeywords(v)
+	return _u
+}
+
+// AppendKeywords appends value to the "keywords" field.
+func (_u *BlogUpdate) AppendKeywords(v []string) *BlogUpdate {
+	_u.mutation.AppendKeywords(v)
+	return _u
+}
+
+// ClearKeywords clears the value of the "keywords" field.
+func (_u *BlogUpdate) ClearKeywords() *BlogUpdate {
+	_u.mutation.ClearKeywords()
+	return _u
+}
+
+// SetDisableCta sets the "disable_cta" field.
+func (_u *BlogUpdate) SetDisableCta(v bool) *BlogUpdate {
+	_u.mutation.SetDisableCta(v)
+	return _u
+}
+
+// SetNillableDisableCta sets the "disable_cta" field if the given value is not nil.
+func (_u *BlogUpdate) SetNillableDisableCta(v *bool) *BlogUpdate {
+	if v != nil {
+		_u.SetDisableCta(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BlogUpdate) SetUpdatedAt(v time.Time) *BlogUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetPrimaryCategoryID sets the "primary_category" edge to the Category entity by ID.
+func (_u *BlogUpdate) SetPrimaryCategoryID(id int) *BlogUpdate {
+	_u.mutation.SetPrimaryCategoryID(id)
+	return _u
+}
+
+// SetNillablePrimaryCategoryID sets the "primary_category" edge to the Category entity by ID if the given value is not nil.
+func (_u *BlogUpdate) SetNillablePrimaryCategoryID(id *int) *BlogUpdate {
+	if id != nil {
+		_u = _u.SetPrimaryCategoryID(*id)
+	}
+	return _u
+}
+
+// SetPrimaryCategory sets the "primary_category" edge to the Category entity.
+func (_u *BlogUpdate) SetPrimaryCategory(v *Category) *BlogUpdate {
+	return _u.SetPrimaryCategoryID(v.ID)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *BlogUpdate) AddTagIDs(ids ...int) *BlogUpdate {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *BlogUpdate) AddTags(v ...*Tag) *BlogUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (_u *BlogUpdate) SetAuthor(v *User) *BlogUpdate {
+	return _u.SetAuthorID(v.ID)
+}
+
+// SetFeaturedImage sets the "featured_image" edge to the Media entity.
+func (_u *BlogUpdate) SetFeaturedImage(v *Media) *BlogUpdate {
+	return _u.SetFeaturedImageID(v.ID)
+}
+
+// AddChunkIDs adds the "chunks" edge to the BlogChunk entity by IDs.
+func (_u *BlogUpdate) AddChunkIDs(ids ...int) *BlogUpdate {
+	_u.mutation.AddChunkIDs(ids...)
+	return _u
+}
+
+// AddChunks adds the "chunks" edges to the BlogChunk entity.
+func (_u *BlogUpdate) AddChunks(v ...*BlogChunk) *BlogUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChunkIDs(ids...)
+}
+
+// Mutation returns the BlogMutation object of the builder.
+func (_u *BlogUpdate) Mutation() *BlogMutation {
+	return _u.mutation
+}
+
+// ClearPrimaryCategory clears the "primary_category" edge to the Category entity.
+func (_u *BlogUpdate) ClearPrimaryCategory() *BlogUpdate {
+	_u.mutation.ClearPrimaryCategory()
+	return _u
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *BlogUpdate) ClearTags() *BlogUpdate {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *BlogUpdate) RemoveTagIDs(ids ...int) *BlogUpdate {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *BlogUpdate) RemoveTags(v ...*Tag) *BlogUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// ClearAuthor clears the "author" edge to the User entity.
+func (_u *BlogUpdate) ClearAuthor() *BlogUpdate {
+	_u.mutation.ClearAuthor()
+	return _u
+}
+
+// ClearFeaturedImage clears the "featured_image" edge to the Media entity.
+func (_u *BlogUpdate) ClearFeaturedImage() *BlogUpdate {
+	_u.mutation.ClearFeaturedImage()
+	return _u
+}
+
+// ClearChunks clears all "chunks" edges to the BlogChunk entity.
+func (_u *BlogUpdate) ClearChunks() *BlogUpdate {
+	_u.mutation.ClearChunks()
+	return _u
+}
+
+// RemoveChunkIDs removes the "chunks" edge to BlogChunk entities by IDs.
+func (_u *BlogUpdate) RemoveChunkIDs(ids ...int) *BlogUpdate {
+	_u.mutation.RemoveChunkIDs(ids...)
+	return _u
+}
+
+// RemoveChunks removes "chunks" edges to BlogChunk entities.
+func (_u *BlogUpdate) RemoveChunks(v ...*BlogChunk) *BlogUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChunkIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BlogUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BlogUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BlogUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BlogUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BlogUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := blog.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BlogUpdate) check() error {
+	if v, ok := _u.mutation.Category(); ok {
+		if err := blog.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "Blog.category": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Format(); ok {
+		if err := blog.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "Blog.format": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Path(); ok {
+		if err := blog.PathValidator(v); err != nil {
+			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "Blog.path": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.WordCount(); ok {
+		if err := blog.WordCountValidator(v); err != nil {
+			return &ValidationError{Name: "word_count", err: fmt.Errorf(`ent: validator failed for field "Blog.word_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CharCount(); ok {
+		if err := blog.CharCountValidator(v); err != nil {
+			return &ValidationError{Name: "char_count", err: fmt.Errorf(`ent: validator failed for field "Blog.char_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReadingMinutes(); ok {
+		if err := blog.ReadingMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "reading_minutes", err: fmt.Errorf(`ent: validator failed for field "Blog.reading_minutes": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BlogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blog.Table, blog.Columns, sqlgraph.NewFieldSpec(blog.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(blog.FieldCategory, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Text(); ok {
+		_spec.SetField(blog.FieldText, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(blog.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(blog.FieldSource, field.TypeString, value)
+	}
+	if _u.mutation.SourceCleared() {
+		_spec.ClearField(blog.FieldSource, field.TypeString)
+	}
+	if value, ok := _u.mutation.Path(); ok {
+		_spec.SetField(blog.FieldPath, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Embedding(); ok {
+		_spec.SetField(blog.FieldEmbedding, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEmbedding(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, blog.FieldEmbedding, value)
+		})
+	}
+	if _u.mutation.EmbeddingCleared() {
+		_spec.ClearField(blog.FieldEmbedding, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.EmbeddingHash(); ok {
+		_spec.SetField(blog.FieldEmbeddingHash, field.TypeString, value)
+	}
+	if _u.mutation.EmbeddingHashCleared() {
+		_spec.ClearField(blog.FieldEmbeddingHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.SchemaJSON(); ok {
+		_spec.SetField(blog.FieldSchemaJSON, field.TypeString, value)
+	}
+	if _u.mutation.SchemaJSONCleared() {
+		_spec.ClearField(blog.FieldSchemaJSON, field.TypeString)
+	}
+	if value, ok := _u.mutation.WordCount(); ok {
+		_spec.SetField(blog.FieldWordCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWordCount(); ok {
+		_spec.AddField(blog.FieldWordCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CharCount(); ok {
+		_spec.SetField(blog.FieldCharCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCharCount(); ok {
+		_spec.AddField(blog.FieldCharCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReadingMinutes(); ok {
+		_spec.SetField(blog.FieldReadingMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReadingMinutes(); ok {
+		_spec.AddField(blog.FieldReadingMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Excerpt(); ok {
+		_spec.SetField(blog.FieldExcerpt, field.TypeString, value)
+	}
+	if _u.mutation.ExcerptCleared() {
+		_spec.ClearField(blog.FieldExcerpt, field.TypeString)
+	}
+	if value, ok := _u.mutation.MetaDescription(); ok {
+		_spec.SetField(blog.FieldMetaDescription, field.TypeString, value)
+	}
+	if _u.mutation.MetaDescriptionCleared() {
+		_spec.ClearField(blog.FieldMetaDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExcerptCustom(); ok {
+		_spec.SetField(blog.FieldExcerptCustom, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MetaDescriptionCustom(); ok {
+		_spec.SetField(blog.FieldMetaDescriptionCustom, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Keywords(); ok {
+		_spec.SetField(blog.FieldKeywords, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedKeywords(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, blog.FieldKeywords, value)
+		})
+	}
+	if _u.mutation.KeywordsCleared() {
+		_spec.ClearField(blog.FieldKeywords, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DisableCta(); ok {
+		_spec.SetField(blog.FieldDisableCta, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(blog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PrimaryCategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blog.PrimaryCategoryTable,
+			Columns: []string{blog.PrimaryCategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PrimaryCategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blog.PrimaryCategoryTable,
+			Columns: []string{blog.PrimaryCategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   blog.TagsTable,
+			Columns: blog.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   blog.TagsTable,
+			Columns: blog.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   blog.TagsTable,
+			Columns: blog.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blog.AuthorTable,
+			Columns: []string{blog.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blog.AuthorTable,
+			Columns: []string{blog.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FeaturedImageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blog.FeaturedImageTable,
+			Columns: []string{blog.FeaturedImageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FeaturedImageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blog.FeaturedImageTable,
+			Columns: []string{blog.FeaturedImageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.ChunksTable,
+			Columns: []string{blog.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogchunk.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChunksIDs(); len(nodes) > 0 && !_u.mutation.ChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.ChunksTable,
+			Columns: []string{blog.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogchunk.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChunksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.ChunksTable,
+			Columns: []string{blog.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogchunk.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blog.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BlogUpdateOne is the builder for updating a single Blog entity.
+type BlogUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BlogMutation
+}
+
+// SetCategory sets the "category" field.
+func (_u *BlogUpdateOne) SetCategory(v string) *BlogUpdateOne {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *BlogUpdateOne) SetNillableCategory(v *string) *BlogUpdateOne {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// SetText sets the "text" field.
+func (_u *BlogUpdateOne) SetText(v string) *BlogUpdateOne {
+	_u.mutation.SetText(v)
+	return _u
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_u *BlogUpdateOne) SetNillableText(v *string) *BlogUpdateOne {
+	if v != nil {
+		_u.SetText(*v)
+	}
+	return _u
+}
+
+// SetFormat sets the "format" field.
+func (_u *BlogUpdateOne) SetFormat(v blog.Format) *BlogUpdateOne {
+	_u.mutation.SetFormat(v)
+	return _u
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (_u *BlogUpdateOne) SetNillableFormat(v *blog.Format) *BlogUpdateOne {
+	if v != nil {
+		_u.SetFormat(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *BlogUpdateOne) SetSource(v string) *BlogUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *BlogUpdateOne) SetNillableSource(v *string) *BlogUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// ClearSource clears the value of the "source" field.
+func (_u *BlogUpdateOne) ClearSource() *BlogUpdateOne {
+	_u.mutation.ClearSource()
+	return _u
+}
+
+// SetPath sets the "path" field.
+func (_u *BlogUpdateOne) SetPath(v string) *BlogUpdateOne {
+	_u.mutation.SetPath(v)
+	return _u
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (_u *BlogUpdateOne) SetNillablePath(v *string) *BlogUpdateOne {
+	if v != nil {
+		_u.SetPath(*v)
+	}
+	return _u
+}
+
+// SetEmbedding sets the "embedding" field.
+func (_u *BlogUpdateOne) SetEmbedding(v []float32) *BlogUpdateOne {
+	_u.mutation.SetEmbedding(v)
+	return _u
+}
+
+// AppendEmbedding appends value to the "embedding" field.
+func (_u *BlogUpdateOne) AppendEmbedding(v []float32) *BlogUpdateOne {
+	_u.mutation.AppendEmbedding(v)
+	return _u
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (_u *BlogUpdateOne) ClearEmbedding() *BlogUpdateOne {
+	_u.mutation.ClearEmbedding()
+	return _u
+}
+
+// SetEmbeddingHash sets the "embedding_hash" field.
+func (_u *BlogUpdateOne) SetEmbeddingHash(v string) *BlogUpdateOne {
+	_u.mutation.SetEmbeddingHash(v)
+	return _u
+}
+
+// SetNillableEmbeddingHash sets the "embedding_hash" field if the given value is not nil.
+func (_u *BlogUpdateOne) SetNillableEmbeddingHash(v *string) *BlogUpdateOne {
+	if v != nil {
+		_u.SetEmbeddingHash(*v)
+	}
+	return _u
+}
+
+// ClearEmbeddingHash clears the value of the "embedding_hash" field.
+func (_u *BlogUpdateOne) ClearEmbeddingHash() *BlogUpdateOne {
+	_u.mutation.ClearEmbeddingHash()
+	return _u
+}
+
+// SetSchemaJSON sets the "schema_json" field.
+func (_u *BlogUpdateOne) SetSchemaJSON(v string) *BlogUpdateOne {
+	_u.mutation.SetSchemaJSON(v)
+	return _u
+}
+
+// SetNillableSchemaJSON sets the "schema_json" field if the given value is not nil.
+func (_u *BlogUpdateOne) SetNillableSchemaJSON(v *string) *BlogUpdateOne {
+	if v != nil {
+		_u.SetSchemaJSON(*v)
+	}
+	return _u
+}
+
+// ClearSchemaJSON clears the value of the "schema_json" field.
+func (_u *BlogUpdateOne) ClearSchemaJSON() *BlogUpdateOne {
+	_u.mutation.ClearSchemaJSON()
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *BlogUpdateOne) SetCategoryID(v int) *BlogUpdateOne {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *BlogUpdateOne) SetNillableCategoryID(v *int) *BlogUpdateOne {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *BlogUpdateOne) ClearCategoryID() *BlogUpdateOne {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetAuthorID sets the "author_id" field.
+func (_u *BlogUpdateOne) SetAuthorID(v int) *BlogUpdateOne {
+	_u.mutation.SetAuthorID(v)
+	return _u
+}
+
+// SetNillableAuthorID sets the "author_id" field if the given value is not nil.
+func (_u *BlogUpdateOne) SetNillableAuthorID(v *int) *BlogUpdateOne {
+	if v != nil {
+		_u.SetAuthorID(*v)
+	}
+	return _u
+}
+
+// ClearAuthorID clears the value of the "author_id" field.
+func (_u *BlogUpdateOne) ClearAuthorID() *BlogUpdateOne {
+	_u.mutation.ClearAuthorID()
+	return _u
+}
+
+// SetFeaturedImageID sets the "featured_image_id" field.
+func (_u *BlogUpdateOne) SetFeaturedImageID(v int) *BlogUpdateOne {
+	_u.mutation.SetFeaturedImageID(v)
+	return _u
+}
+
+// SetNillableFeaturedImageID sets the "featured_image_id" field if the given value is not nil.
+func (_u *BlogUpdateOne) SetNillableFeaturedImageID(v *int) *BlogUpdateOne {
+	if v != nil {
+		_u.SetFeaturedImageID(*v)
+	}
+	return _u
+}
+
+// ClearFeaturedImageID clears the value of the "featured_image_id" field.
+func (_u *BlogUpdateOne) ClearFeaturedImageID() *BlogUpdateOne {
+	_u.mutation.ClearFeaturedImageID()
+	return _u
+}
+
+// SetWordCount sets the "word_count" field.
+func (_u *BlogUpdateOne) SetWordCount(v int) *BlogUpdateOne {
+	_u.mutation.ResetWordCount()
+	_u.mutation.SetWordCount(v)
+	return _u
+}
+
+// SetNillableWordCount sets the "word_count" field if the given value is not nil.
+func (_u *BlogUpdateOne) SetNillableWordCount(v *int) *BlogUpdateOne {
+	if v != nil {
+		_u.SetWordCount(*v)
+	}
+	return _u
+}
+
+// AddWordCount adds value to the "word_count" field.
+func (_u *BlogUpdateOne) AddWordCount(v int) *BlogUpdateOne {
+	_u.mutation.AddWordCount(v)
+	return _u
+}
+
+// SetCharCount sets the "char_count" field.
+func (_u *BlogUpdateOne) SetCharCount(v int) *BlogUpdateOne {
+	_u.mutation.ResetCharCount()
+	_u.mutation.SetCharCount(v)
+	return _u
+}
+
+// SetNillableCharCount sets the "char_count" field if the given value is not nil.
+func (_u *BlogUpdateOne) SetNillableCharCount(v *int) *BlogUpdateOne {
 	if v != nil {
-		_u.SetPath(*v)
+		_u.SetCharCount(*v)
 	}
 	return _u
 }
 
-// SetEmbedding sets the "embedding" field.
-func (_u *BlogUpdate) SetEmbedding(v []float32) *BlogUpdate {
-	_u.mutation.SetEmbedding(v)
+// AddCharCount adds value to the "char_count" field.
+func (_u *BlogUpdateOne) AddCharCount(v int) *BlogUpdateOne {
+	_u.mutation.AddCharCount(v)
 	return _u
 }
 
-// AppendEmbedding appends value to the "embedding" field.
-func (_u *BlogUpdate) AppendEmbedding(v []float32) *BlogUpdate {
-	_u.mutation.AppendEmbedding(v)
+// SetReadingMinutes sets the "reading_minutes" field.
+func (_u *BlogUpdateOne) SetReadingMinutes(v int) *BlogUpdateOne {
+	_u.mutation.ResetReadingMinutes()
+	_u.mutation.SetReadingMinutes(v)
 	return _u
 }
 
-// ClearEmbedding clears the value of the "embedding" field.
-func (_u *BlogUpdate) ClearEmbedding() *BlogUpdate {
-	_u.mutation.ClearEmbedding()
+// SetNillableReadingMinutes sets the "reading_minutes" field if the given value is not nil.
+func (_u *BlogUpdateOne) SetNillableReadingMinutes(v *int) *BlogUpdateOne {
+	if v != nil {
+		_u.SetReadingMinutes(*v)
+	}
 	return _u
 }
 
-// Mutation returns the BlogMutation object of the builder.
-func (_u *BlogUpdate) Mutation() *BlogMutation {
-	return _u.mutation
+// AddReadingMinutes adds value to the "reading_minutes" field.
+func (_u *BlogUpdateOne) AddReadingMinutes(v int) *BlogUpdateOne {
+	_u.mutation.AddReadingMinutes(v)
+	return _u
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *BlogUpdate) Save(ctx context.Context) (int, error) {
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// SetExcerpt sets the "excerpt" field.
+func (_u *BlogUpdateOne) SetExcerpt(v string) *BlogUpdateOne {
+	_u.mutation.SetExcerpt(v)
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *BlogUpdate) SaveX(ctx context.Context) int {
-	affected, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// SetNillableExcerpt sets the "excerpt" field if the given value is not nil.
+func (_u *BlogUpdateOne) SetNillableExcerpt(v *string) *BlogUpdateOne {
+	if v != nil {
+		_u.SetExcerpt(*v)
 	}
-	return affected
-}
-
-// Exec executes the query.
-func (_u *BlogUpdate) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *BlogUpdate) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
-	}
+// ClearExcerpt clears the value of the "excerpt" field.
+func (_u *BlogUpdateOne) ClearExcerpt() *BlogUpdateOne {
+	_u.mutation.ClearExcerpt()
+	return _u
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (_u *BlogUpdate) check() error {
-	if v, ok := _u.mutation.Category(); ok {
-		if err := blog.CategoryValidator(v); err != nil {
-			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "Blog.category": %w`, err)}
-		}
-	}
-	if v, ok := _u.mutation.Path(); ok {
-		if err := blog.PathValidator(v); err != nil {
-			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "Blog.path": %w`, err)}
-		}
-	}
-	return nil
+// SetMetaDescription sets the "meta_description" field.
+func (_u *BlogUpdateOne) SetMetaDescription(v string) *BlogUpdateOne {
+	_u.mutation.SetMetaDescription(v)
+	return _u
 }
 
-func (_u *BlogUpdate) sqlSave(ctx context.Context) (_node int, err error) {
-	if err := _u.check(); err != nil {
-		return _node, err
-	}
-	_spec := sqlgraph.NewUpdateSpec(blog.Table, blog.Columns, sqlgraph.NewFieldSpec(blog.FieldID, field.TypeInt))
-	if ps := _u.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
-			for i := range ps {
-				ps[i](selector)
-			}
-		}
-	}
-	if value, ok := _u.mutation.Category(); ok {
-		_spec.SetField(blog.FieldCategory, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.Text(); ok {
-		_spec.SetField(blog.FieldText, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.Path(); ok {
-		_spec.SetField(blog.FieldPath, field.TypeString, value)
-	}
-	if value, ok := _u.mutation.Embedding(); ok {
-		_spec.SetField(blog.FieldEmbedding, field.TypeJSON, value)
-	}
-	if value, ok := _u.mutation.AppendedEmbedding(); ok {
-		_spec.AddModifier(func(u *sql.UpdateBuilder) {
-			sqljson.Append(u, blog.FieldEmbedding, value)
-		})
-	}
-	if _u.mutation.EmbeddingCleared() {
-		_spec.ClearField(blog.FieldEmbedding, field.TypeJSON)
-	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{blog.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
-		}
-		return 0, err
+// SetNillableMetaDescription sets the "meta_description" field if the given value is not nil.
+func (_u *BlogUpdateOne) SetNillableMetaDescription(v *string) *BlogUpdateOne {
+	if v != nil {
+		_u.SetMetaDescription(*v)
 	}
-	_u.mutation.done = true
-	return _node, nil
+	return _u
 }
 
-// BlogUpdateOne is the builder for updating a single Blog entity.
-type BlogUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *BlogMutation
+// ClearMetaDescription clears the value of the "meta_description" field.
+func (_u *BlogUpdateOne) ClearMetaDescription() *BlogUpdateOne {
+	_u.mutation.ClearMetaDescription()
+	return _u
 }
 
-// SetCategory sets the "category" field.
-func (_u *BlogUpdateOne) SetCategory(v string) *BlogUpdateOne {
-	_u.mutation.SetCategory(v)
+// SetExcerptCustom sets the "excerpt_custom" field.
+func (_u *BlogUpdateOne) SetExcerptCustom(v bool) *BlogUpdateOne {
+	_u.mutation.SetExcerptCustom(v)
 	return _u
 }
 
-// SetNillableCategory sets the "category" field if the given value is not nil.
-func (_u *BlogUpdateOne) SetNillableCategory(v *string) *BlogUpdateOne {
+// SetNillableExcerptCustom sets the "excerpt_custom" field if the given value is not nil.
+func (_u *BlogUpdateOne) SetNillableExcerptCustom(v *bool) *BlogUpdateOne {
 	if v != nil {
-		_u.SetCategory(*v)
+		_u.SetExcerptCustom(*v)
 	}
 	return _u
 }
 
-// SetText sets the "text" field.
-func (_u *BlogUpdateOne) SetText(v string) *BlogUpdateOne {
-	_u.mutation.SetText(v)
+// SetMetaDescriptionCustom sets the "meta_description_custom" field.
+func (_u *BlogUpdateOne) SetMetaDescriptionCustom(v bool) *BlogUpdateOne {
+	_u.mutation.SetMetaDescriptionCustom(v)
 	return _u
 }
 
-// SetNillableText sets the "text" field if the given value is not nil.
-func (_u *BlogUpdateOne) SetNillableText(v *string) *BlogUpdateOne {
+// SetNillableMetaDescriptionCustom sets the "meta_description_custom" field if the given value is not nil.
+func (_u *BlogUpdateOne) SetNillableMetaDescriptionCustom(v *bool) *BlogUpdateOne {
 	if v != nil {
-		_u.SetText(*v)
+		_u.SetMetaDescriptionCustom(*v)
 	}
 	return _u
 }
 
-// SetPath sets the "path" field.
-func (_u *BlogUpdateOne) SetPath(v string) *BlogUpdateOne {
-	_u.mutation.SetPath(v)
+// SetKeywords sets the "keywords" field.
+func (_u *BlogUpdateOne) SetKeywords(v []string) *BlogUpdateOne {
+	_u.mutation.SetKeywords(v)
 	return _u
 }
 
-// SetNillablePath sets the "path" field if the given value is not nil.
-func (_u *BlogUpdateOne) SetNillablePath(v *string) *BlogUpdateOne {
+// AppendKeywords appends value to the "keywords" field.
+func (_u *BlogUpdateOne) AppendKeywords(v []string) *BlogUpdateOne {
+	_u.mutation.AppendKeywords(v)
+	return _u
+}
+
+// ClearKeywords clears the value of the "keywords" field.
+func (_u *BlogUpdateOne) ClearKeywords() *BlogUpdateOne {
+	_u.mutation.ClearKeywords()
+	return _u
+}
+
+// SetDisableCta sets the "disable_cta" field.
+func (_u *BlogUpdateOne) SetDisableCta(v bool) *BlogUpdateOne {
+	_u.mutation.SetDisableCta(v)
+	return _u
+}
+
+// SetNillableDisableCta sets the "disable_cta" field if the given value is not nil.
+func (_u *BlogUpdateOne) SetNillableDisableCta(v *bool) *BlogUpdateOne {
 	if v != nil {
-		_u.SetPath(*v)
+		_u.SetDisableCta(*v)
 	}
 	return _u
 }
 
-// SetEmbedding sets the "embedding" field.
-func (_u *BlogUpdateOne) SetEmbedding(v []float32) *BlogUpdateOne {
-	_u.mutation.SetEmbedding(v)
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BlogUpdateOne) SetUpdatedAt(v time.Time) *BlogUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
 	return _u
 }
 
-// AppendEmbedding appends value to the "embedding" field.
-func (_u *BlogUpdateOne) AppendEmbedding(v []float32) *BlogUpdateOne {
-	_u.mutation.AppendEmbedding(v)
+// SetPrimaryCategoryID sets the "primary_category" edge to the Category entity by ID.
+func (_u *BlogUpdateOne) SetPrimaryCategoryID(id int) *BlogUpdateOne {
+	_u.mutation.SetPrimaryCategoryID(id)
 	return _u
 }
 
-// ClearEmbedding clears the value of the "embedding" field.
-func (_u *BlogUpdateOne) ClearEmbedding() *BlogUpdateOne {
-	_u.mutation.ClearEmbedding()
+// SetNillablePrimaryCategoryID sets the "primary_category" edge to the Category entity by ID if the given value is not nil.
+func (_u *BlogUpdateOne) SetNillablePrimaryCategoryID(id *int) *BlogUpdateOne {
+	if id != nil {
+		_u = _u.SetPrimaryCategoryID(*id)
+	}
+	return _u
+}
+
+// SetPrimaryCategory sets the "primary_category" edge to the Category entity.
+func (_u *BlogUpdateOne) SetPrimaryCategory(v *Category) *BlogUpdateOne {
+	return _u.SetPrimaryCategoryID(v.ID)
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by IDs.
+func (_u *BlogUpdateOne) AddTagIDs(ids ...int) *BlogUpdateOne {
+	_u.mutation.AddTagIDs(ids...)
+	return _u
+}
+
+// AddTags adds the "tags" edges to the Tag entity.
+func (_u *BlogUpdateOne) AddTags(v ...*Tag) *BlogUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTagIDs(ids...)
+}
+
+// SetAuthor sets the "author" edge to the User entity.
+func (_u *BlogUpdateOne) SetAuthor(v *User) *BlogUpdateOne {
+	return _u.SetAuthorID(v.ID)
+}
+
+// SetFeaturedImage sets the "featured_image" edge to the Media entity.
+func (_u *BlogUpdateOne) SetFeaturedImage(v *Media) *BlogUpdateOne {
+	return _u.SetFeaturedImageID(v.ID)
+}
+
+// AddChunkIDs adds the "chunks" edge to the BlogChunk entity by IDs.
+func (_u *BlogUpdateOne) AddChunkIDs(ids ...int) *BlogUpdateOne {
+	_u.mutation.AddChunkIDs(ids...)
 	return _u
 }
 
+// AddChunks adds the "chunks" edges to the BlogChunk entity.
+func (_u *BlogUpdateOne) AddChunks(v ...*BlogChunk) *BlogUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddChunkIDs(ids...)
+}
+
 // Mutation returns the BlogMutation object of the builder.
 func (_u *BlogUpdateOne) Mutation() *BlogMutation {
 	return _u.mutation
 }
 
+// ClearPrimaryCategory clears the "primary_category" edge to the Category entity.
+func (_u *BlogUpdateOne) ClearPrimaryCategory() *BlogUpdateOne {
+	_u.mutation.ClearPrimaryCategory()
+	return _u
+}
+
+// ClearTags clears all "tags" edges to the Tag entity.
+func (_u *BlogUpdateOne) ClearTags() *BlogUpdateOne {
+	_u.mutation.ClearTags()
+	return _u
+}
+
+// RemoveTagIDs removes the "tags" edge to Tag entities by IDs.
+func (_u *BlogUpdateOne) RemoveTagIDs(ids ...int) *BlogUpdateOne {
+	_u.mutation.RemoveTagIDs(ids...)
+	return _u
+}
+
+// RemoveTags removes "tags" edges to Tag entities.
+func (_u *BlogUpdateOne) RemoveTags(v ...*Tag) *BlogUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTagIDs(ids...)
+}
+
+// ClearAuthor clears the "author" edge to the User entity.
+func (_u *BlogUpdateOne) ClearAuthor() *BlogUpdateOne {
+	_u.mutation.ClearAuthor()
+	return _u
+}
+
+// ClearFeaturedImage clears the "featured_image" edge to the Media entity.
+func (_u *BlogUpdateOne) ClearFeaturedImage() *BlogUpdateOne {
+	_u.mutation.ClearFeaturedImage()
+	return _u
+}
+
+// ClearChunks clears all "chunks" edges to the BlogChunk entity.
+func (_u *BlogUpdateOne) ClearChunks() *BlogUpdateOne {
+	_u.mutation.ClearChunks()
+	return _u
+}
+
+// RemoveChunkIDs removes the "chunks" edge to BlogChunk entities by IDs.
+func (_u *BlogUpdateOne) RemoveChunkIDs(ids ...int) *BlogUpdateOne {
+	_u.mutation.RemoveChunkIDs(ids...)
+	return _u
+}
+
+// RemoveChunks removes "chunks" edges to BlogChunk entities.
+func (_u *BlogUpdateOne) RemoveChunks(v ...*BlogChunk) *BlogUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChunkIDs(ids...)
+}
+
 // Where appends a list predicates to the BlogUpdate builder.
 func (_u *BlogUpdateOne) Where(ps ...predicate.Blog) *BlogUpdateOne {
 	_u.mutation.Where(ps...)
@@ -267,6 +1397,7 @@ func (_u *BlogUpdateOne) Select(field string, fields ...string) *BlogUpdateOne {
 
 // Save executes the query and returns the updated Blog entity.
 func (_u *BlogUpdateOne) Save(ctx context.Context) (*Blog, error) {
+	_u.defaults()
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -292,6 +1423,14 @@ func (_u *BlogUpdateOne) ExecX(ctx context.Context) {
 	}
 }
 
+// defaults sets the default values of the builder before save.
+func (_u *BlogUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := blog.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
 // check runs all checks and user-defined validators on the builder.
 func (_u *BlogUpdateOne) check() error {
 	if v, ok := _u.mutation.Category(); ok {
@@ -299,11 +1438,31 @@ func (_u *BlogUpdateOne) check() error {
 			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "Blog.category": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Format(); ok {
+		if err := blog.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "Blog.format": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.Path(); ok {
 		if err := blog.PathValidator(v); err != nil {
 			return &ValidationError{Name: "path", err: fmt.Errorf(`ent: validator failed for field "Blog.path": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.WordCount(); ok {
+		if err := blog.WordCountValidator(v); err != nil {
+			return &ValidationError{Name: "word_count", err: fmt.Errorf(`ent: validator failed for field "Blog.word_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CharCount(); ok {
+		if err := blog.CharCountValidator(v); err != nil {
+			return &ValidationError{Name: "char_count", err: fmt.Errorf(`ent: validator failed for field "Blog.char_count": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ReadingMinutes(); ok {
+		if err := blog.ReadingMinutesValidator(v); err != nil {
+			return &ValidationError{Name: "reading_minutes", err: fmt.Errorf(`ent: validator failed for field "Blog.reading_minutes": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -342,6 +1501,15 @@ func (_u *BlogUpdateOne) sqlSave(ctx context.Context) (_node *Blog, err error) {
 	if value, ok := _u.mutation.Text(); ok {
 		_spec.SetField(blog.FieldText, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Format(); ok {
+		_spec.SetField(blog.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(blog.FieldSource, field.TypeString, value)
+	}
+	if _u.mutation.SourceCleared() {
+		_spec.ClearField(blog.FieldSource, field.TypeString)
+	}
 	if value, ok := _u.mutation.Path(); ok {
 		_spec.SetField(blog.FieldPath, field.TypeString, value)
 	}
@@ -356,6 +1524,248 @@ func (_u *BlogUpdateOne) sqlSave(ctx context.Context) (_node *Blog, err error) {
 	if _u.mutation.EmbeddingCleared() {
 		_spec.ClearField(blog.FieldEmbedding, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.EmbeddingHash(); ok {
+		_spec.SetField(blog.FieldEmbeddingHash, field.TypeString, value)
+	}
+	if _u.mutation.EmbeddingHashCleared() {
+		_spec.ClearField(blog.FieldEmbeddingHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.SchemaJSON(); ok {
+		_spec.SetField(blog.FieldSchemaJSON, field.TypeString, value)
+	}
+	if _u.mutation.SchemaJSONCleared() {
+		_spec.ClearField(blog.FieldSchemaJSON, field.TypeString)
+	}
+	if value, ok := _u.mutation.WordCount(); ok {
+		_spec.SetField(blog.FieldWordCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWordCount(); ok {
+		_spec.AddField(blog.FieldWordCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CharCount(); ok {
+		_spec.SetField(blog.FieldCharCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCharCount(); ok {
+		_spec.AddField(blog.FieldCharCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.ReadingMinutes(); ok {
+		_spec.SetField(blog.FieldReadingMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedReadingMinutes(); ok {
+		_spec.AddField(blog.FieldReadingMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Excerpt(); ok {
+		_spec.SetField(blog.FieldExcerpt, field.TypeString, value)
+	}
+	if _u.mutation.ExcerptCleared() {
+		_spec.ClearField(blog.FieldExcerpt, field.TypeString)
+	}
+	if value, ok := _u.mutation.MetaDescription(); ok {
+		_spec.SetField(blog.FieldMetaDescription, field.TypeString, value)
+	}
+	if _u.mutation.MetaDescriptionCleared() {
+		_spec.ClearField(blog.FieldMetaDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExcerptCustom(); ok {
+		_spec.SetField(blog.FieldExcerptCustom, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MetaDescriptionCustom(); ok {
+		_spec.SetField(blog.FieldMetaDescriptionCustom, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Keywords(); ok {
+		_spec.SetField(blog.FieldKeywords, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedKeywords(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, blog.FieldKeywords, value)
+		})
+	}
+	if _u.mutation.KeywordsCleared() {
+		_spec.ClearField(blog.FieldKeywords, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.DisableCta(); ok {
+		_spec.SetField(blog.FieldDisableCta, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(blog.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.PrimaryCategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blog.PrimaryCategoryTable,
+			Columns: []string{blog.PrimaryCategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PrimaryCategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blog.PrimaryCategoryTable,
+			Columns: []string{blog.PrimaryCategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   blog.TagsTable,
+			Columns: blog.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTagsIDs(); len(nodes) > 0 && !_u.mutation.TagsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   blog.TagsTable,
+			Columns: blog.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TagsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   blog.TagsTable,
+			Columns: blog.TagsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tag.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AuthorCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blog.AuthorTable,
+			Columns: []string{blog.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AuthorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blog.AuthorTable,
+			Columns: []string{blog.AuthorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.FeaturedImageCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blog.FeaturedImageTable,
+			Columns: []string{blog.FeaturedImageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FeaturedImageIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blog.FeaturedImageTable,
+			Columns: []string{blog.FeaturedImageColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(media.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.ChunksTable,
+			Columns: []string{blog.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogchunk.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChunksIDs(); len(nodes) > 0 && !_u.mutation.ChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.ChunksTable,
+			Columns: []string{blog.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogchunk.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChunksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   blog.ChunksTable,
+			Columns: []string{blog.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blogchunk.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Blog{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,192 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"landing/backend/ent/blog"
+	"landing/backend/ent/blogchunk"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// BlogChunk is the model entity for the BlogChunk schema.
+type BlogChunk struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// BlogID holds the value of the "blog_id" field.
+	BlogID int `json:"blog_id,omitempty"`
+	// Position holds the value of the "position" field.
+	Position int `json:"position,omitempty"`
+	// Heading holds the value of the "heading" field.
+	Heading string `json:"heading,omitempty"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text,omitempty"`
+	// Embedding holds the value of the "embedding" field.
+	Embedding []float32 `json:"embedding,omitempty"`
+	// EmbeddingHash holds the value of the "embedding_hash" field.
+	EmbeddingHash string `json:"embedding_hash,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BlogChunkQuery when eager-loading is set.
+	Edges        BlogChunkEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BlogChunkEdges holds the relations/edges for other nodes in the graph.
+type BlogChunkEdges struct {
+	// Blog holds the value of the blog edge.
+	Blog *Blog `json:"blog,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// BlogOrErr returns the Blog value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BlogChunkEdges) BlogOrErr() (*Blog, error) {
+	if e.Blog != nil {
+		return e.Blog, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: blog.Label}
+	}
+	return nil, &NotLoadedError{edge: "blog"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BlogChunk) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case blogchunk.FieldEmbedding:
+			values[i] = new([]byte)
+		case blogchunk.FieldID, blogchunk.FieldBlogID, blogchunk.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case blogchunk.FieldHeading, blogchunk.FieldText, blogchunk.FieldEmbeddingHash:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BlogChunk fields.
+func (_m *BlogChunk) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case blogchunk.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case blogchunk.FieldBlogID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field blog_id", values[i])
+			} else if value.Valid {
+				_m.BlogID = int(value.Int64)
+			}
+		case blogchunk.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = int(value.Int64)
+			}
+		case blogchunk.FieldHeading:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field heading", values[i])
+			} else if value.Valid {
+				_m.Heading = value.String
+			}
+		case blogchunk.FieldText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text", values[i])
+			} else if value.Valid {
+				_m.Text = value.String
+			}
+		case blogchunk.FieldEmbedding:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Embedding); err != nil {
+					return fmt.Errorf("unmarshal field embedding: %w", err)
+				}
+			}
+		case blogchunk.FieldEmbeddingHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field embedding_hash", values[i])
+			} else if value.Valid {
+				_m.EmbeddingHash = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BlogChunk.
+// This includes values selected through modifiers, order, etc.
+func (_m *BlogChunk) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryBlog queries the "blog" edge of the BlogChunk entity.
+func (_m *BlogChunk) QueryBlog() *BlogQuery {
+	return NewBlogChunkClient(_m.config).QueryBlog(_m)
+}
+
+// Update returns a builder for updating this BlogChunk.
+// Note that you need to call BlogChunk.Unwrap() before calling this method if this BlogChunk
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BlogChunk) Update() *BlogChunkUpdateOne {
+	return NewBlogChunkClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BlogChunk entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BlogChunk) Unwrap() *BlogChunk {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BlogChunk is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BlogChunk) String() string {
+	var builder strings.Builder
+	builder.WriteString("BlogChunk(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("blog_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.BlogID))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteString(", ")
+	builder.WriteString("heading=")
+	builder.WriteString(_m.Heading)
+	builder.WriteString(", ")
+	builder.WriteString("text=")
+	builder.WriteString(_m.Text)
+	builder.WriteString(", ")
+	builder.WriteString("embedding=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Embedding))
+	builder.WriteString(", ")
+	builder.WriteString("embedding_hash=")
+	builder.WriteString(_m.EmbeddingHash)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BlogChunks is a parsable slice of BlogChunk.
+type BlogChunks []*BlogChunk
@@ -0,0 +1,111 @@
+// Code generated by ent, DO NOT EDIT.
+
+package blogchunk
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the blogchunk type in the database.
+	Label = "blog_chunk"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldBlogID holds the string denoting the blog_id field in the database.
+	FieldBlogID = "blog_id"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// FieldHeading holds the string denoting the heading field in the database.
+	FieldHeading = "heading"
+	// FieldText holds the string denoting the text field in the database.
+	FieldText = "text"
+	// FieldEmbedding holds the string denoting the embedding field in the database.
+	FieldEmbedding = "embedding"
+	// FieldEmbeddingHash holds the string denoting the embedding_hash field in the database.
+	FieldEmbeddingHash = "embedding_hash"
+	// EdgeBlog holds the string denoting the blog edge name in mutations.
+	EdgeBlog = "blog"
+	// Table holds the table name of the blogchunk in the database.
+	Table = "blog_chunks"
+	// BlogTable is the table that holds the blog relation/edge.
+	BlogTable = "blog_chunks"
+	// BlogInverseTable is the table name for the Blog entity.
+	// It exists in this package in order to avoid circular dependency with the "blog" package.
+	BlogInverseTable = "blogs"
+	// BlogColumn is the table column denoting the blog relation/edge.
+	BlogColumn = "blog_id"
+)
+
+// Columns holds all SQL columns for blogchunk fields.
+var Columns = []string{
+	FieldID,
+	FieldBlogID,
+	FieldPosition,
+	FieldHeading,
+	FieldText,
+	FieldEmbedding,
+	FieldEmbeddingHash,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	PositionValidator func(int) error
+)
+
+// OrderOption defines the ordering options for the BlogChunk queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByBlogID orders the results by the blog_id field.
+func ByBlogID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBlogID, opts...).ToFunc()
+}
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
+}
+
+// ByHeading orders the results by the heading field.
+func ByHeading(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeading, opts...).ToFunc()
+}
+
+// ByText orders the results by the text field.
+func ByText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldText, opts...).ToFunc()
+}
+
+// ByEmbeddingHash orders the results by the embedding_hash field.
+func ByEmbeddingHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmbeddingHash, opts...).ToFunc()
+}
+
+// ByBlogField orders the results by blog field.
+func ByBlogField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBlogStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newBlogStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BlogInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BlogTable, BlogColumn),
+	)
+}
@@ -0,0 +1,403 @@
+// Code generated by ent, DO NOT EDIT.
+
+package blogchunk
+
+import (
+	"landing/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldLTE(FieldID, id))
+}
+
+// BlogID applies equality check predicate on the "blog_id" field. It's identical to BlogIDEQ.
+func BlogID(v int) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldEQ(FieldBlogID, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldEQ(FieldPosition, v))
+}
+
+// Heading applies equality check predicate on the "heading" field. It's identical to HeadingEQ.
+func Heading(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldEQ(FieldHeading, v))
+}
+
+// Text applies equality check predicate on the "text" field. It's identical to TextEQ.
+func Text(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldEQ(FieldText, v))
+}
+
+// EmbeddingHash applies equality check predicate on the "embedding_hash" field. It's identical to EmbeddingHashEQ.
+func EmbeddingHash(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldEQ(FieldEmbeddingHash, v))
+}
+
+// BlogIDEQ applies the EQ predicate on the "blog_id" field.
+func BlogIDEQ(v int) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldEQ(FieldBlogID, v))
+}
+
+// BlogIDNEQ applies the NEQ predicate on the "blog_id" field.
+func BlogIDNEQ(v int) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldNEQ(FieldBlogID, v))
+}
+
+// BlogIDIn applies the In predicate on the "blog_id" field.
+func BlogIDIn(vs ...int) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldIn(FieldBlogID, vs...))
+}
+
+// BlogIDNotIn applies the NotIn predicate on the "blog_id" field.
+func BlogIDNotIn(vs ...int) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldNotIn(FieldBlogID, vs...))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldLTE(FieldPosition, v))
+}
+
+// HeadingEQ applies the EQ predicate on the "heading" field.
+func HeadingEQ(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldEQ(FieldHeading, v))
+}
+
+// HeadingNEQ applies the NEQ predicate on the "heading" field.
+func HeadingNEQ(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldNEQ(FieldHeading, v))
+}
+
+// HeadingIn applies the In predicate on the "heading" field.
+func HeadingIn(vs ...string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldIn(FieldHeading, vs...))
+}
+
+// HeadingNotIn applies the NotIn predicate on the "heading" field.
+func HeadingNotIn(vs ...string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldNotIn(FieldHeading, vs...))
+}
+
+// HeadingGT applies the GT predicate on the "heading" field.
+func HeadingGT(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldGT(FieldHeading, v))
+}
+
+// HeadingGTE applies the GTE predicate on the "heading" field.
+func HeadingGTE(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldGTE(FieldHeading, v))
+}
+
+// HeadingLT applies the LT predicate on the "heading" field.
+func HeadingLT(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldLT(FieldHeading, v))
+}
+
+// HeadingLTE applies the LTE predicate on the "heading" field.
+func HeadingLTE(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldLTE(FieldHeading, v))
+}
+
+// HeadingContains applies the Contains predicate on the "heading" field.
+func HeadingContains(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldContains(FieldHeading, v))
+}
+
+// HeadingHasPrefix applies the HasPrefix predicate on the "heading" field.
+func HeadingHasPrefix(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldHasPrefix(FieldHeading, v))
+}
+
+// HeadingHasSuffix applies the HasSuffix predicate on the "heading" field.
+func HeadingHasSuffix(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldHasSuffix(FieldHeading, v))
+}
+
+// HeadingIsNil applies the IsNil predicate on the "heading" field.
+func HeadingIsNil() predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldIsNull(FieldHeading))
+}
+
+// HeadingNotNil applies the NotNil predicate on the "heading" field.
+func HeadingNotNil() predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldNotNull(FieldHeading))
+}
+
+// HeadingEqualFold applies the EqualFold predicate on the "heading" field.
+func HeadingEqualFold(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldEqualFold(FieldHeading, v))
+}
+
+// HeadingContainsFold applies the ContainsFold predicate on the "heading" field.
+func HeadingContainsFold(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldContainsFold(FieldHeading, v))
+}
+
+// TextEQ applies the EQ predicate on the "text" field.
+func TextEQ(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldEQ(FieldText, v))
+}
+
+// TextNEQ applies the NEQ predicate on the "text" field.
+func TextNEQ(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldNEQ(FieldText, v))
+}
+
+// TextIn applies the In predicate on the "text" field.
+func TextIn(vs ...string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldIn(FieldText, vs...))
+}
+
+// TextNotIn applies the NotIn predicate on the "text" field.
+func TextNotIn(vs ...string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldNotIn(FieldText, vs...))
+}
+
+// TextGT applies the GT predicate on the "text" field.
+func TextGT(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldGT(FieldText, v))
+}
+
+// TextGTE applies the GTE predicate on the "text" field.
+func TextGTE(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldGTE(FieldText, v))
+}
+
+// TextLT applies the LT predicate on the "text" field.
+func TextLT(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldLT(FieldText, v))
+}
+
+// TextLTE applies the LTE predicate on the "text" field.
+func TextLTE(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldLTE(FieldText, v))
+}
+
+// TextContains applies the Contains predicate on the "text" field.
+func TextContains(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldContains(FieldText, v))
+}
+
+// TextHasPrefix applies the HasPrefix predicate on the "text" field.
+func TextHasPrefix(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldHasPrefix(FieldText, v))
+}
+
+// TextHasSuffix applies the HasSuffix predicate on the "text" field.
+func TextHasSuffix(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldHasSuffix(FieldText, v))
+}
+
+// TextEqualFold applies the EqualFold predicate on the "text" field.
+func TextEqualFold(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldEqualFold(FieldText, v))
+}
+
+// TextContainsFold applies the ContainsFold predicate on the "text" field.
+func TextContainsFold(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldContainsFold(FieldText, v))
+}
+
+// EmbeddingIsNil applies the IsNil predicate on the "embedding" field.
+func EmbeddingIsNil() predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldIsNull(FieldEmbedding))
+}
+
+// EmbeddingNotNil applies the NotNil predicate on the "embedding" field.
+func EmbeddingNotNil() predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldNotNull(FieldEmbedding))
+}
+
+// EmbeddingHashEQ applies the EQ predicate on the "embedding_hash" field.
+func EmbeddingHashEQ(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldEQ(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashNEQ applies the NEQ predicate on the "embedding_hash" field.
+func EmbeddingHashNEQ(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldNEQ(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashIn applies the In predicate on the "embedding_hash" field.
+func EmbeddingHashIn(vs ...string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldIn(FieldEmbeddingHash, vs...))
+}
+
+// EmbeddingHashNotIn applies the NotIn predicate on the "embedding_hash" field.
+func EmbeddingHashNotIn(vs ...string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldNotIn(FieldEmbeddingHash, vs...))
+}
+
+// EmbeddingHashGT applies the GT predicate on the "embedding_hash" field.
+func EmbeddingHashGT(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldGT(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashGTE applies the GTE predicate on the "embedding_hash" field.
+func EmbeddingHashGTE(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldGTE(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashLT applies the LT predicate on the "embedding_hash" field.
+func EmbeddingHashLT(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldLT(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashLTE applies the LTE predicate on the "embedding_hash" field.
+func EmbeddingHashLTE(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldLTE(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashContains applies the Contains predicate on the "embedding_hash" field.
+func EmbeddingHashContains(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldContains(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashHasPrefix applies the HasPrefix predicate on the "embedding_hash" field.
+func EmbeddingHashHasPrefix(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldHasPrefix(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashHasSuffix applies the HasSuffix predicate on the "embedding_hash" field.
+func EmbeddingHashHasSuffix(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldHasSuffix(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashIsNil applies the IsNil predicate on the "embedding_hash" field.
+func EmbeddingHashIsNil() predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldIsNull(FieldEmbeddingHash))
+}
+
+// EmbeddingHashNotNil applies the NotNil predicate on the "embedding_hash" field.
+func EmbeddingHashNotNil() predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldNotNull(FieldEmbeddingHash))
+}
+
+// EmbeddingHashEqualFold applies the EqualFold predicate on the "embedding_hash" field.
+func EmbeddingHashEqualFold(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldEqualFold(FieldEmbeddingHash, v))
+}
+
+// EmbeddingHashContainsFold applies the ContainsFold predicate on the "embedding_hash" field.
+func EmbeddingHashContainsFold(v string) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.FieldContainsFold(FieldEmbeddingHash, v))
+}
+
+// HasBlog applies the HasEdge predicate on the "blog" edge.
+func HasBlog() predicate.BlogChunk {
+	return predicate.BlogChunk(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BlogTable, BlogColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBlogWith applies the HasEdge predicate on the "blog" edge with a given conditions (other predicates).
+func HasBlogWith(preds ...predicate.Blog) predicate.BlogChunk {
+	return predicate.BlogChunk(func(s *sql.Selector) {
+		step := newBlogStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BlogChunk) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BlogChunk) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BlogChunk) predicate.BlogChunk {
+	return predicate.BlogChunk(sql.NotPredicates(p))
+}
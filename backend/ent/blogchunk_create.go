@@ -0,0 +1,282 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"landing/backend/ent/blog"
+	"landing/backend/ent/blogchunk"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BlogChunkCreate is the builder for creating a BlogChunk entity.
+type BlogChunkCreate struct {
+	config
+	mutation *BlogChunkMutation
+	hooks    []Hook
+}
+
+// SetBlogID sets the "blog_id" field.
+func (_c *BlogChunkCreate) SetBlogID(v int) *BlogChunkCreate {
+	_c.mutation.SetBlogID(v)
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *BlogChunkCreate) SetPosition(v int) *BlogChunkCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetHeading sets the "heading" field.
+func (_c *BlogChunkCreate) SetHeading(v string) *BlogChunkCreate {
+	_c.mutation.SetHeading(v)
+	return _c
+}
+
+// SetNillableHeading sets the "heading" field if the given value is not nil.
+func (_c *BlogChunkCreate) SetNillableHeading(v *string) *BlogChunkCreate {
+	if v != nil {
+		_c.SetHeading(*v)
+	}
+	return _c
+}
+
+// SetText sets the "text" field.
+func (_c *BlogChunkCreate) SetText(v string) *BlogChunkCreate {
+	_c.mutation.SetText(v)
+	return _c
+}
+
+// SetEmbedding sets the "embedding" field.
+func (_c *BlogChunkCreate) SetEmbedding(v []float32) *BlogChunkCreate {
+	_c.mutation.SetEmbedding(v)
+	return _c
+}
+
+// SetEmbeddingHash sets the "embedding_hash" field.
+func (_c *BlogChunkCreate) SetEmbeddingHash(v string) *BlogChunkCreate {
+	_c.mutation.SetEmbeddingHash(v)
+	return _c
+}
+
+// SetNillableEmbeddingHash sets the "embedding_hash" field if the given value is not nil.
+func (_c *BlogChunkCreate) SetNillableEmbeddingHash(v *string) *BlogChunkCreate {
+	if v != nil {
+		_c.SetEmbeddingHash(*v)
+	}
+	return _c
+}
+
+// SetBlog sets the "blog" edge to the Blog entity.
+func (_c *BlogChunkCreate) SetBlog(v *Blog) *BlogChunkCreate {
+	return _c.SetBlogID(v.ID)
+}
+
+// Mutation returns the BlogChunkMutation object of the builder.
+func (_c *BlogChunkCreate) Mutation() *BlogChunkMutation {
+	return _c.mutation
+}
+
+// Save creates the BlogChunk in the database.
+func (_c *BlogChunkCreate) Save(ctx context.Context) (*BlogChunk, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BlogChunkCreate) SaveX(ctx context.Context) *BlogChunk {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BlogChunkCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BlogChunkCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BlogChunkCreate) check() error {
+	if _, ok := _c.mutation.BlogID(); !ok {
+		return &ValidationError{Name: "blog_id", err: errors.New(`ent: missing required field "BlogChunk.blog_id"`)}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "BlogChunk.position"`)}
+	}
+	if v, ok := _c.mutation.Position(); ok {
+		if err := blogchunk.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "BlogChunk.position": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Text(); !ok {
+		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "BlogChunk.text"`)}
+	}
+	if len(_c.mutation.BlogIDs()) == 0 {
+		return &ValidationError{Name: "blog", err: errors.New(`ent: missing required edge "BlogChunk.blog"`)}
+	}
+	return nil
+}
+
+func (_c *BlogChunkCreate) sqlSave(ctx context.Context) (*BlogChunk, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BlogChunkCreate) createSpec() (*BlogChunk, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BlogChunk{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(blogchunk.Table, sqlgraph.NewFieldSpec(blogchunk.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(blogchunk.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := _c.mutation.Heading(); ok {
+		_spec.SetField(blogchunk.FieldHeading, field.TypeString, value)
+		_node.Heading = value
+	}
+	if value, ok := _c.mutation.Text(); ok {
+		_spec.SetField(blogchunk.FieldText, field.TypeString, value)
+		_node.Text = value
+	}
+	if value, ok := _c.mutation.Embedding(); ok {
+		_spec.SetField(blogchunk.FieldEmbedding, field.TypeJSON, value)
+		_node.Embedding = value
+	}
+	if value, ok := _c.mutation.EmbeddingHash(); ok {
+		_spec.SetField(blogchunk.FieldEmbeddingHash, field.TypeString, value)
+		_node.EmbeddingHash = value
+	}
+	if nodes := _c.mutation.BlogIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogchunk.BlogTable,
+			Columns: []string{blogchunk.BlogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BlogID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BlogChunkCreateBulk is the builder for creating many BlogChunk entities in bulk.
+type BlogChunkCreateBulk struct {
+	config
+	err      error
+	builders []*BlogChunkCreate
+}
+
+// Save creates the BlogChunk entities in the database.
+func (_c *BlogChunkCreateBulk) Save(ctx context.Context) ([]*BlogChunk, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BlogChunk, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BlogChunkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BlogChunkCreateBulk) SaveX(ctx context.Context) []*BlogChunk {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BlogChunkCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BlogChunkCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
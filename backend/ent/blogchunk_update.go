@@ -0,0 +1,567 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"landing/backend/ent/blog"
+	"landing/backend/ent/blogchunk"
+	"landing/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// BlogChunkUpdate is the builder for updating BlogChunk entities.
+type BlogChunkUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BlogChunkMutation
+}
+
+// Where appends a list predicates to the BlogChunkUpdate builder.
+func (_u *BlogChunkUpdate) Where(ps ...predicate.BlogChunk) *BlogChunkUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetBlogID sets the "blog_id" field.
+func (_u *BlogChunkUpdate) SetBlogID(v int) *BlogChunkUpdate {
+	_u.mutation.SetBlogID(v)
+	return _u
+}
+
+// SetNillableBlogID sets the "blog_id" field if the given value is not nil.
+func (_u *BlogChunkUpdate) SetNillableBlogID(v *int) *BlogChunkUpdate {
+	if v != nil {
+		_u.SetBlogID(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *BlogChunkUpdate) SetPosition(v int) *BlogChunkUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *BlogChunkUpdate) SetNillablePosition(v *int) *BlogChunkUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *BlogChunkUpdate) AddPosition(v int) *BlogChunkUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetHeading sets the "heading" field.
+func (_u *BlogChunkUpdate) SetHeading(v string) *BlogChunkUpdate {
+	_u.mutation.SetHeading(v)
+	return _u
+}
+
+// SetNillableHeading sets the "heading" field if the given value is not nil.
+func (_u *BlogChunkUpdate) SetNillableHeading(v *string) *BlogChunkUpdate {
+	if v != nil {
+		_u.SetHeading(*v)
+	}
+	return _u
+}
+
+// ClearHeading clears the value of the "heading" field.
+func (_u *BlogChunkUpdate) ClearHeading() *BlogChunkUpdate {
+	_u.mutation.ClearHeading()
+	return _u
+}
+
+// SetText sets the "text" field.
+func (_u *BlogChunkUpdate) SetText(v string) *BlogChunkUpdate {
+	_u.mutation.SetText(v)
+	return _u
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_u *BlogChunkUpdate) SetNillableText(v *string) *BlogChunkUpdate {
+	if v != nil {
+		_u.SetText(*v)
+	}
+	return _u
+}
+
+// SetEmbedding sets the "embedding" field.
+func (_u *BlogChunkUpdate) SetEmbedding(v []float32) *BlogChunkUpdate {
+	_u.mutation.SetEmbedding(v)
+	return _u
+}
+
+// AppendEmbedding appends value to the "embedding" field.
+func (_u *BlogChunkUpdate) AppendEmbedding(v []float32) *BlogChunkUpdate {
+	_u.mutation.AppendEmbedding(v)
+	return _u
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (_u *BlogChunkUpdate) ClearEmbedding() *BlogChunkUpdate {
+	_u.mutation.ClearEmbedding()
+	return _u
+}
+
+// SetEmbeddingHash sets the "embedding_hash" field.
+func (_u *BlogChunkUpdate) SetEmbeddingHash(v string) *BlogChunkUpdate {
+	_u.mutation.SetEmbeddingHash(v)
+	return _u
+}
+
+// SetNillableEmbeddingHash sets the "embedding_hash" field if the given value is not nil.
+func (_u *BlogChunkUpdate) SetNillableEmbeddingHash(v *string) *BlogChunkUpdate {
+	if v != nil {
+		_u.SetEmbeddingHash(*v)
+	}
+	return _u
+}
+
+// ClearEmbeddingHash clears the value of the "embedding_hash" field.
+func (_u *BlogChunkUpdate) ClearEmbeddingHash() *BlogChunkUpdate {
+	_u.mutation.ClearEmbeddingHash()
+	return _u
+}
+
+// SetBlog sets the "blog" edge to the Blog entity.
+func (_u *BlogChunkUpdate) SetBlog(v *Blog) *BlogChunkUpdate {
+	return _u.SetBlogID(v.ID)
+}
+
+// Mutation returns the BlogChunkMutation object of the builder.
+func (_u *BlogChunkUpdate) Mutation() *BlogChunkMutation {
+	return _u.mutation
+}
+
+// ClearBlog clears the "blog" edge to the Blog entity.
+func (_u *BlogChunkUpdate) ClearBlog() *BlogChunkUpdate {
+	_u.mutation.ClearBlog()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BlogChunkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BlogChunkUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BlogChunkUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BlogChunkUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BlogChunkUpdate) check() error {
+	if v, ok := _u.mutation.Position(); ok {
+		if err := blogchunk.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "BlogChunk.position": %w`, err)}
+		}
+	}
+	if _u.mutation.BlogCleared() && len(_u.mutation.BlogIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BlogChunk.blog"`)
+	}
+	return nil
+}
+
+func (_u *BlogChunkUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blogchunk.Table, blogchunk.Columns, sqlgraph.NewFieldSpec(blogchunk.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(blogchunk.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(blogchunk.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Heading(); ok {
+		_spec.SetField(blogchunk.FieldHeading, field.TypeString, value)
+	}
+	if _u.mutation.HeadingCleared() {
+		_spec.ClearField(blogchunk.FieldHeading, field.TypeString)
+	}
+	if value, ok := _u.mutation.Text(); ok {
+		_spec.SetField(blogchunk.FieldText, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Embedding(); ok {
+		_spec.SetField(blogchunk.FieldEmbedding, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEmbedding(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, blogchunk.FieldEmbedding, value)
+		})
+	}
+	if _u.mutation.EmbeddingCleared() {
+		_spec.ClearField(blogchunk.FieldEmbedding, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.EmbeddingHash(); ok {
+		_spec.SetField(blogchunk.FieldEmbeddingHash, field.TypeString, value)
+	}
+	if _u.mutation.EmbeddingHashCleared() {
+		_spec.ClearField(blogchunk.FieldEmbeddingHash, field.TypeString)
+	}
+	if _u.mutation.BlogCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogchunk.BlogTable,
+			Columns: []string{blogchunk.BlogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blog.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BlogIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogchunk.BlogTable,
+			Columns: []string{blogchunk.BlogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blogchunk.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BlogChunkUpdateOne is the builder for updating a single BlogChunk entity.
+type BlogChunkUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BlogChunkMutation
+}
+
+// SetBlogID sets the "blog_id" field.
+func (_u *BlogChunkUpdateOne) SetBlogID(v int) *BlogChunkUpdateOne {
+	_u.mutation.SetBlogID(v)
+	return _u
+}
+
+// SetNillableBlogID sets the "blog_id" field if the given value is not nil.
+func (_u *BlogChunkUpdateOne) SetNillableBlogID(v *int) *BlogChunkUpdateOne {
+	if v != nil {
+		_u.SetBlogID(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *BlogChunkUpdateOne) SetPosition(v int) *BlogChunkUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *BlogChunkUpdateOne) SetNillablePosition(v *int) *BlogChunkUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *BlogChunkUpdateOne) AddPosition(v int) *BlogChunkUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetHeading sets the "heading" field.
+func (_u *BlogChunkUpdateOne) SetHeading(v string) *BlogChunkUpdateOne {
+	_u.mutation.SetHeading(v)
+	return _u
+}
+
+// SetNillableHeading sets the "heading" field if the given value is not nil.
+func (_u *BlogChunkUpdateOne) SetNillableHeading(v *string) *BlogChunkUpdateOne {
+	if v != nil {
+		_u.SetHeading(*v)
+	}
+	return _u
+}
+
+// ClearHeading clears the value of the "heading" field.
+func (_u *BlogChunkUpdateOne) ClearHeading() *BlogChunkUpdateOne {
+	_u.mutation.ClearHeading()
+	return _u
+}
+
+// SetText sets the "text" field.
+func (_u *BlogChunkUpdateOne) SetText(v string) *BlogChunkUpdateOne {
+	_u.mutation.SetText(v)
+	return _u
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (_u *BlogChunkUpdateOne) SetNillableText(v *string) *BlogChunkUpdateOne {
+	if v != nil {
+		_u.SetText(*v)
+	}
+	return _u
+}
+
+// SetEmbedding sets the "embedding" field.
+func (_u *BlogChunkUpdateOne) SetEmbedding(v []float32) *BlogChunkUpdateOne {
+	_u.mutation.SetEmbedding(v)
+	return _u
+}
+
+// AppendEmbedding appends value to the "embedding" field.
+func (_u *BlogChunkUpdateOne) AppendEmbedding(v []float32) *BlogChunkUpdateOne {
+	_u.mutation.AppendEmbedding(v)
+	return _u
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (_u *BlogChunkUpdateOne) ClearEmbedding() *BlogChunkUpdateOne {
+	_u.mutation.ClearEmbedding()
+	return _u
+}
+
+// SetEmbeddingHash sets the "embedding_hash" field.
+func (_u *BlogChunkUpdateOne) SetEmbeddingHash(v string) *BlogChunkUpdateOne {
+	_u.mutation.SetEmbeddingHash(v)
+	return _u
+}
+
+// SetNillableEmbeddingHash sets the "embedding_hash" field if the given value is not nil.
+func (_u *BlogChunkUpdateOne) SetNillableEmbeddingHash(v *string) *BlogChunkUpdateOne {
+	if v != nil {
+		_u.SetEmbeddingHash(*v)
+	}
+	return _u
+}
+
+// ClearEmbeddingHash clears the value of the "embedding_hash" field.
+func (_u *BlogChunkUpdateOne) ClearEmbeddingHash() *BlogChunkUpdateOne {
+	_u.mutation.ClearEmbeddingHash()
+	return _u
+}
+
+// SetBlog sets the "blog" edge to the Blog entity.
+func (_u *BlogChunkUpdateOne) SetBlog(v *Blog) *BlogChunkUpdateOne {
+	return _u.SetBlogID(v.ID)
+}
+
+// Mutation returns the BlogChunkMutation object of the builder.
+func (_u *BlogChunkUpdateOne) Mutation() *BlogChunkMutation {
+	return _u.mutation
+}
+
+// ClearBlog clears the "blog" edge to the Blog entity.
+func (_u *BlogChunkUpdateOne) ClearBlog() *BlogChunkUpdateOne {
+	_u.mutation.ClearBlog()
+	return _u
+}
+
+// Where appends a list predicates to the BlogChunkUpdate builder.
+func (_u *BlogChunkUpdateOne) Where(ps ...predicate.BlogChunk) *BlogChunkUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BlogChunkUpdateOne) Select(field string, fields ...string) *BlogChunkUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BlogChunk entity.
+func (_u *BlogChunkUpdateOne) Save(ctx context.Context) (*BlogChunk, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BlogChunkUpdateOne) SaveX(ctx context.Context) *BlogChunk {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BlogChunkUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BlogChunkUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BlogChunkUpdateOne) check() error {
+	if v, ok := _u.mutation.Position(); ok {
+		if err := blogchunk.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "BlogChunk.position": %w`, err)}
+		}
+	}
+	if _u.mutation.BlogCleared() && len(_u.mutation.BlogIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BlogChunk.blog"`)
+	}
+	return nil
+}
+
+func (_u *BlogChunkUpdateOne) sqlSave(ctx context.Context) (_node *BlogChunk, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(blogchunk.Table, blogchunk.Columns, sqlgraph.NewFieldSpec(blogchunk.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BlogChunk.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, blogchunk.FieldID)
+		for _, f := range fields {
+			if !blogchunk.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != blogchunk.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(blogchunk.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(blogchunk.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Heading(); ok {
+		_spec.SetField(blogchunk.FieldHeading, field.TypeString, value)
+	}
+	if _u.mutation.HeadingCleared() {
+		_spec.ClearField(blogchunk.FieldHeading, field.TypeString)
+	}
+	if value, ok := _u.mutation.Text(); ok {
+		_spec.SetField(blogchunk.FieldText, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Embedding(); ok {
+		_spec.SetField(blogchunk.FieldEmbedding, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEmbedding(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, blogchunk.FieldEmbedding, value)
+		})
+	}
+	if _u.mutation.EmbeddingCleared() {
+		_spec.ClearField(blogchunk.FieldEmbedding, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.EmbeddingHash(); ok {
+		_spec.SetField(blogchunk.FieldEmbeddingHash, field.TypeString, value)
+	}
+	if _u.mutation.EmbeddingHashCleared() {
+		_spec.ClearField(blogchunk.FieldEmbeddingHash, field.TypeString)
+	}
+	if _u.mutation.BlogCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogchunk.BlogTable,
+			Columns: []string{blogchunk.BlogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blog.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BlogIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   blogchunk.BlogTable,
+			Columns: []string{blogchunk.BlogColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BlogChunk{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{blogchunk.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
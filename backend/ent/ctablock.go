@@ -0,0 +1,225 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"landing/backend/ent/category"
+	"landing/backend/ent/ctablock"
+	"strings"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// CTABlock is the model entity for the CTABlock schema.
+type CTABlock struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Heading holds the value of the "heading" field.
+	Heading string `json:"heading,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
+	// ButtonText holds the value of the "button_text" field.
+	ButtonText string `json:"button_text,omitempty"`
+	// ButtonURL holds the value of the "button_url" field.
+	ButtonURL string `json:"button_url,omitempty"`
+	// Placement holds the value of the "placement" field.
+	Placement ctablock.Placement `json:"placement,omitempty"`
+	// Paragraph holds the value of the "paragraph" field.
+	Paragraph int `json:"paragraph,omitempty"`
+	// Active holds the value of the "active" field.
+	Active bool `json:"active,omitempty"`
+	// CategoryID holds the value of the "category_id" field.
+	CategoryID *int `json:"category_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CTABlockQuery when eager-loading is set.
+	Edges        CTABlockEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CTABlockEdges holds the relations/edges for other nodes in the graph.
+type CTABlockEdges struct {
+	// Category holds the value of the category edge.
+	Category *Category `json:"category,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CategoryOrErr returns the Category value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CTABlockEdges) CategoryOrErr() (*Category, error) {
+	if e.Category != nil {
+		return e.Category, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: category.Label}
+	}
+	return nil, &NotLoadedError{edge: "category"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CTABlock) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ctablock.FieldActive:
+			values[i] = new(sql.NullBool)
+		case ctablock.FieldID, ctablock.FieldParagraph, ctablock.FieldCategoryID:
+			values[i] = new(sql.NullInt64)
+		case ctablock.FieldName, ctablock.FieldHeading, ctablock.FieldBody, ctablock.FieldButtonText, ctablock.FieldButtonURL, ctablock.FieldPlacement:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CTABlock fields.
+func (_m *CTABlock) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ctablock.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case ctablock.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case ctablock.FieldHeading:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field heading", values[i])
+			} else if value.Valid {
+				_m.Heading = value.String
+			}
+		case ctablock.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				_m.Body = value.String
+			}
+		case ctablock.FieldButtonText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field button_text", values[i])
+			} else if value.Valid {
+				_m.ButtonText = value.String
+			}
+		case ctablock.FieldButtonURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field button_url", values[i])
+			} else if value.Valid {
+				_m.ButtonURL = value.String
+			}
+		case ctablock.FieldPlacement:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field placement", values[i])
+			} else if value.Valid {
+				_m.Placement = ctablock.Placement(value.String)
+			}
+		case ctablock.FieldParagraph:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field paragraph", values[i])
+			} else if value.Valid {
+				_m.Paragraph = int(value.Int64)
+			}
+		case ctablock.FieldActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field active", values[i])
+			} else if value.Valid {
+				_m.Active = value.Bool
+			}
+		case ctablock.FieldCategoryID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field category_id", values[i])
+			} else if value.Valid {
+				_m.CategoryID = new(int)
+				*_m.CategoryID = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CTABlock.
+// This includes values selected through modifiers, order, etc.
+func (_m *CTABlock) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCategory queries the "category" edge of the CTABlock entity.
+func (_m *CTABlock) QueryCategory() *CategoryQuery {
+	return NewCTABlockClient(_m.config).QueryCategory(_m)
+}
+
+// Update returns a builder for updating this CTABlock.
+// Note that you need to call CTABlock.Unwrap() before calling this method if this CTABlock
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CTABlock) Update() *CTABlockUpdateOne {
+	return NewCTABlockClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CTABlock entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CTABlock) Unwrap() *CTABlock {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CTABlock is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CTABlock) String() string {
+	var builder strings.Builder
+	builder.WriteString("CTABlock(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("heading=")
+	builder.WriteString(_m.Heading)
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(_m.Body)
+	builder.WriteString(", ")
+	builder.WriteString("button_text=")
+	builder.WriteString(_m.ButtonText)
+	builder.WriteString(", ")
+	builder.WriteString("button_url=")
+	builder.WriteString(_m.ButtonURL)
+	builder.WriteString(", ")
+	builder.WriteString("placement=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Placement))
+	builder.WriteString(", ")
+	builder.WriteString("paragraph=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Paragraph))
+	builder.WriteString(", ")
+	builder.WriteString("active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Active))
+	builder.WriteString(", ")
+	if v := _m.CategoryID; v != nil {
+		builder.WriteString("category_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CTABlocks is a parsable slice of CTABlock.
+type CTABlocks []*CTABlock
@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ctablock
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the ctablock type in the database.
+	Label = "cta_block"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldHeading holds the string denoting the heading field in the database.
+	FieldHeading = "heading"
+	// FieldBody holds the string denoting the body field in the database.
+	FieldBody = "body"
+	// FieldButtonText holds the string denoting the button_text field in the database.
+	FieldButtonText = "button_text"
+	// FieldButtonURL holds the string denoting the button_url field in the database.
+	FieldButtonURL = "button_url"
+	// FieldPlacement holds the string denoting the placement field in the database.
+	FieldPlacement = "placement"
+	// FieldParagraph holds the string denoting the paragraph field in the database.
+	FieldParagraph = "paragraph"
+	// FieldActive holds the string denoting the active field in the database.
+	FieldActive = "active"
+	// FieldCategoryID holds the string denoting the category_id field in the database.
+	FieldCategoryID = "category_id"
+	// EdgeCategory holds the string denoting the category edge name in mutations.
+	EdgeCategory = "category"
+	// Table holds the table name of the ctablock in the database.
+	Table = "cta_blocks"
+	// CategoryTable is the table that holds the category relation/edge.
+	CategoryTable = "cta_blocks"
+	// CategoryInverseTable is the table name for the Category entity.
+	// It exists in this package in order to avoid circular dependency with the "category" package.
+	CategoryInverseTable = "categories"
+	// CategoryColumn is the table column denoting the category relation/edge.
+	CategoryColumn = "category_id"
+)
+
+// Columns holds all SQL columns for ctablock fields.
+var Columns = []string{
+	FieldID,
+	FieldName,
+	FieldHeading,
+	FieldBody,
+	FieldButtonText,
+	FieldButtonURL,
+	FieldPlacement,
+	FieldParagraph,
+	FieldActive,
+	FieldCategoryID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultParagraph holds the default value on creation for the "paragraph" field.
+	DefaultParagraph int
+	// ParagraphValidator is a validator for the "paragraph" field. It is called by the builders before save.
+	ParagraphValidator func(int) error
+	// DefaultActive holds the default value on creation for the "active" field.
+	DefaultActive bool
+)
+
+// Placement defines the type for the "placement" enum field.
+type Placement string
+
+// PlacementEnd is the default value of the Placement enum.
+const DefaultPlacement = PlacementEnd
+
+// Placement values.
+const (
+	PlacementEnd            Placement = "end"
+	PlacementAfterParagraph Placement = "after_paragraph"
+)
+
+func (pl Placement) String() string {
+	return string(pl)
+}
+
+// PlacementValidator is a validator for the "placement" field enum values. It is called by the builders before save.
+func PlacementValidator(pl Placement) error {
+	switch pl {
+	case PlacementEnd, PlacementAfterParagraph:
+		return nil
+	default:
+		return fmt.Errorf("ctablock: invalid enum value for placement field: %q", pl)
+	}
+}
+
+// OrderOption defines the ordering options for the CTABlock queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByHeading orders the results by the heading field.
+func ByHeading(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHeading, opts...).ToFunc()
+}
+
+// ByBody orders the results by the body field.
+func ByBody(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBody, opts...).ToFunc()
+}
+
+// ByButtonText orders the results by the button_text field.
+func ByButtonText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldButtonText, opts...).ToFunc()
+}
+
+// ByButtonURL orders the results by the button_url field.
+func ByButtonURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldButtonURL, opts...).ToFunc()
+}
+
+// ByPlacement orders the results by the placement field.
+func ByPlacement(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlacement, opts...).ToFunc()
+}
+
+// ByParagraph orders the results by the paragraph field.
+func ByParagraph(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParagraph, opts...).ToFunc()
+}
+
+// ByActive orders the results by the active field.
+func ByActive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActive, opts...).ToFunc()
+}
+
+// ByCategoryID orders the results by the category_id field.
+func ByCategoryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategoryID, opts...).ToFunc()
+}
+
+// ByCategoryField orders the results by category field.
+func ByCategoryField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCategoryStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newCategoryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CategoryInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CategoryTable, CategoryColumn),
+	)
+}
@@ -0,0 +1,598 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ctablock
+
+import (
+	"landing/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldLTE(FieldID, id))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldEQ(FieldName, v))
+}
+
+// Heading applies equality check predicate on the "heading" field. It's identical to HeadingEQ.
+func Heading(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldEQ(FieldHeading, v))
+}
+
+// Body applies equality check predicate on the "body" field. It's identical to BodyEQ.
+func Body(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldEQ(FieldBody, v))
+}
+
+// ButtonText applies equality check predicate on the "button_text" field. It's identical to ButtonTextEQ.
+func ButtonText(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldEQ(FieldButtonText, v))
+}
+
+// ButtonURL applies equality check predicate on the "button_url" field. It's identical to ButtonURLEQ.
+func ButtonURL(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldEQ(FieldButtonURL, v))
+}
+
+// Paragraph applies equality check predicate on the "paragraph" field. It's identical to ParagraphEQ.
+func Paragraph(v int) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldEQ(FieldParagraph, v))
+}
+
+// Active applies equality check predicate on the "active" field. It's identical to ActiveEQ.
+func Active(v bool) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldEQ(FieldActive, v))
+}
+
+// CategoryID applies equality check predicate on the "category_id" field. It's identical to CategoryIDEQ.
+func CategoryID(v int) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldContainsFold(FieldName, v))
+}
+
+// HeadingEQ applies the EQ predicate on the "heading" field.
+func HeadingEQ(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldEQ(FieldHeading, v))
+}
+
+// HeadingNEQ applies the NEQ predicate on the "heading" field.
+func HeadingNEQ(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldNEQ(FieldHeading, v))
+}
+
+// HeadingIn applies the In predicate on the "heading" field.
+func HeadingIn(vs ...string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldIn(FieldHeading, vs...))
+}
+
+// HeadingNotIn applies the NotIn predicate on the "heading" field.
+func HeadingNotIn(vs ...string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldNotIn(FieldHeading, vs...))
+}
+
+// HeadingGT applies the GT predicate on the "heading" field.
+func HeadingGT(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldGT(FieldHeading, v))
+}
+
+// HeadingGTE applies the GTE predicate on the "heading" field.
+func HeadingGTE(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldGTE(FieldHeading, v))
+}
+
+// HeadingLT applies the LT predicate on the "heading" field.
+func HeadingLT(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldLT(FieldHeading, v))
+}
+
+// HeadingLTE applies the LTE predicate on the "heading" field.
+func HeadingLTE(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldLTE(FieldHeading, v))
+}
+
+// HeadingContains applies the Contains predicate on the "heading" field.
+func HeadingContains(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldContains(FieldHeading, v))
+}
+
+// HeadingHasPrefix applies the HasPrefix predicate on the "heading" field.
+func HeadingHasPrefix(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldHasPrefix(FieldHeading, v))
+}
+
+// HeadingHasSuffix applies the HasSuffix predicate on the "heading" field.
+func HeadingHasSuffix(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldHasSuffix(FieldHeading, v))
+}
+
+// HeadingIsNil applies the IsNil predicate on the "heading" field.
+func HeadingIsNil() predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldIsNull(FieldHeading))
+}
+
+// HeadingNotNil applies the NotNil predicate on the "heading" field.
+func HeadingNotNil() predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldNotNull(FieldHeading))
+}
+
+// HeadingEqualFold applies the EqualFold predicate on the "heading" field.
+func HeadingEqualFold(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldEqualFold(FieldHeading, v))
+}
+
+// HeadingContainsFold applies the ContainsFold predicate on the "heading" field.
+func HeadingContainsFold(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldContainsFold(FieldHeading, v))
+}
+
+// BodyEQ applies the EQ predicate on the "body" field.
+func BodyEQ(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldEQ(FieldBody, v))
+}
+
+// BodyNEQ applies the NEQ predicate on the "body" field.
+func BodyNEQ(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldNEQ(FieldBody, v))
+}
+
+// BodyIn applies the In predicate on the "body" field.
+func BodyIn(vs ...string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldIn(FieldBody, vs...))
+}
+
+// BodyNotIn applies the NotIn predicate on the "body" field.
+func BodyNotIn(vs ...string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldNotIn(FieldBody, vs...))
+}
+
+// BodyGT applies the GT predicate on the "body" field.
+func BodyGT(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldGT(FieldBody, v))
+}
+
+// BodyGTE applies the GTE predicate on the "body" field.
+func BodyGTE(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldGTE(FieldBody, v))
+}
+
+// BodyLT applies the LT predicate on the "body" field.
+func BodyLT(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldLT(FieldBody, v))
+}
+
+// BodyLTE applies the LTE predicate on the "body" field.
+func BodyLTE(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldLTE(FieldBody, v))
+}
+
+// BodyContains applies the Contains predicate on the "body" field.
+func BodyContains(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldContains(FieldBody, v))
+}
+
+// BodyHasPrefix applies the HasPrefix predicate on the "body" field.
+func BodyHasPrefix(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldHasPrefix(FieldBody, v))
+}
+
+// BodyHasSuffix applies the HasSuffix predicate on the "body" field.
+func BodyHasSuffix(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldHasSuffix(FieldBody, v))
+}
+
+// BodyIsNil applies the IsNil predicate on the "body" field.
+func BodyIsNil() predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldIsNull(FieldBody))
+}
+
+// BodyNotNil applies the NotNil predicate on the "body" field.
+func BodyNotNil() predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldNotNull(FieldBody))
+}
+
+// BodyEqualFold applies the EqualFold predicate on the "body" field.
+func BodyEqualFold(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldEqualFold(FieldBody, v))
+}
+
+// BodyContainsFold applies the ContainsFold predicate on the "body" field.
+func BodyContainsFold(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldContainsFold(FieldBody, v))
+}
+
+// ButtonTextEQ applies the EQ predicate on the "button_text" field.
+func ButtonTextEQ(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldEQ(FieldButtonText, v))
+}
+
+// ButtonTextNEQ applies the NEQ predicate on the "button_text" field.
+func ButtonTextNEQ(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldNEQ(FieldButtonText, v))
+}
+
+// ButtonTextIn applies the In predicate on the "button_text" field.
+func ButtonTextIn(vs ...string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldIn(FieldButtonText, vs...))
+}
+
+// ButtonTextNotIn applies the NotIn predicate on the "button_text" field.
+func ButtonTextNotIn(vs ...string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldNotIn(FieldButtonText, vs...))
+}
+
+// ButtonTextGT applies the GT predicate on the "button_text" field.
+func ButtonTextGT(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldGT(FieldButtonText, v))
+}
+
+// ButtonTextGTE applies the GTE predicate on the "button_text" field.
+func ButtonTextGTE(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldGTE(FieldButtonText, v))
+}
+
+// ButtonTextLT applies the LT predicate on the "button_text" field.
+func ButtonTextLT(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldLT(FieldButtonText, v))
+}
+
+// ButtonTextLTE applies the LTE predicate on the "button_text" field.
+func ButtonTextLTE(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldLTE(FieldButtonText, v))
+}
+
+// ButtonTextContains applies the Contains predicate on the "button_text" field.
+func ButtonTextContains(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldContains(FieldButtonText, v))
+}
+
+// ButtonTextHasPrefix applies the HasPrefix predicate on the "button_text" field.
+func ButtonTextHasPrefix(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldHasPrefix(FieldButtonText, v))
+}
+
+// ButtonTextHasSuffix applies the HasSuffix predicate on the "button_text" field.
+func ButtonTextHasSuffix(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldHasSuffix(FieldButtonText, v))
+}
+
+// ButtonTextIsNil applies the IsNil predicate on the "button_text" field.
+func ButtonTextIsNil() predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldIsNull(FieldButtonText))
+}
+
+// ButtonTextNotNil applies the NotNil predicate on the "button_text" field.
+func ButtonTextNotNil() predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldNotNull(FieldButtonText))
+}
+
+// ButtonTextEqualFold applies the EqualFold predicate on the "button_text" field.
+func ButtonTextEqualFold(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldEqualFold(FieldButtonText, v))
+}
+
+// ButtonTextContainsFold applies the ContainsFold predicate on the "button_text" field.
+func ButtonTextContainsFold(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldContainsFold(FieldButtonText, v))
+}
+
+// ButtonURLEQ applies the EQ predicate on the "button_url" field.
+func ButtonURLEQ(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldEQ(FieldButtonURL, v))
+}
+
+// ButtonURLNEQ applies the NEQ predicate on the "button_url" field.
+func ButtonURLNEQ(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldNEQ(FieldButtonURL, v))
+}
+
+// ButtonURLIn applies the In predicate on the "button_url" field.
+func ButtonURLIn(vs ...string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldIn(FieldButtonURL, vs...))
+}
+
+// ButtonURLNotIn applies the NotIn predicate on the "button_url" field.
+func ButtonURLNotIn(vs ...string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldNotIn(FieldButtonURL, vs...))
+}
+
+// ButtonURLGT applies the GT predicate on the "button_url" field.
+func ButtonURLGT(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldGT(FieldButtonURL, v))
+}
+
+// ButtonURLGTE applies the GTE predicate on the "button_url" field.
+func ButtonURLGTE(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldGTE(FieldButtonURL, v))
+}
+
+// ButtonURLLT applies the LT predicate on the "button_url" field.
+func ButtonURLLT(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldLT(FieldButtonURL, v))
+}
+
+// ButtonURLLTE applies the LTE predicate on the "button_url" field.
+func ButtonURLLTE(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldLTE(FieldButtonURL, v))
+}
+
+// ButtonURLContains applies the Contains predicate on the "button_url" field.
+func ButtonURLContains(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldContains(FieldButtonURL, v))
+}
+
+// ButtonURLHasPrefix applies the HasPrefix predicate on the "button_url" field.
+func ButtonURLHasPrefix(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldHasPrefix(FieldButtonURL, v))
+}
+
+// ButtonURLHasSuffix applies the HasSuffix predicate on the "button_url" field.
+func ButtonURLHasSuffix(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldHasSuffix(FieldButtonURL, v))
+}
+
+// ButtonURLIsNil applies the IsNil predicate on the "button_url" field.
+func ButtonURLIsNil() predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldIsNull(FieldButtonURL))
+}
+
+// ButtonURLNotNil applies the NotNil predicate on the "button_url" field.
+func ButtonURLNotNil() predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldNotNull(FieldButtonURL))
+}
+
+// ButtonURLEqualFold applies the EqualFold predicate on the "button_url" field.
+func ButtonURLEqualFold(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldEqualFold(FieldButtonURL, v))
+}
+
+// ButtonURLContainsFold applies the ContainsFold predicate on the "button_url" field.
+func ButtonURLContainsFold(v string) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldContainsFold(FieldButtonURL, v))
+}
+
+// PlacementEQ applies the EQ predicate on the "placement" field.
+func PlacementEQ(v Placement) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldEQ(FieldPlacement, v))
+}
+
+// PlacementNEQ applies the NEQ predicate on the "placement" field.
+func PlacementNEQ(v Placement) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldNEQ(FieldPlacement, v))
+}
+
+// PlacementIn applies the In predicate on the "placement" field.
+func PlacementIn(vs ...Placement) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldIn(FieldPlacement, vs...))
+}
+
+// PlacementNotIn applies the NotIn predicate on the "placement" field.
+func PlacementNotIn(vs ...Placement) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldNotIn(FieldPlacement, vs...))
+}
+
+// ParagraphEQ applies the EQ predicate on the "paragraph" field.
+func ParagraphEQ(v int) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldEQ(FieldParagraph, v))
+}
+
+// ParagraphNEQ applies the NEQ predicate on the "paragraph" field.
+func ParagraphNEQ(v int) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldNEQ(FieldParagraph, v))
+}
+
+// ParagraphIn applies the In predicate on the "paragraph" field.
+func ParagraphIn(vs ...int) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldIn(FieldParagraph, vs...))
+}
+
+// ParagraphNotIn applies the NotIn predicate on the "paragraph" field.
+func ParagraphNotIn(vs ...int) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldNotIn(FieldParagraph, vs...))
+}
+
+// ParagraphGT applies the GT predicate on the "paragraph" field.
+func ParagraphGT(v int) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldGT(FieldParagraph, v))
+}
+
+// ParagraphGTE applies the GTE predicate on the "paragraph" field.
+func ParagraphGTE(v int) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldGTE(FieldParagraph, v))
+}
+
+// ParagraphLT applies the LT predicate on the "paragraph" field.
+func ParagraphLT(v int) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldLT(FieldParagraph, v))
+}
+
+// ParagraphLTE applies the LTE predicate on the "paragraph" field.
+func ParagraphLTE(v int) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldLTE(FieldParagraph, v))
+}
+
+// ActiveEQ applies the EQ predicate on the "active" field.
+func ActiveEQ(v bool) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldEQ(FieldActive, v))
+}
+
+// ActiveNEQ applies the NEQ predicate on the "active" field.
+func ActiveNEQ(v bool) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldNEQ(FieldActive, v))
+}
+
+// CategoryIDEQ applies the EQ predicate on the "category_id" field.
+func CategoryIDEQ(v int) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldEQ(FieldCategoryID, v))
+}
+
+// CategoryIDNEQ applies the NEQ predicate on the "category_id" field.
+func CategoryIDNEQ(v int) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldNEQ(FieldCategoryID, v))
+}
+
+// CategoryIDIn applies the In predicate on the "category_id" field.
+func CategoryIDIn(vs ...int) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDNotIn applies the NotIn predicate on the "category_id" field.
+func CategoryIDNotIn(vs ...int) predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldNotIn(FieldCategoryID, vs...))
+}
+
+// CategoryIDIsNil applies the IsNil predicate on the "category_id" field.
+func CategoryIDIsNil() predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldIsNull(FieldCategoryID))
+}
+
+// CategoryIDNotNil applies the NotNil predicate on the "category_id" field.
+func CategoryIDNotNil() predicate.CTABlock {
+	return predicate.CTABlock(sql.FieldNotNull(FieldCategoryID))
+}
+
+// HasCategory applies the HasEdge predicate on the "category" edge.
+func HasCategory() predicate.CTABlock {
+	return predicate.CTABlock(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, CategoryTable, CategoryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCategoryWith applies the HasEdge predicate on the "category" edge with a given conditions (other predicates).
+func HasCategoryWith(preds ...predicate.Category) predicate.CTABlock {
+	return predicate.CTABlock(func(s *sql.Selector) {
+		step := newCategoryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CTABlock) predicate.CTABlock {
+	return predicate.CTABlock(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CTABlock) predicate.CTABlock {
+	return predicate.CTABlock(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CTABlock) predicate.CTABlock {
+	return predicate.CTABlock(sql.NotPredicates(p))
+}
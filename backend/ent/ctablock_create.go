@@ -0,0 +1,388 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"landing/backend/ent/category"
+	"landing/backend/ent/ctablock"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CTABlockCreate is the builder for creating a CTABlock entity.
+type CTABlockCreate struct {
+	config
+	mutation *CTABlockMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *CTABlockCreate) SetName(v string) *CTABlockCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetHeading sets the "heading" field.
+func (_c *CTABlockCreate) SetHeading(v string) *CTABlockCreate {
+	_c.mutation.SetHeading(v)
+	return _c
+}
+
+// SetNillableHeading sets the "heading" field if the given value is not nil.
+func (_c *CTABlockCreate) SetNillableHeading(v *string) *CTABlockCreate {
+	if v != nil {
+		_c.SetHeading(*v)
+	}
+	return _c
+}
+
+// SetBody sets the "body" field.
+func (_c *CTABlockCreate) SetBody(v string) *CTABlockCreate {
+	_c.mutation.SetBody(v)
+	return _c
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_c *CTABlockCreate) SetNillableBody(v *string) *CTABlockCreate {
+	if v != nil {
+		_c.SetBody(*v)
+	}
+	return _c
+}
+
+// SetButtonText sets the "button_text" field.
+func (_c *CTABlockCreate) SetButtonText(v string) *CTABlockCreate {
+	_c.mutation.SetButtonText(v)
+	return _c
+}
+
+// SetNillableButtonText sets the "button_text" field if the given value is not nil.
+func (_c *CTABlockCreate) SetNillableButtonText(v *string) *CTABlockCreate {
+	if v != nil {
+		_c.SetButtonText(*v)
+	}
+	return _c
+}
+
+// SetButtonURL sets the "button_url" field.
+func (_c *CTABlockCreate) SetButtonURL(v string) *CTABlockCreate {
+	_c.mutation.SetButtonURL(v)
+	return _c
+}
+
+// SetNillableButtonURL sets the "button_url" field if the given value is not nil.
+func (_c *CTABlockCreate) SetNillableButtonURL(v *string) *CTABlockCreate {
+	if v != nil {
+		_c.SetButtonURL(*v)
+	}
+	return _c
+}
+
+// SetPlacement sets the "placement" field.
+func (_c *CTABlockCreate) SetPlacement(v ctablock.Placement) *CTABlockCreate {
+	_c.mutation.SetPlacement(v)
+	return _c
+}
+
+// SetNillablePlacement sets the "placement" field if the given value is not nil.
+func (_c *CTABlockCreate) SetNillablePlacement(v *ctablock.Placement) *CTABlockCreate {
+	if v != nil {
+		_c.SetPlacement(*v)
+	}
+	return _c
+}
+
+// SetParagraph sets the "paragraph" field.
+func (_c *CTABlockCreate) SetParagraph(v int) *CTABlockCreate {
+	_c.mutation.SetParagraph(v)
+	return _c
+}
+
+// SetNillableParagraph sets the "paragraph" field if the given value is not nil.
+func (_c *CTABlockCreate) SetNillableParagraph(v *int) *CTABlockCreate {
+	if v != nil {
+		_c.SetParagraph(*v)
+	}
+	return _c
+}
+
+// SetActive sets the "active" field.
+func (_c *CTABlockCreate) SetActive(v bool) *CTABlockCreate {
+	_c.mutation.SetActive(v)
+	return _c
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_c *CTABlockCreate) SetNillableActive(v *bool) *CTABlockCreate {
+	if v != nil {
+		_c.SetActive(*v)
+	}
+	return _c
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_c *CTABlockCreate) SetCategoryID(v int) *CTABlockCreate {
+	_c.mutation.SetCategoryID(v)
+	return _c
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_c *CTABlockCreate) SetNillableCategoryID(v *int) *CTABlockCreate {
+	if v != nil {
+		_c.SetCategoryID(*v)
+	}
+	return _c
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_c *CTABlockCreate) SetCategory(v *Category) *CTABlockCreate {
+	return _c.SetCategoryID(v.ID)
+}
+
+// Mutation returns the CTABlockMutation object of the builder.
+func (_c *CTABlockCreate) Mutation() *CTABlockMutation {
+	return _c.mutation
+}
+
+// Save creates the CTABlock in the database.
+func (_c *CTABlockCreate) Save(ctx context.Context) (*CTABlock, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CTABlockCreate) SaveX(ctx context.Context) *CTABlock {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CTABlockCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CTABlockCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CTABlockCreate) defaults() {
+	if _, ok := _c.mutation.Placement(); !ok {
+		v := ctablock.DefaultPlacement
+		_c.mutation.SetPlacement(v)
+	}
+	if _, ok := _c.mutation.Paragraph(); !ok {
+		v := ctablock.DefaultParagraph
+		_c.mutation.SetParagraph(v)
+	}
+	if _, ok := _c.mutation.Active(); !ok {
+		v := ctablock.DefaultActive
+		_c.mutation.SetActive(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CTABlockCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "CTABlock.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := ctablock.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CTABlock.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Placement(); !ok {
+		return &ValidationError{Name: "placement", err: errors.New(`ent: missing required field "CTABlock.placement"`)}
+	}
+	if v, ok := _c.mutation.Placement(); ok {
+		if err := ctablock.PlacementValidator(v); err != nil {
+			return &ValidationError{Name: "placement", err: fmt.Errorf(`ent: validator failed for field "CTABlock.placement": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Paragraph(); !ok {
+		return &ValidationError{Name: "paragraph", err: errors.New(`ent: missing required field "CTABlock.paragraph"`)}
+	}
+	if v, ok := _c.mutation.Paragraph(); ok {
+		if err := ctablock.ParagraphValidator(v); err != nil {
+			return &ValidationError{Name: "paragraph", err: fmt.Errorf(`ent: validator failed for field "CTABlock.paragraph": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "CTABlock.active"`)}
+	}
+	return nil
+}
+
+func (_c *CTABlockCreate) sqlSave(ctx context.Context) (*CTABlock, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CTABlockCreate) createSpec() (*CTABlock, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CTABlock{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ctablock.Table, sqlgraph.NewFieldSpec(ctablock.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(ctablock.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Heading(); ok {
+		_spec.SetField(ctablock.FieldHeading, field.TypeString, value)
+		_node.Heading = value
+	}
+	if value, ok := _c.mutation.Body(); ok {
+		_spec.SetField(ctablock.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := _c.mutation.ButtonText(); ok {
+		_spec.SetField(ctablock.FieldButtonText, field.TypeString, value)
+		_node.ButtonText = value
+	}
+	if value, ok := _c.mutation.ButtonURL(); ok {
+		_spec.SetField(ctablock.FieldButtonURL, field.TypeString, value)
+		_node.ButtonURL = value
+	}
+	if value, ok := _c.mutation.Placement(); ok {
+		_spec.SetField(ctablock.FieldPlacement, field.TypeEnum, value)
+		_node.Placement = value
+	}
+	if value, ok := _c.mutation.Paragraph(); ok {
+		_spec.SetField(ctablock.FieldParagraph, field.TypeInt, value)
+		_node.Paragraph = value
+	}
+	if value, ok := _c.mutation.Active(); ok {
+		_spec.SetField(ctablock.FieldActive, field.TypeBool, value)
+		_node.Active = value
+	}
+	if nodes := _c.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ctablock.CategoryTable,
+			Columns: []string{ctablock.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CategoryID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CTABlockCreateBulk is the builder for creating many CTABlock entities in bulk.
+type CTABlockCreateBulk struct {
+	config
+	err      error
+	builders []*CTABlockCreate
+}
+
+// Save creates the CTABlock entities in the database.
+func (_c *CTABlockCreateBulk) Save(ctx context.Context) ([]*CTABlock, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CTABlock, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CTABlockMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CTABlockCreateBulk) SaveX(ctx context.Context) []*CTABlock {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CTABlockCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CTABlockCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
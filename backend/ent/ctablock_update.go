@@ -0,0 +1,706 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"landing/backend/ent/category"
+	"landing/backend/ent/ctablock"
+	"landing/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CTABlockUpdate is the builder for updating CTABlock entities.
+type CTABlockUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CTABlockMutation
+}
+
+// Where appends a list predicates to the CTABlockUpdate builder.
+func (_u *CTABlockUpdate) Where(ps ...predicate.CTABlock) *CTABlockUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *CTABlockUpdate) SetName(v string) *CTABlockUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *CTABlockUpdate) SetNillableName(v *string) *CTABlockUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetHeading sets the "heading" field.
+func (_u *CTABlockUpdate) SetHeading(v string) *CTABlockUpdate {
+	_u.mutation.SetHeading(v)
+	return _u
+}
+
+// SetNillableHeading sets the "heading" field if the given value is not nil.
+func (_u *CTABlockUpdate) SetNillableHeading(v *string) *CTABlockUpdate {
+	if v != nil {
+		_u.SetHeading(*v)
+	}
+	return _u
+}
+
+// ClearHeading clears the value of the "heading" field.
+func (_u *CTABlockUpdate) ClearHeading() *CTABlockUpdate {
+	_u.mutation.ClearHeading()
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *CTABlockUpdate) SetBody(v string) *CTABlockUpdate {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *CTABlockUpdate) SetNillableBody(v *string) *CTABlockUpdate {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// ClearBody clears the value of the "body" field.
+func (_u *CTABlockUpdate) ClearBody() *CTABlockUpdate {
+	_u.mutation.ClearBody()
+	return _u
+}
+
+// SetButtonText sets the "button_text" field.
+func (_u *CTABlockUpdate) SetButtonText(v string) *CTABlockUpdate {
+	_u.mutation.SetButtonText(v)
+	return _u
+}
+
+// SetNillableButtonText sets the "button_text" field if the given value is not nil.
+func (_u *CTABlockUpdate) SetNillableButtonText(v *string) *CTABlockUpdate {
+	if v != nil {
+		_u.SetButtonText(*v)
+	}
+	return _u
+}
+
+// ClearButtonText clears the value of the "button_text" field.
+func (_u *CTABlockUpdate) ClearButtonText() *CTABlockUpdate {
+	_u.mutation.ClearButtonText()
+	return _u
+}
+
+// SetButtonURL sets the "button_url" field.
+func (_u *CTABlockUpdate) SetButtonURL(v string) *CTABlockUpdate {
+	_u.mutation.SetButtonURL(v)
+	return _u
+}
+
+// SetNillableButtonURL sets the "button_url" field if the given value is not nil.
+func (_u *CTABlockUpdate) SetNillableButtonURL(v *string) *CTABlockUpdate {
+	if v != nil {
+		_u.SetButtonURL(*v)
+	}
+	return _u
+}
+
+// ClearButtonURL clears the value of the "button_url" field.
+func (_u *CTABlockUpdate) ClearButtonURL() *CTABlockUpdate {
+	_u.mutation.ClearButtonURL()
+	return _u
+}
+
+// SetPlacement sets the "placement" field.
+func (_u *CTABlockUpdate) SetPlacement(v ctablock.Placement) *CTABlockUpdate {
+	_u.mutation.SetPlacement(v)
+	return _u
+}
+
+// SetNillablePlacement sets the "placement" field if the given value is not nil.
+func (_u *CTABlockUpdate) SetNillablePlacement(v *ctablock.Placement) *CTABlockUpdate {
+	if v != nil {
+		_u.SetPlacement(*v)
+	}
+	return _u
+}
+
+// SetParagraph sets the "paragraph" field.
+func (_u *CTABlockUpdate) SetParagraph(v int) *CTABlockUpdate {
+	_u.mutation.ResetParagraph()
+	_u.mutation.SetParagraph(v)
+	return _u
+}
+
+// SetNillableParagraph sets the "paragraph" field if the given value is not nil.
+func (_u *CTABlockUpdate) SetNillableParagraph(v *int) *CTABlockUpdate {
+	if v != nil {
+		_u.SetParagraph(*v)
+	}
+	return _u
+}
+
+// AddParagraph adds value to the "paragraph" field.
+func (_u *CTABlockUpdate) AddParagraph(v int) *CTABlockUpdate {
+	_u.mutation.AddParagraph(v)
+	return _u
+}
+
+// SetActive sets the "active" field.
+func (_u *CTABlockUpdate) SetActive(v bool) *CTABlockUpdate {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *CTABlockUpdate) SetNillableActive(v *bool) *CTABlockUpdate {
+	if v != nil {
+		_u.SetActive(*v)
+	}
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *CTABlockUpdate) SetCategoryID(v int) *CTABlockUpdate {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *CTABlockUpdate) SetNillableCategoryID(v *int) *CTABlockUpdate {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *CTABlockUpdate) ClearCategoryID() *CTABlockUpdate {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *CTABlockUpdate) SetCategory(v *Category) *CTABlockUpdate {
+	return _u.SetCategoryID(v.ID)
+}
+
+// Mutation returns the CTABlockMutation object of the builder.
+func (_u *CTABlockUpdate) Mutation() *CTABlockMutation {
+	return _u.mutation
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *CTABlockUpdate) ClearCategory() *CTABlockUpdate {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CTABlockUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CTABlockUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CTABlockUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CTABlockUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CTABlockUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := ctablock.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CTABlock.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Placement(); ok {
+		if err := ctablock.PlacementValidator(v); err != nil {
+			return &ValidationError{Name: "placement", err: fmt.Errorf(`ent: validator failed for field "CTABlock.placement": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Paragraph(); ok {
+		if err := ctablock.ParagraphValidator(v); err != nil {
+			return &ValidationError{Name: "paragraph", err: fmt.Errorf(`ent: validator failed for field "CTABlock.paragraph": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CTABlockUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ctablock.Table, ctablock.Columns, sqlgraph.NewFieldSpec(ctablock.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(ctablock.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Heading(); ok {
+		_spec.SetField(ctablock.FieldHeading, field.TypeString, value)
+	}
+	if _u.mutation.HeadingCleared() {
+		_spec.ClearField(ctablock.FieldHeading, field.TypeString)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(ctablock.FieldBody, field.TypeString, value)
+	}
+	if _u.mutation.BodyCleared() {
+		_spec.ClearField(ctablock.FieldBody, field.TypeString)
+	}
+	if value, ok := _u.mutation.ButtonText(); ok {
+		_spec.SetField(ctablock.FieldButtonText, field.TypeString, value)
+	}
+	if _u.mutation.ButtonTextCleared() {
+		_spec.ClearField(ctablock.FieldButtonText, field.TypeString)
+	}
+	if value, ok := _u.mutation.ButtonURL(); ok {
+		_spec.SetField(ctablock.FieldButtonURL, field.TypeString, value)
+	}
+	if _u.mutation.ButtonURLCleared() {
+		_spec.ClearField(ctablock.FieldButtonURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.Placement(); ok {
+		_spec.SetField(ctablock.FieldPlacement, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Paragraph(); ok {
+		_spec.SetField(ctablock.FieldParagraph, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedParagraph(); ok {
+		_spec.AddField(ctablock.FieldParagraph, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(ctablock.FieldActive, field.TypeBool, value)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ctablock.CategoryTable,
+			Columns: []string{ctablock.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ctablock.CategoryTable,
+			Columns: []string{ctablock.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ctablock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CTABlockUpdateOne is the builder for updating a single CTABlock entity.
+type CTABlockUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CTABlockMutation
+}
+
+// SetName sets the "name" field.
+func (_u *CTABlockUpdateOne) SetName(v string) *CTABlockUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *CTABlockUpdateOne) SetNillableName(v *string) *CTABlockUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetHeading sets the "heading" field.
+func (_u *CTABlockUpdateOne) SetHeading(v string) *CTABlockUpdateOne {
+	_u.mutation.SetHeading(v)
+	return _u
+}
+
+// SetNillableHeading sets the "heading" field if the given value is not nil.
+func (_u *CTABlockUpdateOne) SetNillableHeading(v *string) *CTABlockUpdateOne {
+	if v != nil {
+		_u.SetHeading(*v)
+	}
+	return _u
+}
+
+// ClearHeading clears the value of the "heading" field.
+func (_u *CTABlockUpdateOne) ClearHeading() *CTABlockUpdateOne {
+	_u.mutation.ClearHeading()
+	return _u
+}
+
+// SetBody sets the "body" field.
+func (_u *CTABlockUpdateOne) SetBody(v string) *CTABlockUpdateOne {
+	_u.mutation.SetBody(v)
+	return _u
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (_u *CTABlockUpdateOne) SetNillableBody(v *string) *CTABlockUpdateOne {
+	if v != nil {
+		_u.SetBody(*v)
+	}
+	return _u
+}
+
+// ClearBody clears the value of the "body" field.
+func (_u *CTABlockUpdateOne) ClearBody() *CTABlockUpdateOne {
+	_u.mutation.ClearBody()
+	return _u
+}
+
+// SetButtonText sets the "button_text" field.
+func (_u *CTABlockUpdateOne) SetButtonText(v string) *CTABlockUpdateOne {
+	_u.mutation.SetButtonText(v)
+	return _u
+}
+
+// SetNillableButtonText sets the "button_text" field if the given value is not nil.
+func (_u *CTABlockUpdateOne) SetNillableButtonText(v *string) *CTABlockUpdateOne {
+	if v != nil {
+		_u.SetButtonText(*v)
+	}
+	return _u
+}
+
+// ClearButtonText clears the value of the "button_text" field.
+func (_u *CTABlockUpdateOne) ClearButtonText() *CTABlockUpdateOne {
+	_u.mutation.ClearButtonText()
+	return _u
+}
+
+// SetButtonURL sets the "button_url" field.
+func (_u *CTABlockUpdateOne) SetButtonURL(v string) *CTABlockUpdateOne {
+	_u.mutation.SetButtonURL(v)
+	return _u
+}
+
+// SetNillableButtonURL sets the "button_url" field if the given value is not nil.
+func (_u *CTABlockUpdateOne) SetNillableButtonURL(v *string) *CTABlockUpdateOne {
+	if v != nil {
+		_u.SetButtonURL(*v)
+	}
+	return _u
+}
+
+// ClearButtonURL clears the value of the "button_url" field.
+func (_u *CTABlockUpdateOne) ClearButtonURL() *CTABlockUpdateOne {
+	_u.mutation.ClearButtonURL()
+	return _u
+}
+
+// SetPlacement sets the "placement" field.
+func (_u *CTABlockUpdateOne) SetPlacement(v ctablock.Placement) *CTABlockUpdateOne {
+	_u.mutation.SetPlacement(v)
+	return _u
+}
+
+// SetNillablePlacement sets the "placement" field if the given value is not nil.
+func (_u *CTABlockUpdateOne) SetNillablePlacement(v *ctablock.Placement) *CTABlockUpdateOne {
+	if v != nil {
+		_u.SetPlacement(*v)
+	}
+	return _u
+}
+
+// SetParagraph sets the "paragraph" field.
+func (_u *CTABlockUpdateOne) SetParagraph(v int) *CTABlockUpdateOne {
+	_u.mutation.ResetParagraph()
+	_u.mutation.SetParagraph(v)
+	return _u
+}
+
+// SetNillableParagraph sets the "paragraph" field if the given value is not nil.
+func (_u *CTABlockUpdateOne) SetNillableParagraph(v *int) *CTABlockUpdateOne {
+	if v != nil {
+		_u.SetParagraph(*v)
+	}
+	return _u
+}
+
+// AddParagraph adds value to the "paragraph" field.
+func (_u *CTABlockUpdateOne) AddParagraph(v int) *CTABlockUpdateOne {
+	_u.mutation.AddParagraph(v)
+	return _u
+}
+
+// SetActive sets the "active" field.
+func (_u *CTABlockUpdateOne) SetActive(v bool) *CTABlockUpdateOne {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *CTABlockUpdateOne) SetNillableActive(v *bool) *CTABlockUpdateOne {
+	if v != nil {
+		_u.SetActive(*v)
+	}
+	return _u
+}
+
+// SetCategoryID sets the "category_id" field.
+func (_u *CTABlockUpdateOne) SetCategoryID(v int) *CTABlockUpdateOne {
+	_u.mutation.SetCategoryID(v)
+	return _u
+}
+
+// SetNillableCategoryID sets the "category_id" field if the given value is not nil.
+func (_u *CTABlockUpdateOne) SetNillableCategoryID(v *int) *CTABlockUpdateOne {
+	if v != nil {
+		_u.SetCategoryID(*v)
+	}
+	return _u
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (_u *CTABlockUpdateOne) ClearCategoryID() *CTABlockUpdateOne {
+	_u.mutation.ClearCategoryID()
+	return _u
+}
+
+// SetCategory sets the "category" edge to the Category entity.
+func (_u *CTABlockUpdateOne) SetCategory(v *Category) *CTABlockUpdateOne {
+	return _u.SetCategoryID(v.ID)
+}
+
+// Mutation returns the CTABlockMutation object of the builder.
+func (_u *CTABlockUpdateOne) Mutation() *CTABlockMutation {
+	return _u.mutation
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (_u *CTABlockUpdateOne) ClearCategory() *CTABlockUpdateOne {
+	_u.mutation.ClearCategory()
+	return _u
+}
+
+// Where appends a list predicates to the CTABlockUpdate builder.
+func (_u *CTABlockUpdateOne) Where(ps ...predicate.CTABlock) *CTABlockUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CTABlockUpdateOne) Select(field string, fields ...string) *CTABlockUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CTABlock entity.
+func (_u *CTABlockUpdateOne) Save(ctx context.Context) (*CTABlock, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CTABlockUpdateOne) SaveX(ctx context.Context) *CTABlock {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CTABlockUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CTABlockUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CTABlockUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := ctablock.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "CTABlock.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Placement(); ok {
+		if err := ctablock.PlacementValidator(v); err != nil {
+			return &ValidationError{Name: "placement", err: fmt.Errorf(`ent: validator failed for field "CTABlock.placement": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Paragraph(); ok {
+		if err := ctablock.ParagraphValidator(v); err != nil {
+			return &ValidationError{Name: "paragraph", err: fmt.Errorf(`ent: validator failed for field "CTABlock.paragraph": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CTABlockUpdateOne) sqlSave(ctx context.Context) (_node *CTABlock, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ctablock.Table, ctablock.Columns, sqlgraph.NewFieldSpec(ctablock.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CTABlock.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ctablock.FieldID)
+		for _, f := range fields {
+			if !ctablock.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ctablock.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(ctablock.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Heading(); ok {
+		_spec.SetField(ctablock.FieldHeading, field.TypeString, value)
+	}
+	if _u.mutation.HeadingCleared() {
+		_spec.ClearField(ctablock.FieldHeading, field.TypeString)
+	}
+	if value, ok := _u.mutation.Body(); ok {
+		_spec.SetField(ctablock.FieldBody, field.TypeString, value)
+	}
+	if _u.mutation.BodyCleared() {
+		_spec.ClearField(ctablock.FieldBody, field.TypeString)
+	}
+	if value, ok := _u.mutation.ButtonText(); ok {
+		_spec.SetField(ctablock.FieldButtonText, field.TypeString, value)
+	}
+	if _u.mutation.ButtonTextCleared() {
+		_spec.ClearField(ctablock.FieldButtonText, field.TypeString)
+	}
+	if value, ok := _u.mutation.ButtonURL(); ok {
+		_spec.SetField(ctablock.FieldButtonURL, field.TypeString, value)
+	}
+	if _u.mutation.ButtonURLCleared() {
+		_spec.ClearField(ctablock.FieldButtonURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.Placement(); ok {
+		_spec.SetField(ctablock.FieldPlacement, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Paragraph(); ok {
+		_spec.SetField(ctablock.FieldParagraph, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedParagraph(); ok {
+		_spec.AddField(ctablock.FieldParagraph, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(ctablock.FieldActive, field.TypeBool, value)
+	}
+	if _u.mutation.CategoryCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ctablock.CategoryTable,
+			Columns: []string{ctablock.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CategoryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ctablock.CategoryTable,
+			Columns: []string{ctablock.CategoryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &CTABlock{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ctablock.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
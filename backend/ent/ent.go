@@ -7,6 +7,12 @@ import (
 	"errors"
 	"fmt"
 	"landing/backend/ent/blog"
+	"landing/backend/ent/blogchunk"
+	"landing/backend/ent/category"
+	"landing/backend/ent/ctablock"
+	"landing/backend/ent/media"
+	"landing/backend/ent/placeholder"
+	"landing/backend/ent/tag"
 	"landing/backend/ent/user"
 	"reflect"
 	"sync"
@@ -74,8 +80,14 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			blog.Table: blog.ValidColumn,
-			user.Table: user.ValidColumn,
+			blog.Table:        blog.ValidColumn,
+			blogchunk.Table:   blogchunk.ValidColumn,
+			ctablock.Table:    ctablock.ValidColumn,
+			category.Table:    category.ValidColumn,
+			media.Table:       media.ValidColumn,
+			placeholder.Table: placeholder.ValidColumn,
+			tag.Table:         tag.ValidColumn,
+			user.Table:        user.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,574 @@
+// Code generated by ent, DO NOT EDIT.
+
+package media
+
+import (
+	"landing/backend/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldID, id))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldKey, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldURL, v))
+}
+
+// ContentType applies equality check predicate on the "content_type" field. It's identical to ContentTypeEQ.
+func ContentType(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldContentType, v))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int64) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldSize, v))
+}
+
+// Width applies equality check predicate on the "width" field. It's identical to WidthEQ.
+func Width(v int) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldWidth, v))
+}
+
+// Height applies equality check predicate on the "height" field. It's identical to HeightEQ.
+func Height(v int) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldHeight, v))
+}
+
+// Alt applies equality check predicate on the "alt" field. It's identical to AltEQ.
+func Alt(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldAlt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v string) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v string) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldKey, v))
+}
+
+// KeyContains applies the Contains predicate on the "key" field.
+func KeyContains(v string) predicate.Media {
+	return predicate.Media(sql.FieldContains(FieldKey, v))
+}
+
+// KeyHasPrefix applies the HasPrefix predicate on the "key" field.
+func KeyHasPrefix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasPrefix(FieldKey, v))
+}
+
+// KeyHasSuffix applies the HasSuffix predicate on the "key" field.
+func KeyHasSuffix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasSuffix(FieldKey, v))
+}
+
+// KeyEqualFold applies the EqualFold predicate on the "key" field.
+func KeyEqualFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldEqualFold(FieldKey, v))
+}
+
+// KeyContainsFold applies the ContainsFold predicate on the "key" field.
+func KeyContainsFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldContainsFold(FieldKey, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.Media {
+	return predicate.Media(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldContainsFold(FieldURL, v))
+}
+
+// ContentTypeEQ applies the EQ predicate on the "content_type" field.
+func ContentTypeEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldContentType, v))
+}
+
+// ContentTypeNEQ applies the NEQ predicate on the "content_type" field.
+func ContentTypeNEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldContentType, v))
+}
+
+// ContentTypeIn applies the In predicate on the "content_type" field.
+func ContentTypeIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldContentType, vs...))
+}
+
+// ContentTypeNotIn applies the NotIn predicate on the "content_type" field.
+func ContentTypeNotIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldContentType, vs...))
+}
+
+// ContentTypeGT applies the GT predicate on the "content_type" field.
+func ContentTypeGT(v string) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldContentType, v))
+}
+
+// ContentTypeGTE applies the GTE predicate on the "content_type" field.
+func ContentTypeGTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldContentType, v))
+}
+
+// ContentTypeLT applies the LT predicate on the "content_type" field.
+func ContentTypeLT(v string) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldContentType, v))
+}
+
+// ContentTypeLTE applies the LTE predicate on the "content_type" field.
+func ContentTypeLTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldContentType, v))
+}
+
+// ContentTypeContains applies the Contains predicate on the "content_type" field.
+func ContentTypeContains(v string) predicate.Media {
+	return predicate.Media(sql.FieldContains(FieldContentType, v))
+}
+
+// ContentTypeHasPrefix applies the HasPrefix predicate on the "content_type" field.
+func ContentTypeHasPrefix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasPrefix(FieldContentType, v))
+}
+
+// ContentTypeHasSuffix applies the HasSuffix predicate on the "content_type" field.
+func ContentTypeHasSuffix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasSuffix(FieldContentType, v))
+}
+
+// ContentTypeEqualFold applies the EqualFold predicate on the "content_type" field.
+func ContentTypeEqualFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldEqualFold(FieldContentType, v))
+}
+
+// ContentTypeContainsFold applies the ContainsFold predicate on the "content_type" field.
+func ContentTypeContainsFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldContainsFold(FieldContentType, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int64) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int64) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int64) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int64) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int64) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int64) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int64) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int64) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldSize, v))
+}
+
+// WidthEQ applies the EQ predicate on the "width" field.
+func WidthEQ(v int) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldWidth, v))
+}
+
+// WidthNEQ applies the NEQ predicate on the "width" field.
+func WidthNEQ(v int) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldWidth, v))
+}
+
+// WidthIn applies the In predicate on the "width" field.
+func WidthIn(vs ...int) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldWidth, vs...))
+}
+
+// WidthNotIn applies the NotIn predicate on the "width" field.
+func WidthNotIn(vs ...int) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldWidth, vs...))
+}
+
+// WidthGT applies the GT predicate on the "width" field.
+func WidthGT(v int) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldWidth, v))
+}
+
+// WidthGTE applies the GTE predicate on the "width" field.
+func WidthGTE(v int) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldWidth, v))
+}
+
+// WidthLT applies the LT predicate on the "width" field.
+func WidthLT(v int) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldWidth, v))
+}
+
+// WidthLTE applies the LTE predicate on the "width" field.
+func WidthLTE(v int) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldWidth, v))
+}
+
+// HeightEQ applies the EQ predicate on the "height" field.
+func HeightEQ(v int) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldHeight, v))
+}
+
+// HeightNEQ applies the NEQ predicate on the "height" field.
+func HeightNEQ(v int) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldHeight, v))
+}
+
+// HeightIn applies the In predicate on the "height" field.
+func HeightIn(vs ...int) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldHeight, vs...))
+}
+
+// HeightNotIn applies the NotIn predicate on the "height" field.
+func HeightNotIn(vs ...int) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldHeight, vs...))
+}
+
+// HeightGT applies the GT predicate on the "height" field.
+func HeightGT(v int) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldHeight, v))
+}
+
+// HeightGTE applies the GTE predicate on the "height" field.
+func HeightGTE(v int) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldHeight, v))
+}
+
+// HeightLT applies the LT predicate on the "height" field.
+func HeightLT(v int) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldHeight, v))
+}
+
+// HeightLTE applies the LTE predicate on the "height" field.
+func HeightLTE(v int) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldHeight, v))
+}
+
+// AltEQ applies the EQ predicate on the "alt" field.
+func AltEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldAlt, v))
+}
+
+// AltNEQ applies the NEQ predicate on the "alt" field.
+func AltNEQ(v string) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldAlt, v))
+}
+
+// AltIn applies the In predicate on the "alt" field.
+func AltIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldAlt, vs...))
+}
+
+// AltNotIn applies the NotIn predicate on the "alt" field.
+func AltNotIn(vs ...string) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldAlt, vs...))
+}
+
+// AltGT applies the GT predicate on the "alt" field.
+func AltGT(v string) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldAlt, v))
+}
+
+// AltGTE applies the GTE predicate on the "alt" field.
+func AltGTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldAlt, v))
+}
+
+// AltLT applies the LT predicate on the "alt" field.
+func AltLT(v string) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldAlt, v))
+}
+
+// AltLTE applies the LTE predicate on the "alt" field.
+func AltLTE(v string) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldAlt, v))
+}
+
+// AltContains applies the Contains predicate on the "alt" field.
+func AltContains(v string) predicate.Media {
+	return predicate.Media(sql.FieldContains(FieldAlt, v))
+}
+
+// AltHasPrefix applies the HasPrefix predicate on the "alt" field.
+func AltHasPrefix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasPrefix(FieldAlt, v))
+}
+
+// AltHasSuffix applies the HasSuffix predicate on the "alt" field.
+func AltHasSuffix(v string) predicate.Media {
+	return predicate.Media(sql.FieldHasSuffix(FieldAlt, v))
+}
+
+// AltIsNil applies the IsNil predicate on the "alt" field.
+func AltIsNil() predicate.Media {
+	return predicate.Media(sql.FieldIsNull(FieldAlt))
+}
+
+// AltNotNil applies the NotNil predicate on the "alt" field.
+func AltNotNil() predicate.Media {
+	return predicate.Media(sql.FieldNotNull(FieldAlt))
+}
+
+// AltEqualFold applies the EqualFold predicate on the "alt" field.
+func AltEqualFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldEqualFold(FieldAlt, v))
+}
+
+// AltContainsFold applies the ContainsFold predicate on the "alt" field.
+func AltContainsFold(v string) predicate.Media {
+	return predicate.Media(sql.FieldContainsFold(FieldAlt, v))
+}
+
+// VariantsIsNil applies the IsNil predicate on the "variants" field.
+func VariantsIsNil() predicate.Media {
+	return predicate.Media(sql.FieldIsNull(FieldVariants))
+}
+
+// VariantsNotNil applies the NotNil predicate on the "variants" field.
+func VariantsNotNil() predicate.Media {
+	return predicate.Media(sql.FieldNotNull(FieldVariants))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Media {
+	return predicate.Media(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Media {
+	return predicate.Media(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Media {
+	return predicate.Media(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasFeaturedIn applies the HasEdge predicate on the "featured_in" edge.
+func HasFeaturedIn() predicate.Media {
+	return predicate.Media(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FeaturedInTable, FeaturedInColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFeaturedInWith applies the HasEdge predicate on the "featured_in" edge with a given conditions (other predicates).
+func HasFeaturedInWith(preds ...predicate.Blog) predicate.Media {
+	return predicate.Media(func(s *sql.Selector) {
+		step := newFeaturedInStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Media) predicate.Media {
+	return predicate.Media(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Media) predicate.Media {
+	return predicate.Media(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Media) predicate.Media {
+	return predicate.Media(sql.NotPredicates(p))
+}
@@ -0,0 +1,371 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"landing/backend/ent/blog"
+	"landing/backend/ent/media"
+	"landing/backend/internal/imaging"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MediaCreate is the builder for creating a Media entity.
+type MediaCreate struct {
+	config
+	mutation *MediaMutation
+	hooks    []Hook
+}
+
+// SetKey sets the "key" field.
+func (_c *MediaCreate) SetKey(v string) *MediaCreate {
+	_c.mutation.SetKey(v)
+	return _c
+}
+
+// SetURL sets the "url" field.
+func (_c *MediaCreate) SetURL(v string) *MediaCreate {
+	_c.mutation.SetURL(v)
+	return _c
+}
+
+// SetContentType sets the "content_type" field.
+func (_c *MediaCreate) SetContentType(v string) *MediaCreate {
+	_c.mutation.SetContentType(v)
+	return _c
+}
+
+// SetSize sets the "size" field.
+func (_c *MediaCreate) SetSize(v int64) *MediaCreate {
+	_c.mutation.SetSize(v)
+	return _c
+}
+
+// SetWidth sets the "width" field.
+func (_c *MediaCreate) SetWidth(v int) *MediaCreate {
+	_c.mutation.SetWidth(v)
+	return _c
+}
+
+// SetHeight sets the "height" field.
+func (_c *MediaCreate) SetHeight(v int) *MediaCreate {
+	_c.mutation.SetHeight(v)
+	return _c
+}
+
+// SetAlt sets the "alt" field.
+func (_c *MediaCreate) SetAlt(v string) *MediaCreate {
+	_c.mutation.SetAlt(v)
+	return _c
+}
+
+// SetNillableAlt sets the "alt" field if the given value is not nil.
+func (_c *MediaCreate) SetNillableAlt(v *string) *MediaCreate {
+	if v != nil {
+		_c.SetAlt(*v)
+	}
+	return _c
+}
+
+// SetVariants sets the "variants" field.
+func (_c *MediaCreate) SetVariants(v []imaging.Variant) *MediaCreate {
+	_c.mutation.SetVariants(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *MediaCreate) SetCreatedAt(v time.Time) *MediaCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *MediaCreate) SetNillableCreatedAt(v *time.Time) *MediaCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// AddFeaturedInIDs adds the "featured_in" edge to the Blog entity by IDs.
+func (_c *MediaCreate) AddFeaturedInIDs(ids ...int) *MediaCreate {
+	_c.mutation.AddFeaturedInIDs(ids...)
+	return _c
+}
+
+// AddFeaturedIn adds the "featured_in" edges to the Blog entity.
+func (_c *MediaCreate) AddFeaturedIn(v ...*Blog) *MediaCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddFeaturedInIDs(ids...)
+}
+
+// Mutation returns the MediaMutation object of the builder.
+func (_c *MediaCreate) Mutation() *MediaMutation {
+	return _c.mutation
+}
+
+// Save creates the Media in the database.
+func (_c *MediaCreate) Save(ctx context.Context) (*Media, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *MediaCreate) SaveX(ctx context.Context) *Media {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MediaCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MediaCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *MediaCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := media.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *MediaCreate) check() error {
+	if _, ok := _c.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "Media.key"`)}
+	}
+	if v, ok := _c.mutation.Key(); ok {
+		if err := media.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "Media.key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "Media.url"`)}
+	}
+	if v, ok := _c.mutation.URL(); ok {
+		if err := media.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "Media.url": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ContentType(); !ok {
+		return &ValidationError{Name: "content_type", err: errors.New(`ent: missing required field "Media.content_type"`)}
+	}
+	if v, ok := _c.mutation.ContentType(); ok {
+		if err := media.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "Media.content_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "Media.size"`)}
+	}
+	if v, ok := _c.mutation.Size(); ok {
+		if err := media.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Media.size": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Width(); !ok {
+		return &ValidationError{Name: "width", err: errors.New(`ent: missing required field "Media.width"`)}
+	}
+	if v, ok := _c.mutation.Width(); ok {
+		if err := media.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "Media.width": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Height(); !ok {
+		return &ValidationError{Name: "height", err: errors.New(`ent: missing required field "Media.height"`)}
+	}
+	if v, ok := _c.mutation.Height(); ok {
+		if err := media.HeightValidator(v); err != nil {
+			return &ValidationError{Name: "height", err: fmt.Errorf(`ent: validator failed for field "Media.height": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Media.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *MediaCreate) sqlSave(ctx context.Context) (*Media, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *MediaCreate) createSpec() (*Media, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Media{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(media.Table, sqlgraph.NewFieldSpec(media.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Key(); ok {
+		_spec.SetField(media.FieldKey, field.TypeString, value)
+		_node.Key = value
+	}
+	if value, ok := _c.mutation.URL(); ok {
+		_spec.SetField(media.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := _c.mutation.ContentType(); ok {
+		_spec.SetField(media.FieldContentType, field.TypeString, value)
+		_node.ContentType = value
+	}
+	if value, ok := _c.mutation.Size(); ok {
+		_spec.SetField(media.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := _c.mutation.Width(); ok {
+		_spec.SetField(media.FieldWidth, field.TypeInt, value)
+		_node.Width = value
+	}
+	if value, ok := _c.mutation.Height(); ok {
+		_spec.SetField(media.FieldHeight, field.TypeInt, value)
+		_node.Height = value
+	}
+	if value, ok := _c.mutation.Alt(); ok {
+		_spec.SetField(media.FieldAlt, field.TypeString, value)
+		_node.Alt = value
+	}
+	if value, ok := _c.mutation.Variants(); ok {
+		_spec.SetField(media.FieldVariants, field.TypeJSON, value)
+		_node.Variants = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(media.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.FeaturedInIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   media.FeaturedInTable,
+			Columns: []string{media.FeaturedInColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// MediaCreateBulk is the builder for creating many Media entities in bulk.
+type MediaCreateBulk struct {
+	config
+	err      error
+	builders []*MediaCreate
+}
+
+// Save creates the Media entities in the database.
+func (_c *MediaCreateBulk) Save(ctx context.Context) ([]*Media, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Media, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MediaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *MediaCreateBulk) SaveX(ctx context.Context) []*Media {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *MediaCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *MediaCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,790 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"landing/backend/ent/blog"
+	"landing/backend/ent/media"
+	"landing/backend/ent/predicate"
+	"landing/backend/internal/imaging"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// MediaUpdate is the builder for updating Media entities.
+type MediaUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MediaMutation
+}
+
+// Where appends a list predicates to the MediaUpdate builder.
+func (_u *MediaUpdate) Where(ps ...predicate.Media) *MediaUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetKey sets the "key" field.
+func (_u *MediaUpdate) SetKey(v string) *MediaUpdate {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *MediaUpdate) SetNillableKey(v *string) *MediaUpdate {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *MediaUpdate) SetURL(v string) *MediaUpdate {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *MediaUpdate) SetNillableURL(v *string) *MediaUpdate {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetContentType sets the "content_type" field.
+func (_u *MediaUpdate) SetContentType(v string) *MediaUpdate {
+	_u.mutation.SetContentType(v)
+	return _u
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_u *MediaUpdate) SetNillableContentType(v *string) *MediaUpdate {
+	if v != nil {
+		_u.SetContentType(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *MediaUpdate) SetSize(v int64) *MediaUpdate {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *MediaUpdate) SetNillableSize(v *int64) *MediaUpdate {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *MediaUpdate) AddSize(v int64) *MediaUpdate {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetWidth sets the "width" field.
+func (_u *MediaUpdate) SetWidth(v int) *MediaUpdate {
+	_u.mutation.ResetWidth()
+	_u.mutation.SetWidth(v)
+	return _u
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_u *MediaUpdate) SetNillableWidth(v *int) *MediaUpdate {
+	if v != nil {
+		_u.SetWidth(*v)
+	}
+	return _u
+}
+
+// AddWidth adds value to the "width" field.
+func (_u *MediaUpdate) AddWidth(v int) *MediaUpdate {
+	_u.mutation.AddWidth(v)
+	return _u
+}
+
+// SetHeight sets the "height" field.
+func (_u *MediaUpdate) SetHeight(v int) *MediaUpdate {
+	_u.mutation.ResetHeight()
+	_u.mutation.SetHeight(v)
+	return _u
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_u *MediaUpdate) SetNillableHeight(v *int) *MediaUpdate {
+	if v != nil {
+		_u.SetHeight(*v)
+	}
+	return _u
+}
+
+// AddHeight adds value to the "height" field.
+func (_u *MediaUpdate) AddHeight(v int) *MediaUpdate {
+	_u.mutation.AddHeight(v)
+	return _u
+}
+
+// SetAlt sets the "alt" field.
+func (_u *MediaUpdate) SetAlt(v string) *MediaUpdate {
+	_u.mutation.SetAlt(v)
+	return _u
+}
+
+// SetNillableAlt sets the "alt" field if the given value is not nil.
+func (_u *MediaUpdate) SetNillableAlt(v *string) *MediaUpdate {
+	if v != nil {
+		_u.SetAlt(*v)
+	}
+	return _u
+}
+
+// ClearAlt clears the value of the "alt" field.
+func (_u *MediaUpdate) ClearAlt() *MediaUpdate {
+	_u.mutation.ClearAlt()
+	return _u
+}
+
+// SetVariants sets the "variants" field.
+func (_u *MediaUpdate) SetVariants(v []imaging.Variant) *MediaUpdate {
+	_u.mutation.SetVariants(v)
+	return _u
+}
+
+// AppendVariants appends value to the "variants" field.
+func (_u *MediaUpdate) AppendVariants(v []imaging.Variant) *MediaUpdate {
+	_u.mutation.AppendVariants(v)
+	return _u
+}
+
+// ClearVariants clears the value of the "variants" field.
+func (_u *MediaUpdate) ClearVariants() *MediaUpdate {
+	_u.mutation.ClearVariants()
+	return _u
+}
+
+// AddFeaturedInIDs adds the "featured_in" edge to the Blog entity by IDs.
+func (_u *MediaUpdate) AddFeaturedInIDs(ids ...int) *MediaUpdate {
+	_u.mutation.AddFeaturedInIDs(ids...)
+	return _u
+}
+
+// AddFeaturedIn adds the "featured_in" edges to the Blog entity.
+func (_u *MediaUpdate) AddFeaturedIn(v ...*Blog) *MediaUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFeaturedInIDs(ids...)
+}
+
+// Mutation returns the MediaMutation object of the builder.
+func (_u *MediaUpdate) Mutation() *MediaMutation {
+	return _u.mutation
+}
+
+// ClearFeaturedIn clears all "featured_in" edges to the Blog entity.
+func (_u *MediaUpdate) ClearFeaturedIn() *MediaUpdate {
+	_u.mutation.ClearFeaturedIn()
+	return _u
+}
+
+// RemoveFeaturedInIDs removes the "featured_in" edge to Blog entities by IDs.
+func (_u *MediaUpdate) RemoveFeaturedInIDs(ids ...int) *MediaUpdate {
+	_u.mutation.RemoveFeaturedInIDs(ids...)
+	return _u
+}
+
+// RemoveFeaturedIn removes "featured_in" edges to Blog entities.
+func (_u *MediaUpdate) RemoveFeaturedIn(v ...*Blog) *MediaUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFeaturedInIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *MediaUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MediaUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *MediaUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MediaUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MediaUpdate) check() error {
+	if v, ok := _u.mutation.Key(); ok {
+		if err := media.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "Media.key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.URL(); ok {
+		if err := media.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "Media.url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentType(); ok {
+		if err := media.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "Media.content_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Size(); ok {
+		if err := media.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Media.size": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Width(); ok {
+		if err := media.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "Media.width": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Height(); ok {
+		if err := media.HeightValidator(v); err != nil {
+			return &ValidationError{Name: "height", err: fmt.Errorf(`ent: validator failed for field "Media.height": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MediaUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(media.Table, media.Columns, sqlgraph.NewFieldSpec(media.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(media.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(media.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentType(); ok {
+		_spec.SetField(media.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(media.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(media.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Width(); ok {
+		_spec.SetField(media.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWidth(); ok {
+		_spec.AddField(media.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Height(); ok {
+		_spec.SetField(media.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHeight(); ok {
+		_spec.AddField(media.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Alt(); ok {
+		_spec.SetField(media.FieldAlt, field.TypeString, value)
+	}
+	if _u.mutation.AltCleared() {
+		_spec.ClearField(media.FieldAlt, field.TypeString)
+	}
+	if value, ok := _u.mutation.Variants(); ok {
+		_spec.SetField(media.FieldVariants, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVariants(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, media.FieldVariants, value)
+		})
+	}
+	if _u.mutation.VariantsCleared() {
+		_spec.ClearField(media.FieldVariants, field.TypeJSON)
+	}
+	if _u.mutation.FeaturedInCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   media.FeaturedInTable,
+			Columns: []string{media.FeaturedInColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blog.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFeaturedInIDs(); len(nodes) > 0 && !_u.mutation.FeaturedInCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   media.FeaturedInTable,
+			Columns: []string{media.FeaturedInColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FeaturedInIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   media.FeaturedInTable,
+			Columns: []string{media.FeaturedInColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{media.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// MediaUpdateOne is the builder for updating a single Media entity.
+type MediaUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MediaMutation
+}
+
+// SetKey sets the "key" field.
+func (_u *MediaUpdateOne) SetKey(v string) *MediaUpdateOne {
+	_u.mutation.SetKey(v)
+	return _u
+}
+
+// SetNillableKey sets the "key" field if the given value is not nil.
+func (_u *MediaUpdateOne) SetNillableKey(v *string) *MediaUpdateOne {
+	if v != nil {
+		_u.SetKey(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *MediaUpdateOne) SetURL(v string) *MediaUpdateOne {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *MediaUpdateOne) SetNillableURL(v *string) *MediaUpdateOne {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetContentType sets the "content_type" field.
+func (_u *MediaUpdateOne) SetContentType(v string) *MediaUpdateOne {
+	_u.mutation.SetContentType(v)
+	return _u
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (_u *MediaUpdateOne) SetNillableContentType(v *string) *MediaUpdateOne {
+	if v != nil {
+		_u.SetContentType(*v)
+	}
+	return _u
+}
+
+// SetSize sets the "size" field.
+func (_u *MediaUpdateOne) SetSize(v int64) *MediaUpdateOne {
+	_u.mutation.ResetSize()
+	_u.mutation.SetSize(v)
+	return _u
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (_u *MediaUpdateOne) SetNillableSize(v *int64) *MediaUpdateOne {
+	if v != nil {
+		_u.SetSize(*v)
+	}
+	return _u
+}
+
+// AddSize adds value to the "size" field.
+func (_u *MediaUpdateOne) AddSize(v int64) *MediaUpdateOne {
+	_u.mutation.AddSize(v)
+	return _u
+}
+
+// SetWidth sets the "width" field.
+func (_u *MediaUpdateOne) SetWidth(v int) *MediaUpdateOne {
+	_u.mutation.ResetWidth()
+	_u.mutation.SetWidth(v)
+	return _u
+}
+
+// SetNillableWidth sets the "width" field if the given value is not nil.
+func (_u *MediaUpdateOne) SetNillableWidth(v *int) *MediaUpdateOne {
+	if v != nil {
+		_u.SetWidth(*v)
+	}
+	return _u
+}
+
+// AddWidth adds value to the "width" field.
+func (_u *MediaUpdateOne) AddWidth(v int) *MediaUpdateOne {
+	_u.mutation.AddWidth(v)
+	return _u
+}
+
+// SetHeight sets the "height" field.
+func (_u *MediaUpdateOne) SetHeight(v int) *MediaUpdateOne {
+	_u.mutation.ResetHeight()
+	_u.mutation.SetHeight(v)
+	return _u
+}
+
+// SetNillableHeight sets the "height" field if the given value is not nil.
+func (_u *MediaUpdateOne) SetNillableHeight(v *int) *MediaUpdateOne {
+	if v != nil {
+		_u.SetHeight(*v)
+	}
+	return _u
+}
+
+// AddHeight adds value to the "height" field.
+func (_u *MediaUpdateOne) AddHeight(v int) *MediaUpdateOne {
+	_u.mutation.AddHeight(v)
+	return _u
+}
+
+// SetAlt sets the "alt" field.
+func (_u *MediaUpdateOne) SetAlt(v string) *MediaUpdateOne {
+	_u.mutation.SetAlt(v)
+	return _u
+}
+
+// SetNillableAlt sets the "alt" field if the given value is not nil.
+func (_u *MediaUpdateOne) SetNillableAlt(v *string) *MediaUpdateOne {
+	if v != nil {
+		_u.SetAlt(*v)
+	}
+	return _u
+}
+
+// ClearAlt clears the value of the "alt" field.
+func (_u *MediaUpdateOne) ClearAlt() *MediaUpdateOne {
+	_u.mutation.ClearAlt()
+	return _u
+}
+
+// SetVariants sets the "variants" field.
+func (_u *MediaUpdateOne) SetVariants(v []imaging.Variant) *MediaUpdateOne {
+	_u.mutation.SetVariants(v)
+	return _u
+}
+
+// AppendVariants appends value to the "variants" field.
+func (_u *MediaUpdateOne) AppendVariants(v []imaging.Variant) *MediaUpdateOne {
+	_u.mutation.AppendVariants(v)
+	return _u
+}
+
+// ClearVariants clears the value of the "variants" field.
+func (_u *MediaUpdateOne) ClearVariants() *MediaUpdateOne {
+	_u.mutation.ClearVariants()
+	return _u
+}
+
+// AddFeaturedInIDs adds the "featured_in" edge to the Blog entity by IDs.
+func (_u *MediaUpdateOne) AddFeaturedInIDs(ids ...int) *MediaUpdateOne {
+	_u.mutation.AddFeaturedInIDs(ids...)
+	return _u
+}
+
+// AddFeaturedIn adds the "featured_in" edges to the Blog entity.
+func (_u *MediaUpdateOne) AddFeaturedIn(v ...*Blog) *MediaUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddFeaturedInIDs(ids...)
+}
+
+// Mutation returns the MediaMutation object of the builder.
+func (_u *MediaUpdateOne) Mutation() *MediaMutation {
+	return _u.mutation
+}
+
+// ClearFeaturedIn clears all "featured_in" edges to the Blog entity.
+func (_u *MediaUpdateOne) ClearFeaturedIn() *MediaUpdateOne {
+	_u.mutation.ClearFeaturedIn()
+	return _u
+}
+
+// RemoveFeaturedInIDs removes the "featured_in" edge to Blog entities by IDs.
+func (_u *MediaUpdateOne) RemoveFeaturedInIDs(ids ...int) *MediaUpdateOne {
+	_u.mutation.RemoveFeaturedInIDs(ids...)
+	return _u
+}
+
+// RemoveFeaturedIn removes "featured_in" edges to Blog entities.
+func (_u *MediaUpdateOne) RemoveFeaturedIn(v ...*Blog) *MediaUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveFeaturedInIDs(ids...)
+}
+
+// Where appends a list predicates to the MediaUpdate builder.
+func (_u *MediaUpdateOne) Where(ps ...predicate.Media) *MediaUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *MediaUpdateOne) Select(field string, fields ...string) *MediaUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Media entity.
+func (_u *MediaUpdateOne) Save(ctx context.Context) (*Media, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *MediaUpdateOne) SaveX(ctx context.Context) *Media {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *MediaUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *MediaUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *MediaUpdateOne) check() error {
+	if v, ok := _u.mutation.Key(); ok {
+		if err := media.KeyValidator(v); err != nil {
+			return &ValidationError{Name: "key", err: fmt.Errorf(`ent: validator failed for field "Media.key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.URL(); ok {
+		if err := media.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "Media.url": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ContentType(); ok {
+		if err := media.ContentTypeValidator(v); err != nil {
+			return &ValidationError{Name: "content_type", err: fmt.Errorf(`ent: validator failed for field "Media.content_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Size(); ok {
+		if err := media.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Media.size": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Width(); ok {
+		if err := media.WidthValidator(v); err != nil {
+			return &ValidationError{Name: "width", err: fmt.Errorf(`ent: validator failed for field "Media.width": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Height(); ok {
+		if err := media.HeightValidator(v); err != nil {
+			return &ValidationError{Name: "height", err: fmt.Errorf(`ent: validator failed for field "Media.height": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *MediaUpdateOne) sqlSave(ctx context.Context) (_node *Media, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(media.Table, media.Columns, sqlgraph.NewFieldSpec(media.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Media.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, media.FieldID)
+		for _, f := range fields {
+			if !media.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != media.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Key(); ok {
+		_spec.SetField(media.FieldKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(media.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ContentType(); ok {
+		_spec.SetField(media.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Size(); ok {
+		_spec.SetField(media.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedSize(); ok {
+		_spec.AddField(media.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Width(); ok {
+		_spec.SetField(media.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedWidth(); ok {
+		_spec.AddField(media.FieldWidth, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Height(); ok {
+		_spec.SetField(media.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHeight(); ok {
+		_spec.AddField(media.FieldHeight, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Alt(); ok {
+		_spec.SetField(media.FieldAlt, field.TypeString, value)
+	}
+	if _u.mutation.AltCleared() {
+		_spec.ClearField(media.FieldAlt, field.TypeString)
+	}
+	if value, ok := _u.mutation.Variants(); ok {
+		_spec.SetField(media.FieldVariants, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedVariants(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, media.FieldVariants, value)
+		})
+	}
+	if _u.mutation.VariantsCleared() {
+		_spec.ClearField(media.FieldVariants, field.TypeJSON)
+	}
+	if _u.mutation.FeaturedInCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   media.FeaturedInTable,
+			Columns: []string{media.FeaturedInColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blog.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedFeaturedInIDs(); len(nodes) > 0 && !_u.mutation.FeaturedInCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   media.FeaturedInTable,
+			Columns: []string{media.FeaturedInColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.FeaturedInIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   media.FeaturedInTable,
+			Columns: []string{media.FeaturedInColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(blog.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Media{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{media.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -13,20 +13,179 @@ var (
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "category", Type: field.TypeString},
 		{Name: "text", Type: field.TypeString, Size: 2147483647},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"html", "markdown"}, Default: "html"},
+		{Name: "source", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "path", Type: field.TypeString, Unique: true},
 		{Name: "embedding", Type: field.TypeJSON, Nullable: true},
+		{Name: "embedding_hash", Type: field.TypeString, Nullable: true},
+		{Name: "schema_json", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "word_count", Type: field.TypeInt, Default: 0},
+		{Name: "char_count", Type: field.TypeInt, Default: 0},
+		{Name: "reading_minutes", Type: field.TypeInt, Default: 0},
+		{Name: "excerpt", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "meta_description", Type: field.TypeString, Nullable: true},
+		{Name: "excerpt_custom", Type: field.TypeBool, Default: false},
+		{Name: "meta_description_custom", Type: field.TypeBool, Default: false},
+		{Name: "keywords", Type: field.TypeJSON, Nullable: true},
+		{Name: "disable_cta", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "updated_at", Type: field.TypeTime, Default: "CURRENT_TIMESTAMP"},
+		{Name: "category_id", Type: field.TypeInt, Nullable: true},
+		{Name: "featured_image_id", Type: field.TypeInt, Nullable: true},
+		{Name: "author_id", Type: field.TypeInt, Nullable: true},
 	}
 	// BlogsTable holds the schema information for the "blogs" table.
 	BlogsTable = &schema.Table{
 		Name:       "blogs",
 		Columns:    BlogsColumns,
 		PrimaryKey: []*schema.Column{BlogsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "blogs_categories_blogs",
+				Columns:    []*schema.Column{BlogsColumns[20]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "blogs_media_featured_in",
+				Columns:    []*schema.Column{BlogsColumns[21]},
+				RefColumns: []*schema.Column{MediaColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "blogs_users_posts",
+				Columns:    []*schema.Column{BlogsColumns[22]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// BlogChunksColumns holds the columns for the "blog_chunks" table.
+	BlogChunksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "position", Type: field.TypeInt},
+		{Name: "heading", Type: field.TypeString, Nullable: true},
+		{Name: "text", Type: field.TypeString, Size: 2147483647},
+		{Name: "embedding", Type: field.TypeJSON, Nullable: true},
+		{Name: "embedding_hash", Type: field.TypeString, Nullable: true},
+		{Name: "blog_id", Type: field.TypeInt},
+	}
+	// BlogChunksTable holds the schema information for the "blog_chunks" table.
+	BlogChunksTable = &schema.Table{
+		Name:       "blog_chunks",
+		Columns:    BlogChunksColumns,
+		PrimaryKey: []*schema.Column{BlogChunksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "blog_chunks_blogs_chunks",
+				Columns:    []*schema.Column{BlogChunksColumns[6]},
+				RefColumns: []*schema.Column{BlogsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
+	// CtaBlocksColumns holds the columns for the "cta_blocks" table.
+	CtaBlocksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "heading", Type: field.TypeString, Nullable: true},
+		{Name: "body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "button_text", Type: field.TypeString, Nullable: true},
+		{Name: "button_url", Type: field.TypeString, Nullable: true},
+		{Name: "placement", Type: field.TypeEnum, Enums: []string{"end", "after_paragraph"}, Default: "end"},
+		{Name: "paragraph", Type: field.TypeInt, Default: 3},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "category_id", Type: field.TypeInt, Nullable: true},
+	}
+	// CtaBlocksTable holds the schema information for the "cta_blocks" table.
+	CtaBlocksTable = &schema.Table{
+		Name:       "cta_blocks",
+		Columns:    CtaBlocksColumns,
+		PrimaryKey: []*schema.Column{CtaBlocksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "cta_blocks_categories_cta_blocks",
+				Columns:    []*schema.Column{CtaBlocksColumns[9]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// CategoriesColumns holds the columns for the "categories" table.
+	CategoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeJSON, Nullable: true},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "parent_id", Type: field.TypeInt, Nullable: true},
+	}
+	// CategoriesTable holds the schema information for the "categories" table.
+	CategoriesTable = &schema.Table{
+		Name:       "categories",
+		Columns:    CategoriesColumns,
+		PrimaryKey: []*schema.Column{CategoriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "categories_categories_children",
+				Columns:    []*schema.Column{CategoriesColumns[4]},
+				RefColumns: []*schema.Column{CategoriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
+	// MediaColumns holds the columns for the "media" table.
+	MediaColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "url", Type: field.TypeString},
+		{Name: "content_type", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "width", Type: field.TypeInt},
+		{Name: "height", Type: field.TypeInt},
+		{Name: "alt", Type: field.TypeString, Nullable: true},
+		{Name: "variants", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// MediaTable holds the schema information for the "media" table.
+	MediaTable = &schema.Table{
+		Name:       "media",
+		Columns:    MediaColumns,
+		PrimaryKey: []*schema.Column{MediaColumns[0]},
+	}
+	// PlaceholdersColumns holds the columns for the "placeholders" table.
+	PlaceholdersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "value", Type: field.TypeString, Size: 2147483647},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+	}
+	// PlaceholdersTable holds the schema information for the "placeholders" table.
+	PlaceholdersTable = &schema.Table{
+		Name:       "placeholders",
+		Columns:    PlaceholdersColumns,
+		PrimaryKey: []*schema.Column{PlaceholdersColumns[0]},
+	}
+	// TagsColumns holds the columns for the "tags" table.
+	TagsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "slug", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString},
+	}
+	// TagsTable holds the schema information for the "tags" table.
+	TagsTable = &schema.Table{
+		Name:       "tags",
+		Columns:    TagsColumns,
+		PrimaryKey: []*schema.Column{TagsColumns[0]},
 	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "email", Type: field.TypeString, Unique: true},
+		{Name: "display_name", Type: field.TypeString, Default: schema.Expr("''")},
 		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "bio", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "avatar", Type: field.TypeString, Nullable: true},
+		{Name: "social_links", Type: field.TypeJSON, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -34,12 +193,52 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// BlogTagsColumns holds the columns for the "blog_tags" table.
+	BlogTagsColumns = []*schema.Column{
+		{Name: "blog_id", Type: field.TypeInt},
+		{Name: "tag_id", Type: field.TypeInt},
+	}
+	// BlogTagsTable holds the schema information for the "blog_tags" table.
+	BlogTagsTable = &schema.Table{
+		Name:       "blog_tags",
+		Columns:    BlogTagsColumns,
+		PrimaryKey: []*schema.Column{BlogTagsColumns[0], BlogTagsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "blog_tags_blog_id",
+				Columns:    []*schema.Column{BlogTagsColumns[0]},
+				RefColumns: []*schema.Column{BlogsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "blog_tags_tag_id",
+				Columns:    []*schema.Column{BlogTagsColumns[1]},
+				RefColumns: []*schema.Column{TagsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		BlogsTable,
+		BlogChunksTable,
+		CtaBlocksTable,
+		CategoriesTable,
+		MediaTable,
+		PlaceholdersTable,
+		TagsTable,
 		UsersTable,
+		BlogTagsTable,
 	}
 )
 
 func init() {
+	BlogsTable.ForeignKeys[0].RefTable = CategoriesTable
+	BlogsTable.ForeignKeys[1].RefTable = MediaTable
+	BlogsTable.ForeignKeys[2].RefTable = UsersTable
+	BlogChunksTable.ForeignKeys[0].RefTable = BlogsTable
+	CtaBlocksTable.ForeignKeys[0].RefTable = CategoriesTable
+	CategoriesTable.ForeignKeys[0].RefTable = CategoriesTable
+	BlogTagsTable.ForeignKeys[0].RefTable = BlogsTable
+	BlogTagsTable.ForeignKeys[1].RefTable = TagsTable
 }
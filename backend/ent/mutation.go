@@ -7,9 +7,17 @@ import (
 	"errors"
 	"fmt"
 	"landing/backend/ent/blog"
+	"landing/backend/ent/blogchunk"
+	"landing/backend/ent/category"
+	"landing/backend/ent/ctablock"
+	"landing/backend/ent/media"
+	"landing/backend/ent/placeholder"
 	"landing/backend/ent/predicate"
+	"landing/backend/ent/tag"
 	"landing/backend/ent/user"
+	"landing/backend/internal/imaging"
 	"sync"
+	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -24,25 +32,62 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeBlog = "Blog"
-	TypeUser = "User"
+	TypeBlog        = "Blog"
+	TypeBlogChunk   = "BlogChunk"
+	TypeCTABlock    = "CTABlock"
+	TypeCategory    = "Category"
+	TypeMedia       = "Media"
+	TypePlaceholder = "Placeholder"
+	TypeTag         = "Tag"
+	TypeUser        = "User"
 )
 
 // BlogMutation represents an operation that mutates the Blog nodes in the graph.
 type BlogMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	category        *string
-	text            *string
-	_path           *string
-	embedding       *[]float32
-	appendembedding []float32
-	clearedFields   map[string]struct{}
-	done            bool
-	oldValue        func(context.Context) (*Blog, error)
-	predicates      []predicate.Blog
+	op                      Op
+	typ                     string
+	id                      *int
+	category                *string
+	text                    *string
+	format                  *blog.Format
+	source                  *string
+	_path                   *string
+	embedding               *[]float32
+	appendembedding         []float32
+	embedding_hash          *string
+	schema_json             *string
+	word_count              *int
+	addword_count           *int
+	char_count              *int
+	addchar_count           *int
+	reading_minutes         *int
+	addreading_minutes      *int
+	excerpt                 *string
+	meta_description        *string
+	excerpt_custom          *bool
+	meta_description_custom *bool
+	keywords                *[]string
+	appendkeywords          []string
+	disable_cta             *bool
+	created_at              *time.Time
+	updated_at              *time.Time
+	clearedFields           map[string]struct{}
+	primary_category        *int
+	clearedprimary_category bool
+	tags                    map[int]struct{}
+	removedtags             map[int]struct{}
+	clearedtags             bool
+	author                  *int
+	clearedauthor           bool
+	featured_image          *int
+	clearedfeatured_image   bool
+	chunks                  map[int]struct{}
+	removedchunks           map[int]struct{}
+	clearedchunks           bool
+	done                    bool
+	oldValue                func(context.Context) (*Blog, error)
+	predicates              []predicate.Blog
 }
 
 var _ ent.Mutation = (*BlogMutation)(nil)
@@ -215,6 +260,91 @@ func (m *BlogMutation) ResetText() {
 	m.text = nil
 }
 
+// SetFormat sets the "format" field.
+func (m *BlogMutation) SetFormat(b blog.Format) {
+	m.format = &b
+}
+
+// Format returns the value of the "format" field in the mutation.
+func (m *BlogMutation) Format() (r blog.Format, exists bool) {
+	v := m.format
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFormat returns the old "format" field's value of the Blog entity.
+// If the Blog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlogMutation) OldFormat(ctx context.Context) (v blog.Format, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFormat requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
+	}
+	return oldValue.Format, nil
+}
+
+// ResetFormat resets all changes to the "format" field.
+func (m *BlogMutation) ResetFormat() {
+	m.format = nil
+}
+
+// SetSource sets the "source" field.
+func (m *BlogMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *BlogMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the Blog entity.
+// If the Blog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlogMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ClearSource clears the value of the "source" field.
+func (m *BlogMutation) ClearSource() {
+	m.source = nil
+	m.clearedFields[blog.FieldSource] = struct{}{}
+}
+
+// SourceCleared returns if the "source" field was cleared in this mutation.
+func (m *BlogMutation) SourceCleared() bool {
+	_, ok := m.clearedFields[blog.FieldSource]
+	return ok
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *BlogMutation) ResetSource() {
+	m.source = nil
+	delete(m.clearedFields, blog.FieldSource)
+}
+
 // SetPath sets the "path" field.
 func (m *BlogMutation) SetPath(s string) {
 	m._path = &s
@@ -316,243 +446,6221 @@ func (m *BlogMutation) ResetEmbedding() {
 	delete(m.clearedFields, blog.FieldEmbedding)
 }
 
-// Where appends a list predicates to the BlogMutation builder.
-func (m *BlogMutation) Where(ps ...predicate.Blog) {
-	m.predicates = append(m.predicates, ps...)
+// SetEmbeddingHash sets the "embedding_hash" field.
+func (m *BlogMutation) SetEmbeddingHash(s string) {
+	m.embedding_hash = &s
 }
 
-// WhereP appends storage-level predicates to the BlogMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *BlogMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Blog, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// EmbeddingHash returns the value of the "embedding_hash" field in the mutation.
+func (m *BlogMutation) EmbeddingHash() (r string, exists bool) {
+	v := m.embedding_hash
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *BlogMutation) Op() Op {
-	return m.op
+// OldEmbeddingHash returns the old "embedding_hash" field's value of the Blog entity.
+// If the Blog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlogMutation) OldEmbeddingHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbeddingHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbeddingHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbeddingHash: %w", err)
+	}
+	return oldValue.EmbeddingHash, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *BlogMutation) SetOp(op Op) {
-	m.op = op
+// ClearEmbeddingHash clears the value of the "embedding_hash" field.
+func (m *BlogMutation) ClearEmbeddingHash() {
+	m.embedding_hash = nil
+	m.clearedFields[blog.FieldEmbeddingHash] = struct{}{}
 }
 
-// Type returns the node type of this mutation (Blog).
-func (m *BlogMutation) Type() string {
-	return m.typ
+// EmbeddingHashCleared returns if the "embedding_hash" field was cleared in this mutation.
+func (m *BlogMutation) EmbeddingHashCleared() bool {
+	_, ok := m.clearedFields[blog.FieldEmbeddingHash]
+	return ok
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *BlogMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.category != nil {
-		fields = append(fields, blog.FieldCategory)
+// ResetEmbeddingHash resets all changes to the "embedding_hash" field.
+func (m *BlogMutation) ResetEmbeddingHash() {
+	m.embedding_hash = nil
+	delete(m.clearedFields, blog.FieldEmbeddingHash)
+}
+
+// SetSchemaJSON sets the "schema_json" field.
+func (m *BlogMutation) SetSchemaJSON(s string) {
+	m.schema_json = &s
+}
+
+// SchemaJSON returns the value of the "schema_json" field in the mutation.
+func (m *BlogMutation) SchemaJSON() (r string, exists bool) {
+	v := m.schema_json
+	if v == nil {
+		return
 	}
-	if m.text != nil {
-		fields = append(fields, blog.FieldText)
+	return *v, true
+}
+
+// OldSchemaJSON returns the old "schema_json" field's value of the Blog entity.
+// If the Blog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlogMutation) OldSchemaJSON(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSchemaJSON is only allowed on UpdateOne operations")
 	}
-	if m._path != nil {
-		fields = append(fields, blog.FieldPath)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSchemaJSON requires an ID field in the mutation")
 	}
-	if m.embedding != nil {
-		fields = append(fields, blog.FieldEmbedding)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSchemaJSON: %w", err)
 	}
-	return fields
+	return oldValue.SchemaJSON, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *BlogMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case blog.FieldCategory:
-		return m.Category()
-	case blog.FieldText:
-		return m.Text()
-	case blog.FieldPath:
-		return m.Path()
-	case blog.FieldEmbedding:
-		return m.Embedding()
+// ClearSchemaJSON clears the value of the "schema_json" field.
+func (m *BlogMutation) ClearSchemaJSON() {
+	m.schema_json = nil
+	m.clearedFields[blog.FieldSchemaJSON] = struct{}{}
+}
+
+// SchemaJSONCleared returns if the "schema_json" field was cleared in this mutation.
+func (m *BlogMutation) SchemaJSONCleared() bool {
+	_, ok := m.clearedFields[blog.FieldSchemaJSON]
+	return ok
+}
+
+// ResetSchemaJSON resets all changes to the "schema_json" field.
+func (m *BlogMutation) ResetSchemaJSON() {
+	m.schema_json = nil
+	delete(m.clearedFields, blog.FieldSchemaJSON)
+}
+
+// SetCategoryID sets the "category_id" field.
+func (m *BlogMutation) SetCategoryID(i int) {
+	m.primary_category = &i
+}
+
+// CategoryID returns the value of the "category_id" field in the mutation.
+func (m *BlogMutation) CategoryID() (r int, exists bool) {
+	v := m.primary_category
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *BlogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case blog.FieldCategory:
-		return m.OldCategory(ctx)
-	case blog.FieldText:
-		return m.OldText(ctx)
-	case blog.FieldPath:
-		return m.OldPath(ctx)
-	case blog.FieldEmbedding:
-		return m.OldEmbedding(ctx)
+// OldCategoryID returns the old "category_id" field's value of the Blog entity.
+// If the Blog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlogMutation) OldCategoryID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategoryID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategoryID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategoryID: %w", err)
+	}
+	return oldValue.CategoryID, nil
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (m *BlogMutation) ClearCategoryID() {
+	m.primary_category = nil
+	m.clearedFields[blog.FieldCategoryID] = struct{}{}
+}
+
+// CategoryIDCleared returns if the "category_id" field was cleared in this mutation.
+func (m *BlogMutation) CategoryIDCleared() bool {
+	_, ok := m.clearedFields[blog.FieldCategoryID]
+	return ok
+}
+
+// ResetCategoryID resets all changes to the "category_id" field.
+func (m *BlogMutation) ResetCategoryID() {
+	m.primary_category = nil
+	delete(m.clearedFields, blog.FieldCategoryID)
+}
+
+// SetAuthorID sets the "author_id" field.
+func (m *BlogMutation) SetAuthorID(i int) {
+	m.author = &i
+}
+
+// AuthorID returns the value of the "author_id" field in the mutation.
+func (m *BlogMutation) AuthorID() (r int, exists bool) {
+	v := m.author
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthorID returns the old "author_id" field's value of the Blog entity.
+// If the Blog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlogMutation) OldAuthorID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthorID: %w", err)
+	}
+	return oldValue.AuthorID, nil
+}
+
+// ClearAuthorID clears the value of the "author_id" field.
+func (m *BlogMutation) ClearAuthorID() {
+	m.author = nil
+	m.clearedFields[blog.FieldAuthorID] = struct{}{}
+}
+
+// AuthorIDCleared returns if the "author_id" field was cleared in this mutation.
+func (m *BlogMutation) AuthorIDCleared() bool {
+	_, ok := m.clearedFields[blog.FieldAuthorID]
+	return ok
+}
+
+// ResetAuthorID resets all changes to the "author_id" field.
+func (m *BlogMutation) ResetAuthorID() {
+	m.author = nil
+	delete(m.clearedFields, blog.FieldAuthorID)
+}
+
+// SetFeaturedImageID sets the "featured_image_id" field.
+func (m *BlogMutation) SetFeaturedImageID(i int) {
+	m.featured_image = &i
+}
+
+// FeaturedImageID returns the value of the "featured_image_id" field in the mutation.
+func (m *BlogMutation) FeaturedImageID() (r int, exists bool) {
+	v := m.featured_image
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFeaturedImageID returns the old "featured_image_id" field's value of the Blog entity.
+// If the Blog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlogMutation) OldFeaturedImageID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFeaturedImageID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFeaturedImageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFeaturedImageID: %w", err)
+	}
+	return oldValue.FeaturedImageID, nil
+}
+
+// ClearFeaturedImageID clears the value of the "featured_image_id" field.
+func (m *BlogMutation) ClearFeaturedImageID() {
+	m.featured_image = nil
+	m.clearedFields[blog.FieldFeaturedImageID] = struct{}{}
+}
+
+// FeaturedImageIDCleared returns if the "featured_image_id" field was cleared in this mutation.
+func (m *BlogMutation) FeaturedImageIDCleared() bool {
+	_, ok := m.clearedFields[blog.FieldFeaturedImageID]
+	return ok
+}
+
+// ResetFeaturedImageID resets all changes to the "featured_image_id" field.
+func (m *BlogMutation) ResetFeaturedImageID() {
+	m.featured_image = nil
+	delete(m.clearedFields, blog.FieldFeaturedImageID)
+}
+
+// SetWordCount sets the "word_count" field.
+func (m *BlogMutation) SetWordCount(i int) {
+	m.word_count = &i
+	m.addword_count = nil
+}
+
+// WordCount returns the value of the "word_count" field in the mutation.
+func (m *BlogMutation) WordCount() (r int, exists bool) {
+	v := m.word_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWordCount returns the old "word_count" field's value of the Blog entity.
+// If the Blog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlogMutation) OldWordCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWordCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWordCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWordCount: %w", err)
+	}
+	return oldValue.WordCount, nil
+}
+
+// AddWordCount adds i to the "word_count" field.
+func (m *BlogMutation) AddWordCount(i int) {
+	if m.addword_count != nil {
+		*m.addword_count += i
+	} else {
+		m.addword_count = &i
+	}
+}
+
+// AddedWordCount returns the value that was added to the "word_count" field in this mutation.
+func (m *BlogMutation) AddedWordCount() (r int, exists bool) {
+	v := m.addword_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWordCount resets all changes to the "word_count" field.
+func (m *BlogMutation) ResetWordCount() {
+	m.word_count = nil
+	m.addword_count = nil
+}
+
+// SetCharCount sets the "char_count" field.
+func (m *BlogMutation) SetCharCount(i int) {
+	m.char_count = &i
+	m.addchar_count = nil
+}
+
+// CharCount returns the value of the "char_count" field in the mutation.
+func (m *BlogMutation) CharCount() (r int, exists bool) {
+	v := m.char_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCharCount returns the old "char_count" field's value of the Blog entity.
+// If the Blog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlogMutation) OldCharCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCharCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCharCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCharCount: %w", err)
+	}
+	return oldValue.CharCount, nil
+}
+
+// AddCharCount adds i to the "char_count" field.
+func (m *BlogMutation) AddCharCount(i int) {
+	if m.addchar_count != nil {
+		*m.addchar_count += i
+	} else {
+		m.addchar_count = &i
+	}
+}
+
+// AddedCharCount returns the value that was added to the "char_count" field in this mutation.
+func (m *BlogMutation) AddedCharCount() (r int, exists bool) {
+	v := m.addchar_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCharCount resets all changes to the "char_count" field.
+func (m *BlogMutation) ResetCharCount() {
+	m.char_count = nil
+	m.addchar_count = nil
+}
+
+// SetReadingMinutes sets the "reading_minutes" field.
+func (m *BlogMutation) SetReadingMinutes(i int) {
+	m.reading_minutes = &i
+	m.addreading_minutes = nil
+}
+
+// ReadingMinutes returns the value of the "reading_minutes" field in the mutation.
+func (m *BlogMutation) ReadingMinutes() (r int, exists bool) {
+	v := m.reading_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReadingMinutes returns the old "reading_minutes" field's value of the Blog entity.
+// If the Blog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlogMutation) OldReadingMinutes(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReadingMinutes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReadingMinutes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReadingMinutes: %w", err)
+	}
+	return oldValue.ReadingMinutes, nil
+}
+
+// AddReadingMinutes adds i to the "reading_minutes" field.
+func (m *BlogMutation) AddReadingMinutes(i int) {
+	if m.addreading_minutes != nil {
+		*m.addreading_minutes += i
+	} else {
+		m.addreading_minutes = &i
+	}
+}
+
+// AddedReadingMinutes returns the value that was added to the "reading_minutes" field in this mutation.
+func (m *BlogMutation) AddedReadingMinutes() (r int, exists bool) {
+	v := m.addreading_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReadingMinutes resets all changes to the "reading_minutes" field.
+func (m *BlogMutation) ResetReadingMinutes() {
+	m.reading_minutes = nil
+	m.addreading_minutes = nil
+}
+
+// SetExcerpt sets the "excerpt" field.
+func (m *BlogMutation) SetExcerpt(s string) {
+	m.excerpt = &s
+}
+
+// Excerpt returns the value of the "excerpt" field in the mutation.
+func (m *BlogMutation) Excerpt() (r string, exists bool) {
+	v := m.excerpt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExcerpt returns the old "excerpt" field's value of the Blog entity.
+// If the Blog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlogMutation) OldExcerpt(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExcerpt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExcerpt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExcerpt: %w", err)
+	}
+	return oldValue.Excerpt, nil
+}
+
+// ClearExcerpt clears the value of the "excerpt" field.
+func (m *BlogMutation) ClearExcerpt() {
+	m.excerpt = nil
+	m.clearedFields[blog.FieldExcerpt] = struct{}{}
+}
+
+// ExcerptCleared returns if the "excerpt" field was cleared in this mutation.
+func (m *BlogMutation) ExcerptCleared() bool {
+	_, ok := m.clearedFields[blog.FieldExcerpt]
+	return ok
+}
+
+// ResetExcerpt resets all changes to the "excerpt" field.
+func (m *BlogMutation) ResetExcerpt() {
+	m.excerpt = nil
+	delete(m.clearedFields, blog.FieldExcerpt)
+}
+
+// SetMetaDescription sets the "meta_description" field.
+func (m *BlogMutation) SetMetaDescription(s string) {
+	m.meta_description = &s
+}
+
+// MetaDescription returns the value of the "meta_description" field in the mutation.
+func (m *BlogMutation) MetaDescription() (r string, exists bool) {
+	v := m.meta_description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetaDescription returns the old "meta_description" field's value of the Blog entity.
+// If the Blog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlogMutation) OldMetaDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetaDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetaDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetaDescription: %w", err)
+	}
+	return oldValue.MetaDescription, nil
+}
+
+// ClearMetaDescription clears the value of the "meta_description" field.
+func (m *BlogMutation) ClearMetaDescription() {
+	m.meta_description = nil
+	m.clearedFields[blog.FieldMetaDescription] = struct{}{}
+}
+
+// MetaDescriptionCleared returns if the "meta_description" field was cleared in this mutation.
+func (m *BlogMutation) MetaDescriptionCleared() bool {
+	_, ok := m.clearedFields[blog.FieldMetaDescription]
+	return ok
+}
+
+// ResetMetaDescription resets all changes to the "meta_description" field.
+func (m *BlogMutation) ResetMetaDescription() {
+	m.meta_description = nil
+	delete(m.clearedFields, blog.FieldMetaDescription)
+}
+
+// SetExcerptCustom sets the "excerpt_custom" field.
+func (m *BlogMutation) SetExcerptCustom(b bool) {
+	m.excerpt_custom = &b
+}
+
+// ExcerptCustom returns the value of the "excerpt_custom" field in the mutation.
+func (m *BlogMutation) ExcerptCustom() (r bool, exists bool) {
+	v := m.excerpt_custom
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExcerptCustom returns the old "excerpt_custom" field's value of the Blog entity.
+// If the Blog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlogMutation) OldExcerptCustom(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExcerptCustom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExcerptCustom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExcerptCustom: %w", err)
+	}
+	return oldValue.ExcerptCustom, nil
+}
+
+// ResetExcerptCustom resets all changes to the "excerpt_custom" field.
+func (m *BlogMutation) ResetExcerptCustom() {
+	m.excerpt_custom = nil
+}
+
+// SetMetaDescriptionCustom sets the "meta_description_custom" field.
+func (m *BlogMutation) SetMetaDescriptionCustom(b bool) {
+	m.meta_description_custom = &b
+}
+
+// MetaDescriptionCustom returns the value of the "meta_description_custom" field in the mutation.
+func (m *BlogMutation) MetaDescriptionCustom() (r bool, exists bool) {
+	v := m.meta_description_custom
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetaDescriptionCustom returns the old "meta_description_custom" field's value of the Blog entity.
+// If the Blog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlogMutation) OldMetaDescriptionCustom(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetaDescriptionCustom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetaDescriptionCustom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetaDescriptionCustom: %w", err)
+	}
+	return oldValue.MetaDescriptionCustom, nil
+}
+
+// ResetMetaDescriptionCustom resets all changes to the "meta_description_custom" field.
+func (m *BlogMutation) ResetMetaDescriptionCustom() {
+	m.meta_description_custom = nil
+}
+
+// SetKeywords sets the "keywords" field.
+func (m *BlogMutation) SetKeywords(s []string) {
+	m.keywords = &s
+	m.appendkeywords = nil
+}
+
+// Keywords returns the value of the "keywords" field in the mutation.
+func (m *BlogMutation) Keywords() (r []string, exists bool) {
+	v := m.keywords
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeywords returns the old "keywords" field's value of the Blog entity.
+// If the Blog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlogMutation) OldKeywords(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeywords is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeywords requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeywords: %w", err)
+	}
+	return oldValue.Keywords, nil
+}
+
+// AppendKeywords adds s to the "keywords" field.
+func (m *BlogMutation) AppendKeywords(s []string) {
+	m.appendkeywords = append(m.appendkeywords, s...)
+}
+
+// AppendedKeywords returns the list of values that were appended to the "keywords" field in this mutation.
+func (m *BlogMutation) AppendedKeywords() ([]string, bool) {
+	if len(m.appendkeywords) == 0 {
+		return nil, false
+	}
+	return m.appendkeywords, true
+}
+
+// ClearKeywords clears the value of the "keywords" field.
+func (m *BlogMutation) ClearKeywords() {
+	m.keywords = nil
+	m.appendkeywords = nil
+	m.clearedFields[blog.FieldKeywords] = struct{}{}
+}
+
+// KeywordsCleared returns if the "keywords" field was cleared in this mutation.
+func (m *BlogMutation) KeywordsCleared() bool {
+	_, ok := m.clearedFields[blog.FieldKeywords]
+	return ok
+}
+
+// ResetKeywords resets all changes to the "keywords" field.
+func (m *BlogMutation) ResetKeywords() {
+	m.keywords = nil
+	m.appendkeywords = nil
+	delete(m.clearedFields, blog.FieldKeywords)
+}
+
+// SetDisableCta sets the "disable_cta" field.
+func (m *BlogMutation) SetDisableCta(b bool) {
+	m.disable_cta = &b
+}
+
+// DisableCta returns the value of the "disable_cta" field in the mutation.
+func (m *BlogMutation) DisableCta() (r bool, exists bool) {
+	v := m.disable_cta
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisableCta returns the old "disable_cta" field's value of the Blog entity.
+// If the Blog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlogMutation) OldDisableCta(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisableCta is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisableCta requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisableCta: %w", err)
+	}
+	return oldValue.DisableCta, nil
+}
+
+// ResetDisableCta resets all changes to the "disable_cta" field.
+func (m *BlogMutation) ResetDisableCta() {
+	m.disable_cta = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BlogMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BlogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Blog entity.
+// If the Blog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BlogMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *BlogMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *BlogMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Blog entity.
+// If the Blog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlogMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *BlogMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetPrimaryCategoryID sets the "primary_category" edge to the Category entity by id.
+func (m *BlogMutation) SetPrimaryCategoryID(id int) {
+	m.primary_category = &id
+}
+
+// ClearPrimaryCategory clears the "primary_category" edge to the Category entity.
+func (m *BlogMutation) ClearPrimaryCategory() {
+	m.clearedprimary_category = true
+	m.clearedFields[blog.FieldCategoryID] = struct{}{}
+}
+
+// PrimaryCategoryCleared reports if the "primary_category" edge to the Category entity was cleared.
+func (m *BlogMutation) PrimaryCategoryCleared() bool {
+	return m.CategoryIDCleared() || m.clearedprimary_category
+}
+
+// PrimaryCategoryID returns the "primary_category" edge ID in the mutation.
+func (m *BlogMutation) PrimaryCategoryID() (id int, exists bool) {
+	if m.primary_category != nil {
+		return *m.primary_category, true
+	}
+	return
+}
+
+// PrimaryCategoryIDs returns the "primary_category" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PrimaryCategoryID instead. It exists only for internal usage by the builders.
+func (m *BlogMutation) PrimaryCategoryIDs() (ids []int) {
+	if id := m.primary_category; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPrimaryCategory resets all changes to the "primary_category" edge.
+func (m *BlogMutation) ResetPrimaryCategory() {
+	m.primary_category = nil
+	m.clearedprimary_category = false
+}
+
+// AddTagIDs adds the "tags" edge to the Tag entity by ids.
+func (m *BlogMutation) AddTagIDs(ids ...int) {
+	if m.tags == nil {
+		m.tags = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.tags[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTags clears the "tags" edge to the Tag entity.
+func (m *BlogMutation) ClearTags() {
+	m.clearedtags = true
+}
+
+// TagsCleared reports if the "tags" edge to the Tag entity was cleared.
+func (m *BlogMutation) TagsCleared() bool {
+	return m.clearedtags
+}
+
+// RemoveTagIDs removes the "tags" edge to the Tag entity by IDs.
+func (m *BlogMutation) RemoveTagIDs(ids ...int) {
+	if m.removedtags == nil {
+		m.removedtags = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.tags, ids[i])
+		m.removedtags[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTags returns the removed IDs of the "tags" edge to the Tag entity.
+func (m *BlogMutation) RemovedTagsIDs() (ids []int) {
+	for id := range m.removedtags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TagsIDs returns the "tags" edge IDs in the mutation.
+func (m *BlogMutation) TagsIDs() (ids []int) {
+	for id := range m.tags {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTags resets all changes to the "tags" edge.
+func (m *BlogMutation) ResetTags() {
+	m.tags = nil
+	m.clearedtags = false
+	m.removedtags = nil
+}
+
+// ClearAuthor clears the "author" edge to the User entity.
+func (m *BlogMutation) ClearAuthor() {
+	m.clearedauthor = true
+	m.clearedFields[blog.FieldAuthorID] = struct{}{}
+}
+
+// AuthorCleared reports if the "author" edge to the User entity was cleared.
+func (m *BlogMutation) AuthorCleared() bool {
+	return m.AuthorIDCleared() || m.clearedauthor
+}
+
+// AuthorIDs returns the "author" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AuthorID instead. It exists only for internal usage by the builders.
+func (m *BlogMutation) AuthorIDs() (ids []int) {
+	if id := m.author; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAuthor resets all changes to the "author" edge.
+func (m *BlogMutation) ResetAuthor() {
+	m.author = nil
+	m.clearedauthor = false
+}
+
+// ClearFeaturedImage clears the "featured_image" edge to the Media entity.
+func (m *BlogMutation) ClearFeaturedImage() {
+	m.clearedfeatured_image = true
+	m.clearedFields[blog.FieldFeaturedImageID] = struct{}{}
+}
+
+// FeaturedImageCleared reports if the "featured_image" edge to the Media entity was cleared.
+func (m *BlogMutation) FeaturedImageCleared() bool {
+	return m.FeaturedImageIDCleared() || m.clearedfeatured_image
+}
+
+// FeaturedImageIDs returns the "featured_image" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// FeaturedImageID instead. It exists only for internal usage by the builders.
+func (m *BlogMutation) FeaturedImageIDs() (ids []int) {
+	if id := m.featured_image; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetFeaturedImage resets all changes to the "featured_image" edge.
+func (m *BlogMutation) ResetFeaturedImage() {
+	m.featured_image = nil
+	m.clearedfeatured_image = false
+}
+
+// AddChunkIDs adds the "chunks" edge to the BlogChunk entity by ids.
+func (m *BlogMutation) AddChunkIDs(ids ...int) {
+	if m.chunks == nil {
+		m.chunks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.chunks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChunks clears the "chunks" edge to the BlogChunk entity.
+func (m *BlogMutation) ClearChunks() {
+	m.clearedchunks = true
+}
+
+// ChunksCleared reports if the "chunks" edge to the BlogChunk entity was cleared.
+func (m *BlogMutation) ChunksCleared() bool {
+	return m.clearedchunks
+}
+
+// RemoveChunkIDs removes the "chunks" edge to the BlogChunk entity by IDs.
+func (m *BlogMutation) RemoveChunkIDs(ids ...int) {
+	if m.removedchunks == nil {
+		m.removedchunks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.chunks, ids[i])
+		m.removedchunks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChunks returns the removed IDs of the "chunks" edge to the BlogChunk entity.
+func (m *BlogMutation) RemovedChunksIDs() (ids []int) {
+	for id := range m.removedchunks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChunksIDs returns the "chunks" edge IDs in the mutation.
+func (m *BlogMutation) ChunksIDs() (ids []int) {
+	for id := range m.chunks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChunks resets all changes to the "chunks" edge.
+func (m *BlogMutation) ResetChunks() {
+	m.chunks = nil
+	m.clearedchunks = false
+	m.removedchunks = nil
+}
+
+// Where appends a list predicates to the BlogMutation builder.
+func (m *BlogMutation) Where(ps ...predicate.Blog) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BlogMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BlogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Blog, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BlogMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BlogMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Blog).
+func (m *BlogMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BlogMutation) Fields() []string {
+	fields := make([]string, 0, 22)
+	if m.category != nil {
+		fields = append(fields, blog.FieldCategory)
+	}
+	if m.text != nil {
+		fields = append(fields, blog.FieldText)
+	}
+	if m.format != nil {
+		fields = append(fields, blog.FieldFormat)
+	}
+	if m.source != nil {
+		fields = append(fields, blog.FieldSource)
+	}
+	if m._path != nil {
+		fields = append(fields, blog.FieldPath)
+	}
+	if m.embedding != nil {
+		fields = append(fields, blog.FieldEmbedding)
+	}
+	if m.embedding_hash != nil {
+		fields = append(fields, blog.FieldEmbeddingHash)
+	}
+	if m.schema_json != nil {
+		fields = append(fields, blog.FieldSchemaJSON)
+	}
+	if m.primary_category != nil {
+		fields = append(fields, blog.FieldCategoryID)
+	}
+	if m.author != nil {
+		fields = append(fields, blog.FieldAuthorID)
+	}
+	if m.featured_image != nil {
+		fields = append(fields, blog.FieldFeaturedImageID)
+	}
+	if m.word_count != nil {
+		fields = append(fields, blog.FieldWordCount)
+	}
+	if m.char_count != nil {
+		fields = append(fields, blog.FieldCharCount)
+	}
+	if m.reading_minutes != nil {
+		fields = append(fields, blog.FieldReadingMinutes)
+	}
+	if m.excerpt != nil {
+		fields = append(fields, blog.FieldExcerpt)
+	}
+	if m.meta_description != nil {
+		fields = append(fields, blog.FieldMetaDescription)
+	}
+	if m.excerpt_custom != nil {
+		fields = append(fields, blog.FieldExcerptCustom)
+	}
+	if m.meta_description_custom != nil {
+		fields = append(fields, blog.FieldMetaDescriptionCustom)
+	}
+	if m.keywords != nil {
+		fields = append(fields, blog.FieldKeywords)
+	}
+	if m.disable_cta != nil {
+		fields = append(fields, blog.FieldDisableCta)
+	}
+	if m.created_at != nil {
+		fields = append(fields, blog.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, blog.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BlogMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case blog.FieldCategory:
+		return m.Category()
+	case blog.FieldText:
+		return m.Text()
+	case blog.FieldFormat:
+		return m.Format()
+	case blog.FieldSource:
+		return m.Source()
+	case blog.FieldPath:
+		return m.Path()
+	case blog.FieldEmbedding:
+		return m.Embedding()
+	case blog.FieldEmbeddingHash:
+		return m.EmbeddingHash()
+	case blog.FieldSchemaJSON:
+		return m.SchemaJSON()
+	case blog.FieldCategoryID:
+		return m.CategoryID()
+	case blog.FieldAuthorID:
+		return m.AuthorID()
+	case blog.FieldFeaturedImageID:
+		return m.FeaturedImageID()
+	case blog.FieldWordCount:
+		return m.WordCount()
+	case blog.FieldCharCount:
+		return m.CharCount()
+	case blog.FieldReadingMinutes:
+		return m.ReadingMinutes()
+	case blog.FieldExcerpt:
+		return m.Excerpt()
+	case blog.FieldMetaDescription:
+		return m.MetaDescription()
+	case blog.FieldExcerptCustom:
+		return m.ExcerptCustom()
+	case blog.FieldMetaDescriptionCustom:
+		return m.MetaDescriptionCustom()
+	case blog.FieldKeywords:
+		return m.Keywords()
+	case blog.FieldDisableCta:
+		return m.DisableCta()
+	case blog.FieldCreatedAt:
+		return m.CreatedAt()
+	case blog.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BlogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case blog.FieldCategory:
+		return m.OldCategory(ctx)
+	case blog.FieldText:
+		return m.OldText(ctx)
+	case blog.FieldFormat:
+		return m.OldFormat(ctx)
+	case blog.FieldSource:
+		return m.OldSource(ctx)
+	case blog.FieldPath:
+		return m.OldPath(ctx)
+	case blog.FieldEmbedding:
+		return m.OldEmbedding(ctx)
+	case blog.FieldEmbeddingHash:
+		return m.OldEmbeddingHash(ctx)
+	case blog.FieldSchemaJSON:
+		return m.OldSchemaJSON(ctx)
+	case blog.FieldCategoryID:
+		return m.OldCategoryID(ctx)
+	case blog.FieldAuthorID:
+		return m.OldAuthorID(ctx)
+	case blog.FieldFeaturedImageID:
+		return m.OldFeaturedImageID(ctx)
+	case blog.FieldWordCount:
+		return m.OldWordCount(ctx)
+	case blog.FieldCharCount:
+		return m.OldCharCount(ctx)
+	case blog.FieldReadingMinutes:
+		return m.OldReadingMinutes(ctx)
+	case blog.FieldExcerpt:
+		return m.OldExcerpt(ctx)
+	case blog.FieldMetaDescription:
+		return m.OldMetaDescription(ctx)
+	case blog.FieldExcerptCustom:
+		return m.OldExcerptCustom(ctx)
+	case blog.FieldMetaDescriptionCustom:
+		return m.OldMetaDescriptionCustom(ctx)
+	case blog.FieldKeywords:
+		return m.OldKeywords(ctx)
+	case blog.FieldDisableCta:
+		return m.OldDisableCta(ctx)
+	case blog.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case blog.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Blog field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BlogMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case blog.FieldCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case blog.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case blog.FieldFormat:
+		v, ok := value.(blog.Format)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case blog.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case blog.FieldPath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPath(v)
+		return nil
+	case blog.FieldEmbedding:
+		v, ok := value.([]float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbedding(v)
+		return nil
+	case blog.FieldEmbeddingHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbeddingHash(v)
+		return nil
+	case blog.FieldSchemaJSON:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSchemaJSON(v)
+		return nil
+	case blog.FieldCategoryID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategoryID(v)
+		return nil
+	case blog.FieldAuthorID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthorID(v)
+		return nil
+	case blog.FieldFeaturedImageID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFeaturedImageID(v)
+		return nil
+	case blog.FieldWordCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWordCount(v)
+		return nil
+	case blog.FieldCharCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCharCount(v)
+		return nil
+	case blog.FieldReadingMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadingMinutes(v)
+		return nil
+	case blog.FieldExcerpt:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExcerpt(v)
+		return nil
+	case blog.FieldMetaDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetaDescription(v)
+		return nil
+	case blog.FieldExcerptCustom:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExcerptCustom(v)
+		return nil
+	case blog.FieldMetaDescriptionCustom:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetaDescriptionCustom(v)
+		return nil
+	case blog.FieldKeywords:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeywords(v)
+		return nil
+	case blog.FieldDisableCta:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisableCta(v)
+		return nil
+	case blog.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case blog.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Blog field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BlogMutation) AddedFields() []string {
+	var fields []string
+	if m.addword_count != nil {
+		fields = append(fields, blog.FieldWordCount)
+	}
+	if m.addchar_count != nil {
+		fields = append(fields, blog.FieldCharCount)
+	}
+	if m.addreading_minutes != nil {
+		fields = append(fields, blog.FieldReadingMinutes)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BlogMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case blog.FieldWordCount:
+		return m.AddedWordCount()
+	case blog.FieldCharCount:
+		return m.AddedCharCount()
+	case blog.FieldReadingMinutes:
+		return m.AddedReadingMinutes()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BlogMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case blog.FieldWordCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWordCount(v)
+		return nil
+	case blog.FieldCharCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCharCount(v)
+		return nil
+	case blog.FieldReadingMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReadingMinutes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Blog numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BlogMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(blog.FieldSource) {
+		fields = append(fields, blog.FieldSource)
+	}
+	if m.FieldCleared(blog.FieldEmbedding) {
+		fields = append(fields, blog.FieldEmbedding)
+	}
+	if m.FieldCleared(blog.FieldEmbeddingHash) {
+		fields = append(fields, blog.FieldEmbeddingHash)
+	}
+	if m.FieldCleared(blog.FieldSchemaJSON) {
+		fields = append(fields, blog.FieldSchemaJSON)
+	}
+	if m.FieldCleared(blog.FieldCategoryID) {
+		fields = append(fields, blog.FieldCategoryID)
+	}
+	if m.FieldCleared(blog.FieldAuthorID) {
+		fields = append(fields, blog.FieldAuthorID)
+	}
+	if m.FieldCleared(blog.FieldFeaturedImageID) {
+		fields = append(fields, blog.FieldFeaturedImageID)
+	}
+	if m.FieldCleared(blog.FieldExcerpt) {
+		fields = append(fields, blog.FieldExcerpt)
+	}
+	if m.FieldCleared(blog.FieldMetaDescription) {
+		fields = append(fields, blog.FieldMetaDescription)
+	}
+	if m.FieldCleared(blog.FieldKeywords) {
+		fields = append(fields, blog.FieldKeywords)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BlogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BlogMutation) ClearField(name string) error {
+	switch name {
+	case blog.FieldSource:
+		m.ClearSource()
+		return nil
+	case blog.FieldEmbedding:
+		m.ClearEmbedding()
+		return nil
+	case blog.FieldEmbeddingHash:
+		m.ClearEmbeddingHash()
+		return nil
+	case blog.FieldSchemaJSON:
+		m.ClearSchemaJSON()
+		return nil
+	case blog.FieldCategoryID:
+		m.ClearCategoryID()
+		return nil
+	case blog.FieldAuthorID:
+		m.ClearAuthorID()
+		return nil
+	case blog.FieldFeaturedImageID:
+		m.ClearFeaturedImageID()
+		return nil
+	case blog.FieldExcerpt:
+		m.ClearExcerpt()
+		return nil
+	case blog.FieldMetaDescription:
+		m.ClearMetaDescription()
+		return nil
+	case blog.FieldKeywords:
+		m.ClearKeywords()
+		return nil
+	}
+	return fmt.Errorf("unknown Blog nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BlogMutation) ResetField(name string) error {
+	switch name {
+	case blog.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case blog.FieldText:
+		m.ResetText()
+		return nil
+	case blog.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case blog.FieldSource:
+		m.ResetSource()
+		return nil
+	case blog.FieldPath:
+		m.ResetPath()
+		return nil
+	case blog.FieldEmbedding:
+		m.ResetEmbedding()
+		return nil
+	case blog.FieldEmbeddingHash:
+		m.ResetEmbeddingHash()
+		return nil
+	case blog.FieldSchemaJSON:
+		m.ResetSchemaJSON()
+		return nil
+	case blog.FieldCategoryID:
+		m.ResetCategoryID()
+		return nil
+	case blog.FieldAuthorID:
+		m.ResetAuthorID()
+		return nil
+	case blog.FieldFeaturedImageID:
+		m.ResetFeaturedImageID()
+		return nil
+	case blog.FieldWordCount:
+		m.ResetWordCount()
+		return nil
+	case blog.FieldCharCount:
+		m.ResetCharCount()
+		return nil
+	case blog.FieldReadingMinutes:
+		m.ResetReadingMinutes()
+		return nil
+	case blog.FieldExcerpt:
+		m.ResetExcerpt()
+		return nil
+	case blog.FieldMetaDescription:
+		m.ResetMetaDescription()
+		return nil
+	case blog.FieldExcerptCustom:
+		m.ResetExcerptCustom()
+		return nil
+	case blog.FieldMetaDescriptionCustom:
+		m.ResetMetaDescriptionCustom()
+		return nil
+	case blog.FieldKeywords:
+		m.ResetKeywords()
+		return nil
+	case blog.FieldDisableCta:
+		m.ResetDisableCta()
+		return nil
+	case blog.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case blog.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Blog field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BlogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.primary_category != nil {
+		edges = append(edges, blog.EdgePrimaryCategory)
+	}
+	if m.tags != nil {
+		edges = append(edges, blog.EdgeTags)
+	}
+	if m.author != nil {
+		edges = append(edges, blog.EdgeAuthor)
+	}
+	if m.featured_image != nil {
+		edges = append(edges, blog.EdgeFeaturedImage)
+	}
+	if m.chunks != nil {
+		edges = append(edges, blog.EdgeChunks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BlogMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case blog.EdgePrimaryCategory:
+		if id := m.primary_category; id != nil {
+			return []ent.Value{*id}
+		}
+	case blog.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.tags))
+		for id := range m.tags {
+			ids = append(ids, id)
+		}
+		return ids
+	case blog.EdgeAuthor:
+		if id := m.author; id != nil {
+			return []ent.Value{*id}
+		}
+	case blog.EdgeFeaturedImage:
+		if id := m.featured_image; id != nil {
+			return []ent.Value{*id}
+		}
+	case blog.EdgeChunks:
+		ids := make([]ent.Value, 0, len(m.chunks))
+		for id := range m.chunks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BlogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.removedtags != nil {
+		edges = append(edges, blog.EdgeTags)
+	}
+	if m.removedchunks != nil {
+		edges = append(edges, blog.EdgeChunks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BlogMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case blog.EdgeTags:
+		ids := make([]ent.Value, 0, len(m.removedtags))
+		for id := range m.removedtags {
+			ids = append(ids, id)
+		}
+		return ids
+	case blog.EdgeChunks:
+		ids := make([]ent.Value, 0, len(m.removedchunks))
+		for id := range m.removedchunks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BlogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 5)
+	if m.clearedprimary_category {
+		edges = append(edges, blog.EdgePrimaryCategory)
+	}
+	if m.clearedtags {
+		edges = append(edges, blog.EdgeTags)
+	}
+	if m.clearedauthor {
+		edges = append(edges, blog.EdgeAuthor)
+	}
+	if m.clearedfeatured_image {
+		edges = append(edges, blog.EdgeFeaturedImage)
+	}
+	if m.clearedchunks {
+		edges = append(edges, blog.EdgeChunks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BlogMutation) EdgeCleared(name string) bool {
+	switch name {
+	case blog.EdgePrimaryCategory:
+		return m.clearedprimary_category
+	case blog.EdgeTags:
+		return m.clearedtags
+	case blog.EdgeAuthor:
+		return m.clearedauthor
+	case blog.EdgeFeaturedImage:
+		return m.clearedfeatured_image
+	case blog.EdgeChunks:
+		return m.clearedchunks
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BlogMutation) ClearEdge(name string) error {
+	switch name {
+	case blog.EdgePrimaryCategory:
+		m.ClearPrimaryCategory()
+		return nil
+	case blog.EdgeAuthor:
+		m.ClearAuthor()
+		return nil
+	case blog.EdgeFeaturedImage:
+		m.ClearFeaturedImage()
+		return nil
+	}
+	return fmt.Errorf("unknown Blog unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BlogMutation) ResetEdge(name string) error {
+	switch name {
+	case blog.EdgePrimaryCategory:
+		m.ResetPrimaryCategory()
+		return nil
+	case blog.EdgeTags:
+		m.ResetTags()
+		return nil
+	case blog.EdgeAuthor:
+		m.ResetAuthor()
+		return nil
+	case blog.EdgeFeaturedImage:
+		m.ResetFeaturedImage()
+		return nil
+	case blog.EdgeChunks:
+		m.ResetChunks()
+		return nil
+	}
+	return fmt.Errorf("unknown Blog edge %s", name)
+}
+
+// BlogChunkMutation represents an operation that mutates the BlogChunk nodes in the graph.
+type BlogChunkMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	position        *int
+	addposition     *int
+	heading         *string
+	text            *string
+	embedding       *[]float32
+	appendembedding []float32
+	embedding_hash  *string
+	clearedFields   map[string]struct{}
+	blog            *int
+	clearedblog     bool
+	done            bool
+	oldValue        func(context.Context) (*BlogChunk, error)
+	predicates      []predicate.BlogChunk
+}
+
+var _ ent.Mutation = (*BlogChunkMutation)(nil)
+
+// blogchunkOption allows management of the mutation configuration using functional options.
+type blogchunkOption func(*BlogChunkMutation)
+
+// newBlogChunkMutation creates new mutation for the BlogChunk entity.
+func newBlogChunkMutation(c config, op Op, opts ...blogchunkOption) *BlogChunkMutation {
+	m := &BlogChunkMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBlogChunk,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBlogChunkID sets the ID field of the mutation.
+func withBlogChunkID(id int) blogchunkOption {
+	return func(m *BlogChunkMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BlogChunk
+		)
+		m.oldValue = func(ctx context.Context) (*BlogChunk, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BlogChunk.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBlogChunk sets the old BlogChunk of the mutation.
+func withBlogChunk(node *BlogChunk) blogchunkOption {
+	return func(m *BlogChunkMutation) {
+		m.oldValue = func(context.Context) (*BlogChunk, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BlogChunkMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BlogChunkMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BlogChunkMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BlogChunkMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BlogChunk.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBlogID sets the "blog_id" field.
+func (m *BlogChunkMutation) SetBlogID(i int) {
+	m.blog = &i
+}
+
+// BlogID returns the value of the "blog_id" field in the mutation.
+func (m *BlogChunkMutation) BlogID() (r int, exists bool) {
+	v := m.blog
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBlogID returns the old "blog_id" field's value of the BlogChunk entity.
+// If the BlogChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlogChunkMutation) OldBlogID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBlogID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBlogID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBlogID: %w", err)
+	}
+	return oldValue.BlogID, nil
+}
+
+// ResetBlogID resets all changes to the "blog_id" field.
+func (m *BlogChunkMutation) ResetBlogID() {
+	m.blog = nil
+}
+
+// SetPosition sets the "position" field.
+func (m *BlogChunkMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *BlogChunkMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the BlogChunk entity.
+// If the BlogChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlogChunkMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *BlogChunkMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *BlogChunkMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *BlogChunkMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// SetHeading sets the "heading" field.
+func (m *BlogChunkMutation) SetHeading(s string) {
+	m.heading = &s
+}
+
+// Heading returns the value of the "heading" field in the mutation.
+func (m *BlogChunkMutation) Heading() (r string, exists bool) {
+	v := m.heading
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeading returns the old "heading" field's value of the BlogChunk entity.
+// If the BlogChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlogChunkMutation) OldHeading(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeading is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeading requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeading: %w", err)
+	}
+	return oldValue.Heading, nil
+}
+
+// ClearHeading clears the value of the "heading" field.
+func (m *BlogChunkMutation) ClearHeading() {
+	m.heading = nil
+	m.clearedFields[blogchunk.FieldHeading] = struct{}{}
+}
+
+// HeadingCleared returns if the "heading" field was cleared in this mutation.
+func (m *BlogChunkMutation) HeadingCleared() bool {
+	_, ok := m.clearedFields[blogchunk.FieldHeading]
+	return ok
+}
+
+// ResetHeading resets all changes to the "heading" field.
+func (m *BlogChunkMutation) ResetHeading() {
+	m.heading = nil
+	delete(m.clearedFields, blogchunk.FieldHeading)
+}
+
+// SetText sets the "text" field.
+func (m *BlogChunkMutation) SetText(s string) {
+	m.text = &s
+}
+
+// Text returns the value of the "text" field in the mutation.
+func (m *BlogChunkMutation) Text() (r string, exists bool) {
+	v := m.text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldText returns the old "text" field's value of the BlogChunk entity.
+// If the BlogChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlogChunkMutation) OldText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
+	}
+	return oldValue.Text, nil
+}
+
+// ResetText resets all changes to the "text" field.
+func (m *BlogChunkMutation) ResetText() {
+	m.text = nil
+}
+
+// SetEmbedding sets the "embedding" field.
+func (m *BlogChunkMutation) SetEmbedding(f []float32) {
+	m.embedding = &f
+	m.appendembedding = nil
+}
+
+// Embedding returns the value of the "embedding" field in the mutation.
+func (m *BlogChunkMutation) Embedding() (r []float32, exists bool) {
+	v := m.embedding
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbedding returns the old "embedding" field's value of the BlogChunk entity.
+// If the BlogChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlogChunkMutation) OldEmbedding(ctx context.Context) (v []float32, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbedding is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbedding requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbedding: %w", err)
+	}
+	return oldValue.Embedding, nil
+}
+
+// AppendEmbedding adds f to the "embedding" field.
+func (m *BlogChunkMutation) AppendEmbedding(f []float32) {
+	m.appendembedding = append(m.appendembedding, f...)
+}
+
+// AppendedEmbedding returns the list of values that were appended to the "embedding" field in this mutation.
+func (m *BlogChunkMutation) AppendedEmbedding() ([]float32, bool) {
+	if len(m.appendembedding) == 0 {
+		return nil, false
+	}
+	return m.appendembedding, true
+}
+
+// ClearEmbedding clears the value of the "embedding" field.
+func (m *BlogChunkMutation) ClearEmbedding() {
+	m.embedding = nil
+	m.appendembedding = nil
+	m.clearedFields[blogchunk.FieldEmbedding] = struct{}{}
+}
+
+// EmbeddingCleared returns if the "embedding" field was cleared in this mutation.
+func (m *BlogChunkMutation) EmbeddingCleared() bool {
+	_, ok := m.clearedFields[blogchunk.FieldEmbedding]
+	return ok
+}
+
+// ResetEmbedding resets all changes to the "embedding" field.
+func (m *BlogChunkMutation) ResetEmbedding() {
+	m.embedding = nil
+	m.appendembedding = nil
+	delete(m.clearedFields, blogchunk.FieldEmbedding)
+}
+
+// SetEmbeddingHash sets the "embedding_hash" field.
+func (m *BlogChunkMutation) SetEmbeddingHash(s string) {
+	m.embedding_hash = &s
+}
+
+// EmbeddingHash returns the value of the "embedding_hash" field in the mutation.
+func (m *BlogChunkMutation) EmbeddingHash() (r string, exists bool) {
+	v := m.embedding_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmbeddingHash returns the old "embedding_hash" field's value of the BlogChunk entity.
+// If the BlogChunk object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BlogChunkMutation) OldEmbeddingHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmbeddingHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmbeddingHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmbeddingHash: %w", err)
+	}
+	return oldValue.EmbeddingHash, nil
+}
+
+// ClearEmbeddingHash clears the value of the "embedding_hash" field.
+func (m *BlogChunkMutation) ClearEmbeddingHash() {
+	m.embedding_hash = nil
+	m.clearedFields[blogchunk.FieldEmbeddingHash] = struct{}{}
+}
+
+// EmbeddingHashCleared returns if the "embedding_hash" field was cleared in this mutation.
+func (m *BlogChunkMutation) EmbeddingHashCleared() bool {
+	_, ok := m.clearedFields[blogchunk.FieldEmbeddingHash]
+	return ok
+}
+
+// ResetEmbeddingHash resets all changes to the "embedding_hash" field.
+func (m *BlogChunkMutation) ResetEmbeddingHash() {
+	m.embedding_hash = nil
+	delete(m.clearedFields, blogchunk.FieldEmbeddingHash)
+}
+
+// ClearBlog clears the "blog" edge to the Blog entity.
+func (m *BlogChunkMutation) ClearBlog() {
+	m.clearedblog = true
+	m.clearedFields[blogchunk.FieldBlogID] = struct{}{}
+}
+
+// BlogCleared reports if the "blog" edge to the Blog entity was cleared.
+func (m *BlogChunkMutation) BlogCleared() bool {
+	return m.clearedblog
+}
+
+// BlogIDs returns the "blog" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BlogID instead. It exists only for internal usage by the builders.
+func (m *BlogChunkMutation) BlogIDs() (ids []int) {
+	if id := m.blog; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBlog resets all changes to the "blog" edge.
+func (m *BlogChunkMutation) ResetBlog() {
+	m.blog = nil
+	m.clearedblog = false
+}
+
+// Where appends a list predicates to the BlogChunkMutation builder.
+func (m *BlogChunkMutation) Where(ps ...predicate.BlogChunk) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BlogChunkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BlogChunkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BlogChunk, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BlogChunkMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BlogChunkMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BlogChunk).
+func (m *BlogChunkMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BlogChunkMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.blog != nil {
+		fields = append(fields, blogchunk.FieldBlogID)
+	}
+	if m.position != nil {
+		fields = append(fields, blogchunk.FieldPosition)
+	}
+	if m.heading != nil {
+		fields = append(fields, blogchunk.FieldHeading)
+	}
+	if m.text != nil {
+		fields = append(fields, blogchunk.FieldText)
+	}
+	if m.embedding != nil {
+		fields = append(fields, blogchunk.FieldEmbedding)
+	}
+	if m.embedding_hash != nil {
+		fields = append(fields, blogchunk.FieldEmbeddingHash)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BlogChunkMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case blogchunk.FieldBlogID:
+		return m.BlogID()
+	case blogchunk.FieldPosition:
+		return m.Position()
+	case blogchunk.FieldHeading:
+		return m.Heading()
+	case blogchunk.FieldText:
+		return m.Text()
+	case blogchunk.FieldEmbedding:
+		return m.Embedding()
+	case blogchunk.FieldEmbeddingHash:
+		return m.EmbeddingHash()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BlogChunkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case blogchunk.FieldBlogID:
+		return m.OldBlogID(ctx)
+	case blogchunk.FieldPosition:
+		return m.OldPosition(ctx)
+	case blogchunk.FieldHeading:
+		return m.OldHeading(ctx)
+	case blogchunk.FieldText:
+		return m.OldText(ctx)
+	case blogchunk.FieldEmbedding:
+		return m.OldEmbedding(ctx)
+	case blogchunk.FieldEmbeddingHash:
+		return m.OldEmbeddingHash(ctx)
+	}
+	return nil, fmt.Errorf("unknown BlogChunk field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BlogChunkMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case blogchunk.FieldBlogID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBlogID(v)
+		return nil
+	case blogchunk.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case blogchunk.FieldHeading:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeading(v)
+		return nil
+	case blogchunk.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case blogchunk.FieldEmbedding:
+		v, ok := value.([]float32)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbedding(v)
+		return nil
+	case blogchunk.FieldEmbeddingHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmbeddingHash(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BlogChunk field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BlogChunkMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, blogchunk.FieldPosition)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BlogChunkMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case blogchunk.FieldPosition:
+		return m.AddedPosition()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BlogChunkMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case blogchunk.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BlogChunk numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BlogChunkMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(blogchunk.FieldHeading) {
+		fields = append(fields, blogchunk.FieldHeading)
+	}
+	if m.FieldCleared(blogchunk.FieldEmbedding) {
+		fields = append(fields, blogchunk.FieldEmbedding)
+	}
+	if m.FieldCleared(blogchunk.FieldEmbeddingHash) {
+		fields = append(fields, blogchunk.FieldEmbeddingHash)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BlogChunkMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BlogChunkMutation) ClearField(name string) error {
+	switch name {
+	case blogchunk.FieldHeading:
+		m.ClearHeading()
+		return nil
+	case blogchunk.FieldEmbedding:
+		m.ClearEmbedding()
+		return nil
+	case blogchunk.FieldEmbeddingHash:
+		m.ClearEmbeddingHash()
+		return nil
+	}
+	return fmt.Errorf("unknown BlogChunk nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BlogChunkMutation) ResetField(name string) error {
+	switch name {
+	case blogchunk.FieldBlogID:
+		m.ResetBlogID()
+		return nil
+	case blogchunk.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case blogchunk.FieldHeading:
+		m.ResetHeading()
+		return nil
+	case blogchunk.FieldText:
+		m.ResetText()
+		return nil
+	case blogchunk.FieldEmbedding:
+		m.ResetEmbedding()
+		return nil
+	case blogchunk.FieldEmbeddingHash:
+		m.ResetEmbeddingHash()
+		return nil
+	}
+	return fmt.Errorf("unknown BlogChunk field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BlogChunkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.blog != nil {
+		edges = append(edges, blogchunk.EdgeBlog)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BlogChunkMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case blogchunk.EdgeBlog:
+		if id := m.blog; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BlogChunkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BlogChunkMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BlogChunkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedblog {
+		edges = append(edges, blogchunk.EdgeBlog)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BlogChunkMutation) EdgeCleared(name string) bool {
+	switch name {
+	case blogchunk.EdgeBlog:
+		return m.clearedblog
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BlogChunkMutation) ClearEdge(name string) error {
+	switch name {
+	case blogchunk.EdgeBlog:
+		m.ClearBlog()
+		return nil
+	}
+	return fmt.Errorf("unknown BlogChunk unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BlogChunkMutation) ResetEdge(name string) error {
+	switch name {
+	case blogchunk.EdgeBlog:
+		m.ResetBlog()
+		return nil
+	}
+	return fmt.Errorf("unknown BlogChunk edge %s", name)
+}
+
+// CTABlockMutation represents an operation that mutates the CTABlock nodes in the graph.
+type CTABlockMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	name            *string
+	heading         *string
+	body            *string
+	button_text     *string
+	button_url      *string
+	placement       *ctablock.Placement
+	paragraph       *int
+	addparagraph    *int
+	active          *bool
+	clearedFields   map[string]struct{}
+	category        *int
+	clearedcategory bool
+	done            bool
+	oldValue        func(context.Context) (*CTABlock, error)
+	predicates      []predicate.CTABlock
+}
+
+var _ ent.Mutation = (*CTABlockMutation)(nil)
+
+// ctablockOption allows management of the mutation configuration using functional options.
+type ctablockOption func(*CTABlockMutation)
+
+// newCTABlockMutation creates new mutation for the CTABlock entity.
+func newCTABlockMutation(c config, op Op, opts ...ctablockOption) *CTABlockMutation {
+	m := &CTABlockMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCTABlock,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCTABlockID sets the ID field of the mutation.
+func withCTABlockID(id int) ctablockOption {
+	return func(m *CTABlockMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CTABlock
+		)
+		m.oldValue = func(ctx context.Context) (*CTABlock, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CTABlock.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCTABlock sets the old CTABlock of the mutation.
+func withCTABlock(node *CTABlock) ctablockOption {
+	return func(m *CTABlockMutation) {
+		m.oldValue = func(context.Context) (*CTABlock, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CTABlockMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CTABlockMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CTABlockMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CTABlockMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CTABlock.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *CTABlockMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *CTABlockMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the CTABlock entity.
+// If the CTABlock object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CTABlockMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *CTABlockMutation) ResetName() {
+	m.name = nil
+}
+
+// SetHeading sets the "heading" field.
+func (m *CTABlockMutation) SetHeading(s string) {
+	m.heading = &s
+}
+
+// Heading returns the value of the "heading" field in the mutation.
+func (m *CTABlockMutation) Heading() (r string, exists bool) {
+	v := m.heading
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeading returns the old "heading" field's value of the CTABlock entity.
+// If the CTABlock object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CTABlockMutation) OldHeading(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeading is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeading requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeading: %w", err)
+	}
+	return oldValue.Heading, nil
+}
+
+// ClearHeading clears the value of the "heading" field.
+func (m *CTABlockMutation) ClearHeading() {
+	m.heading = nil
+	m.clearedFields[ctablock.FieldHeading] = struct{}{}
+}
+
+// HeadingCleared returns if the "heading" field was cleared in this mutation.
+func (m *CTABlockMutation) HeadingCleared() bool {
+	_, ok := m.clearedFields[ctablock.FieldHeading]
+	return ok
+}
+
+// ResetHeading resets all changes to the "heading" field.
+func (m *CTABlockMutation) ResetHeading() {
+	m.heading = nil
+	delete(m.clearedFields, ctablock.FieldHeading)
+}
+
+// SetBody sets the "body" field.
+func (m *CTABlockMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *CTABlockMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the CTABlock entity.
+// If the CTABlock object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CTABlockMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ClearBody clears the value of the "body" field.
+func (m *CTABlockMutation) ClearBody() {
+	m.body = nil
+	m.clearedFields[ctablock.FieldBody] = struct{}{}
+}
+
+// BodyCleared returns if the "body" field was cleared in this mutation.
+func (m *CTABlockMutation) BodyCleared() bool {
+	_, ok := m.clearedFields[ctablock.FieldBody]
+	return ok
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *CTABlockMutation) ResetBody() {
+	m.body = nil
+	delete(m.clearedFields, ctablock.FieldBody)
+}
+
+// SetButtonText sets the "button_text" field.
+func (m *CTABlockMutation) SetButtonText(s string) {
+	m.button_text = &s
+}
+
+// ButtonText returns the value of the "button_text" field in the mutation.
+func (m *CTABlockMutation) ButtonText() (r string, exists bool) {
+	v := m.button_text
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldButtonText returns the old "button_text" field's value of the CTABlock entity.
+// If the CTABlock object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CTABlockMutation) OldButtonText(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldButtonText is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldButtonText requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldButtonText: %w", err)
+	}
+	return oldValue.ButtonText, nil
+}
+
+// ClearButtonText clears the value of the "button_text" field.
+func (m *CTABlockMutation) ClearButtonText() {
+	m.button_text = nil
+	m.clearedFields[ctablock.FieldButtonText] = struct{}{}
+}
+
+// ButtonTextCleared returns if the "button_text" field was cleared in this mutation.
+func (m *CTABlockMutation) ButtonTextCleared() bool {
+	_, ok := m.clearedFields[ctablock.FieldButtonText]
+	return ok
+}
+
+// ResetButtonText resets all changes to the "button_text" field.
+func (m *CTABlockMutation) ResetButtonText() {
+	m.button_text = nil
+	delete(m.clearedFields, ctablock.FieldButtonText)
+}
+
+// SetButtonURL sets the "button_url" field.
+func (m *CTABlockMutation) SetButtonURL(s string) {
+	m.button_url = &s
+}
+
+// ButtonURL returns the value of the "button_url" field in the mutation.
+func (m *CTABlockMutation) ButtonURL() (r string, exists bool) {
+	v := m.button_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldButtonURL returns the old "button_url" field's value of the CTABlock entity.
+// If the CTABlock object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CTABlockMutation) OldButtonURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldButtonURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldButtonURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldButtonURL: %w", err)
+	}
+	return oldValue.ButtonURL, nil
+}
+
+// ClearButtonURL clears the value of the "button_url" field.
+func (m *CTABlockMutation) ClearButtonURL() {
+	m.button_url = nil
+	m.clearedFields[ctablock.FieldButtonURL] = struct{}{}
+}
+
+// ButtonURLCleared returns if the "button_url" field was cleared in this mutation.
+func (m *CTABlockMutation) ButtonURLCleared() bool {
+	_, ok := m.clearedFields[ctablock.FieldButtonURL]
+	return ok
+}
+
+// ResetButtonURL resets all changes to the "button_url" field.
+func (m *CTABlockMutation) ResetButtonURL() {
+	m.button_url = nil
+	delete(m.clearedFields, ctablock.FieldButtonURL)
+}
+
+// SetPlacement sets the "placement" field.
+func (m *CTABlockMutation) SetPlacement(c ctablock.Placement) {
+	m.placement = &c
+}
+
+// Placement returns the value of the "placement" field in the mutation.
+func (m *CTABlockMutation) Placement() (r ctablock.Placement, exists bool) {
+	v := m.placement
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlacement returns the old "placement" field's value of the CTABlock entity.
+// If the CTABlock object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CTABlockMutation) OldPlacement(ctx context.Context) (v ctablock.Placement, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlacement is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlacement requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlacement: %w", err)
+	}
+	return oldValue.Placement, nil
+}
+
+// ResetPlacement resets all changes to the "placement" field.
+func (m *CTABlockMutation) ResetPlacement() {
+	m.placement = nil
+}
+
+// SetParagraph sets the "paragraph" field.
+func (m *CTABlockMutation) SetParagraph(i int) {
+	m.paragraph = &i
+	m.addparagraph = nil
+}
+
+// Paragraph returns the value of the "paragraph" field in the mutation.
+func (m *CTABlockMutation) Paragraph() (r int, exists bool) {
+	v := m.paragraph
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParagraph returns the old "paragraph" field's value of the CTABlock entity.
+// If the CTABlock object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CTABlockMutation) OldParagraph(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParagraph is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParagraph requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParagraph: %w", err)
+	}
+	return oldValue.Paragraph, nil
+}
+
+// AddParagraph adds i to the "paragraph" field.
+func (m *CTABlockMutation) AddParagraph(i int) {
+	if m.addparagraph != nil {
+		*m.addparagraph += i
+	} else {
+		m.addparagraph = &i
+	}
+}
+
+// AddedParagraph returns the value that was added to the "paragraph" field in this mutation.
+func (m *CTABlockMutation) AddedParagraph() (r int, exists bool) {
+	v := m.addparagraph
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetParagraph resets all changes to the "paragraph" field.
+func (m *CTABlockMutation) ResetParagraph() {
+	m.paragraph = nil
+	m.addparagraph = nil
+}
+
+// SetActive sets the "active" field.
+func (m *CTABlockMutation) SetActive(b bool) {
+	m.active = &b
+}
+
+// Active returns the value of the "active" field in the mutation.
+func (m *CTABlockMutation) Active() (r bool, exists bool) {
+	v := m.active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActive returns the old "active" field's value of the CTABlock entity.
+// If the CTABlock object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CTABlockMutation) OldActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+	}
+	return oldValue.Active, nil
+}
+
+// ResetActive resets all changes to the "active" field.
+func (m *CTABlockMutation) ResetActive() {
+	m.active = nil
+}
+
+// SetCategoryID sets the "category_id" field.
+func (m *CTABlockMutation) SetCategoryID(i int) {
+	m.category = &i
+}
+
+// CategoryID returns the value of the "category_id" field in the mutation.
+func (m *CTABlockMutation) CategoryID() (r int, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategoryID returns the old "category_id" field's value of the CTABlock entity.
+// If the CTABlock object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CTABlockMutation) OldCategoryID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategoryID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategoryID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategoryID: %w", err)
+	}
+	return oldValue.CategoryID, nil
+}
+
+// ClearCategoryID clears the value of the "category_id" field.
+func (m *CTABlockMutation) ClearCategoryID() {
+	m.category = nil
+	m.clearedFields[ctablock.FieldCategoryID] = struct{}{}
+}
+
+// CategoryIDCleared returns if the "category_id" field was cleared in this mutation.
+func (m *CTABlockMutation) CategoryIDCleared() bool {
+	_, ok := m.clearedFields[ctablock.FieldCategoryID]
+	return ok
+}
+
+// ResetCategoryID resets all changes to the "category_id" field.
+func (m *CTABlockMutation) ResetCategoryID() {
+	m.category = nil
+	delete(m.clearedFields, ctablock.FieldCategoryID)
+}
+
+// ClearCategory clears the "category" edge to the Category entity.
+func (m *CTABlockMutation) ClearCategory() {
+	m.clearedcategory = true
+	m.clearedFields[ctablock.FieldCategoryID] = struct{}{}
+}
+
+// CategoryCleared reports if the "category" edge to the Category entity was cleared.
+func (m *CTABlockMutation) CategoryCleared() bool {
+	return m.CategoryIDCleared() || m.clearedcategory
+}
+
+// CategoryIDs returns the "category" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CategoryID instead. It exists only for internal usage by the builders.
+func (m *CTABlockMutation) CategoryIDs() (ids []int) {
+	if id := m.category; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCategory resets all changes to the "category" edge.
+func (m *CTABlockMutation) ResetCategory() {
+	m.category = nil
+	m.clearedcategory = false
+}
+
+// Where appends a list predicates to the CTABlockMutation builder.
+func (m *CTABlockMutation) Where(ps ...predicate.CTABlock) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CTABlockMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CTABlockMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CTABlock, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CTABlockMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CTABlockMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CTABlock).
+func (m *CTABlockMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CTABlockMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.name != nil {
+		fields = append(fields, ctablock.FieldName)
+	}
+	if m.heading != nil {
+		fields = append(fields, ctablock.FieldHeading)
+	}
+	if m.body != nil {
+		fields = append(fields, ctablock.FieldBody)
+	}
+	if m.button_text != nil {
+		fields = append(fields, ctablock.FieldButtonText)
+	}
+	if m.button_url != nil {
+		fields = append(fields, ctablock.FieldButtonURL)
+	}
+	if m.placement != nil {
+		fields = append(fields, ctablock.FieldPlacement)
+	}
+	if m.paragraph != nil {
+		fields = append(fields, ctablock.FieldParagraph)
+	}
+	if m.active != nil {
+		fields = append(fields, ctablock.FieldActive)
+	}
+	if m.category != nil {
+		fields = append(fields, ctablock.FieldCategoryID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CTABlockMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ctablock.FieldName:
+		return m.Name()
+	case ctablock.FieldHeading:
+		return m.Heading()
+	case ctablock.FieldBody:
+		return m.Body()
+	case ctablock.FieldButtonText:
+		return m.ButtonText()
+	case ctablock.FieldButtonURL:
+		return m.ButtonURL()
+	case ctablock.FieldPlacement:
+		return m.Placement()
+	case ctablock.FieldParagraph:
+		return m.Paragraph()
+	case ctablock.FieldActive:
+		return m.Active()
+	case ctablock.FieldCategoryID:
+		return m.CategoryID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CTABlockMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ctablock.FieldName:
+		return m.OldName(ctx)
+	case ctablock.FieldHeading:
+		return m.OldHeading(ctx)
+	case ctablock.FieldBody:
+		return m.OldBody(ctx)
+	case ctablock.FieldButtonText:
+		return m.OldButtonText(ctx)
+	case ctablock.FieldButtonURL:
+		return m.OldButtonURL(ctx)
+	case ctablock.FieldPlacement:
+		return m.OldPlacement(ctx)
+	case ctablock.FieldParagraph:
+		return m.OldParagraph(ctx)
+	case ctablock.FieldActive:
+		return m.OldActive(ctx)
+	case ctablock.FieldCategoryID:
+		return m.OldCategoryID(ctx)
+	}
+	return nil, fmt.Errorf("unknown CTABlock field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CTABlockMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ctablock.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case ctablock.FieldHeading:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeading(v)
+		return nil
+	case ctablock.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case ctablock.FieldButtonText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetButtonText(v)
+		return nil
+	case ctablock.FieldButtonURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetButtonURL(v)
+		return nil
+	case ctablock.FieldPlacement:
+		v, ok := value.(ctablock.Placement)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlacement(v)
+		return nil
+	case ctablock.FieldParagraph:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParagraph(v)
+		return nil
+	case ctablock.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
+		return nil
+	case ctablock.FieldCategoryID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategoryID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CTABlock field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CTABlockMutation) AddedFields() []string {
+	var fields []string
+	if m.addparagraph != nil {
+		fields = append(fields, ctablock.FieldParagraph)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CTABlockMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case ctablock.FieldParagraph:
+		return m.AddedParagraph()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CTABlockMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case ctablock.FieldParagraph:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddParagraph(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CTABlock numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CTABlockMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(ctablock.FieldHeading) {
+		fields = append(fields, ctablock.FieldHeading)
+	}
+	if m.FieldCleared(ctablock.FieldBody) {
+		fields = append(fields, ctablock.FieldBody)
+	}
+	if m.FieldCleared(ctablock.FieldButtonText) {
+		fields = append(fields, ctablock.FieldButtonText)
+	}
+	if m.FieldCleared(ctablock.FieldButtonURL) {
+		fields = append(fields, ctablock.FieldButtonURL)
+	}
+	if m.FieldCleared(ctablock.FieldCategoryID) {
+		fields = append(fields, ctablock.FieldCategoryID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CTABlockMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CTABlockMutation) ClearField(name string) error {
+	switch name {
+	case ctablock.FieldHeading:
+		m.ClearHeading()
+		return nil
+	case ctablock.FieldBody:
+		m.ClearBody()
+		return nil
+	case ctablock.FieldButtonText:
+		m.ClearButtonText()
+		return nil
+	case ctablock.FieldButtonURL:
+		m.ClearButtonURL()
+		return nil
+	case ctablock.FieldCategoryID:
+		m.ClearCategoryID()
+		return nil
+	}
+	return fmt.Errorf("unknown CTABlock nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CTABlockMutation) ResetField(name string) error {
+	switch name {
+	case ctablock.FieldName:
+		m.ResetName()
+		return nil
+	case ctablock.FieldHeading:
+		m.ResetHeading()
+		return nil
+	case ctablock.FieldBody:
+		m.ResetBody()
+		return nil
+	case ctablock.FieldButtonText:
+		m.ResetButtonText()
+		return nil
+	case ctablock.FieldButtonURL:
+		m.ResetButtonURL()
+		return nil
+	case ctablock.FieldPlacement:
+		m.ResetPlacement()
+		return nil
+	case ctablock.FieldParagraph:
+		m.ResetParagraph()
+		return nil
+	case ctablock.FieldActive:
+		m.ResetActive()
+		return nil
+	case ctablock.FieldCategoryID:
+		m.ResetCategoryID()
+		return nil
+	}
+	return fmt.Errorf("unknown CTABlock field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CTABlockMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.category != nil {
+		edges = append(edges, ctablock.EdgeCategory)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CTABlockMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case ctablock.EdgeCategory:
+		if id := m.category; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CTABlockMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CTABlockMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CTABlockMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcategory {
+		edges = append(edges, ctablock.EdgeCategory)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CTABlockMutation) EdgeCleared(name string) bool {
+	switch name {
+	case ctablock.EdgeCategory:
+		return m.clearedcategory
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CTABlockMutation) ClearEdge(name string) error {
+	switch name {
+	case ctablock.EdgeCategory:
+		m.ClearCategory()
+		return nil
+	}
+	return fmt.Errorf("unknown CTABlock unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CTABlockMutation) ResetEdge(name string) error {
+	switch name {
+	case ctablock.EdgeCategory:
+		m.ResetCategory()
+		return nil
+	}
+	return fmt.Errorf("unknown CTABlock edge %s", name)
+}
+
+// CategoryMutation represents an operation that mutates the Category nodes in the graph.
+type CategoryMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	slug              *string
+	name              *map[string]string
+	description       *string
+	clearedFields     map[string]struct{}
+	parent            *int
+	clearedparent     bool
+	children          map[int]struct{}
+	removedchildren   map[int]struct{}
+	clearedchildren   bool
+	blogs             map[int]struct{}
+	removedblogs      map[int]struct{}
+	clearedblogs      bool
+	cta_blocks        map[int]struct{}
+	removedcta_blocks map[int]struct{}
+	clearedcta_blocks bool
+	done              bool
+	oldValue          func(context.Context) (*Category, error)
+	predicates        []predicate.Category
+}
+
+var _ ent.Mutation = (*CategoryMutation)(nil)
+
+// categoryOption allows management of the mutation configuration using functional options.
+type categoryOption func(*CategoryMutation)
+
+// newCategoryMutation creates new mutation for the Category entity.
+func newCategoryMutation(c config, op Op, opts ...categoryOption) *CategoryMutation {
+	m := &CategoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCategory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCategoryID sets the ID field of the mutation.
+func withCategoryID(id int) categoryOption {
+	return func(m *CategoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Category
+		)
+		m.oldValue = func(ctx context.Context) (*Category, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Category.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCategory sets the old Category of the mutation.
+func withCategory(node *Category) categoryOption {
+	return func(m *CategoryMutation) {
+		m.oldValue = func(context.Context) (*Category, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CategoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CategoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CategoryMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CategoryMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Category.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSlug sets the "slug" field.
+func (m *CategoryMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *CategoryMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlug returns the old "slug" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *CategoryMutation) ResetSlug() {
+	m.slug = nil
+}
+
+// SetName sets the "name" field.
+func (m *CategoryMutation) SetName(value map[string]string) {
+	m.name = &value
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *CategoryMutation) Name() (r map[string]string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldName(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *CategoryMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[category.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *CategoryMutation) NameCleared() bool {
+	_, ok := m.clearedFields[category.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *CategoryMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, category.FieldName)
+}
+
+// SetDescription sets the "description" field.
+func (m *CategoryMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *CategoryMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *CategoryMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[category.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *CategoryMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[category.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *CategoryMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, category.FieldDescription)
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *CategoryMutation) SetParentID(i int) {
+	m.parent = &i
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *CategoryMutation) ParentID() (r int, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Category entity.
+// If the Category object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CategoryMutation) OldParentID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *CategoryMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[category.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *CategoryMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[category.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *CategoryMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, category.FieldParentID)
+}
+
+// ClearParent clears the "parent" edge to the Category entity.
+func (m *CategoryMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[category.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Category entity was cleared.
+func (m *CategoryMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *CategoryMutation) ParentIDs() (ids []int) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *CategoryMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Category entity by ids.
+func (m *CategoryMutation) AddChildIDs(ids ...int) {
+	if m.children == nil {
+		m.children = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Category entity.
+func (m *CategoryMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Category entity was cleared.
+func (m *CategoryMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Category entity by IDs.
+func (m *CategoryMutation) RemoveChildIDs(ids ...int) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Category entity.
+func (m *CategoryMutation) RemovedChildrenIDs() (ids []int) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *CategoryMutation) ChildrenIDs() (ids []int) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *CategoryMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// AddBlogIDs adds the "blogs" edge to the Blog entity by ids.
+func (m *CategoryMutation) AddBlogIDs(ids ...int) {
+	if m.blogs == nil {
+		m.blogs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.blogs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBlogs clears the "blogs" edge to the Blog entity.
+func (m *CategoryMutation) ClearBlogs() {
+	m.clearedblogs = true
+}
+
+// BlogsCleared reports if the "blogs" edge to the Blog entity was cleared.
+func (m *CategoryMutation) BlogsCleared() bool {
+	return m.clearedblogs
+}
+
+// RemoveBlogIDs removes the "blogs" edge to the Blog entity by IDs.
+func (m *CategoryMutation) RemoveBlogIDs(ids ...int) {
+	if m.removedblogs == nil {
+		m.removedblogs = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.blogs, ids[i])
+		m.removedblogs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBlogs returns the removed IDs of the "blogs" edge to the Blog entity.
+func (m *CategoryMutation) RemovedBlogsIDs() (ids []int) {
+	for id := range m.removedblogs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BlogsIDs returns the "blogs" edge IDs in the mutation.
+func (m *CategoryMutation) BlogsIDs() (ids []int) {
+	for id := range m.blogs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBlogs resets all changes to the "blogs" edge.
+func (m *CategoryMutation) ResetBlogs() {
+	m.blogs = nil
+	m.clearedblogs = false
+	m.removedblogs = nil
+}
+
+// AddCtaBlockIDs adds the "cta_blocks" edge to the CTABlock entity by ids.
+func (m *CategoryMutation) AddCtaBlockIDs(ids ...int) {
+	if m.cta_blocks == nil {
+		m.cta_blocks = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.cta_blocks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCtaBlocks clears the "cta_blocks" edge to the CTABlock entity.
+func (m *CategoryMutation) ClearCtaBlocks() {
+	m.clearedcta_blocks = true
+}
+
+// CtaBlocksCleared reports if the "cta_blocks" edge to the CTABlock entity was cleared.
+func (m *CategoryMutation) CtaBlocksCleared() bool {
+	return m.clearedcta_blocks
+}
+
+// RemoveCtaBlockIDs removes the "cta_blocks" edge to the CTABlock entity by IDs.
+func (m *CategoryMutation) RemoveCtaBlockIDs(ids ...int) {
+	if m.removedcta_blocks == nil {
+		m.removedcta_blocks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.cta_blocks, ids[i])
+		m.removedcta_blocks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCtaBlocks returns the removed IDs of the "cta_blocks" edge to the CTABlock entity.
+func (m *CategoryMutation) RemovedCtaBlocksIDs() (ids []int) {
+	for id := range m.removedcta_blocks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CtaBlocksIDs returns the "cta_blocks" edge IDs in the mutation.
+func (m *CategoryMutation) CtaBlocksIDs() (ids []int) {
+	for id := range m.cta_blocks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCtaBlocks resets all changes to the "cta_blocks" edge.
+func (m *CategoryMutation) ResetCtaBlocks() {
+	m.cta_blocks = nil
+	m.clearedcta_blocks = false
+	m.removedcta_blocks = nil
+}
+
+// Where appends a list predicates to the CategoryMutation builder.
+func (m *CategoryMutation) Where(ps ...predicate.Category) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CategoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CategoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Category, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CategoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CategoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Category).
+func (m *CategoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CategoryMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.slug != nil {
+		fields = append(fields, category.FieldSlug)
+	}
+	if m.name != nil {
+		fields = append(fields, category.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, category.FieldDescription)
+	}
+	if m.parent != nil {
+		fields = append(fields, category.FieldParentID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CategoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case category.FieldSlug:
+		return m.Slug()
+	case category.FieldName:
+		return m.Name()
+	case category.FieldDescription:
+		return m.Description()
+	case category.FieldParentID:
+		return m.ParentID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CategoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case category.FieldSlug:
+		return m.OldSlug(ctx)
+	case category.FieldName:
+		return m.OldName(ctx)
+	case category.FieldDescription:
+		return m.OldDescription(ctx)
+	case category.FieldParentID:
+		return m.OldParentID(ctx)
+	}
+	return nil, fmt.Errorf("unknown Category field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CategoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case category.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
+	case category.FieldName:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case category.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case category.FieldParentID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Category field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CategoryMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CategoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CategoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Category numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CategoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(category.FieldName) {
+		fields = append(fields, category.FieldName)
+	}
+	if m.FieldCleared(category.FieldDescription) {
+		fields = append(fields, category.FieldDescription)
+	}
+	if m.FieldCleared(category.FieldParentID) {
+		fields = append(fields, category.FieldParentID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CategoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CategoryMutation) ClearField(name string) error {
+	switch name {
+	case category.FieldName:
+		m.ClearName()
+		return nil
+	case category.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case category.FieldParentID:
+		m.ClearParentID()
+		return nil
+	}
+	return fmt.Errorf("unknown Category nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CategoryMutation) ResetField(name string) error {
+	switch name {
+	case category.FieldSlug:
+		m.ResetSlug()
+		return nil
+	case category.FieldName:
+		m.ResetName()
+		return nil
+	case category.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case category.FieldParentID:
+		m.ResetParentID()
+		return nil
+	}
+	return fmt.Errorf("unknown Category field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CategoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.parent != nil {
+		edges = append(edges, category.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, category.EdgeChildren)
+	}
+	if m.blogs != nil {
+		edges = append(edges, category.EdgeBlogs)
+	}
+	if m.cta_blocks != nil {
+		edges = append(edges, category.EdgeCtaBlocks)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CategoryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case category.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case category.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case category.EdgeBlogs:
+		ids := make([]ent.Value, 0, len(m.blogs))
+		for id := range m.blogs {
+			ids = append(ids, id)
+		}
+		return ids
+	case category.EdgeCtaBlocks:
+		ids := make([]ent.Value, 0, len(m.cta_blocks))
+		for id := range m.cta_blocks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CategoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedchildren != nil {
+		edges = append(edges, category.EdgeChildren)
+	}
+	if m.removedblogs != nil {
+		edges = append(edges, category.EdgeBlogs)
+	}
+	if m.removedcta_blocks != nil {
+		edges = append(edges, category.EdgeCtaBlocks)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CategoryMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case category.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	case category.EdgeBlogs:
+		ids := make([]ent.Value, 0, len(m.removedblogs))
+		for id := range m.removedblogs {
+			ids = append(ids, id)
+		}
+		return ids
+	case category.EdgeCtaBlocks:
+		ids := make([]ent.Value, 0, len(m.removedcta_blocks))
+		for id := range m.removedcta_blocks {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CategoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedparent {
+		edges = append(edges, category.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, category.EdgeChildren)
+	}
+	if m.clearedblogs {
+		edges = append(edges, category.EdgeBlogs)
+	}
+	if m.clearedcta_blocks {
+		edges = append(edges, category.EdgeCtaBlocks)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CategoryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case category.EdgeParent:
+		return m.clearedparent
+	case category.EdgeChildren:
+		return m.clearedchildren
+	case category.EdgeBlogs:
+		return m.clearedblogs
+	case category.EdgeCtaBlocks:
+		return m.clearedcta_blocks
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CategoryMutation) ClearEdge(name string) error {
+	switch name {
+	case category.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Category unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CategoryMutation) ResetEdge(name string) error {
+	switch name {
+	case category.EdgeParent:
+		m.ResetParent()
+		return nil
+	case category.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case category.EdgeBlogs:
+		m.ResetBlogs()
+		return nil
+	case category.EdgeCtaBlocks:
+		m.ResetCtaBlocks()
+		return nil
+	}
+	return fmt.Errorf("unknown Category edge %s", name)
+}
+
+// MediaMutation represents an operation that mutates the Media nodes in the graph.
+type MediaMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	key                *string
+	url                *string
+	content_type       *string
+	size               *int64
+	addsize            *int64
+	width              *int
+	addwidth           *int
+	height             *int
+	addheight          *int
+	alt                *string
+	variants           *[]imaging.Variant
+	appendvariants     []imaging.Variant
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	featured_in        map[int]struct{}
+	removedfeatured_in map[int]struct{}
+	clearedfeatured_in bool
+	done               bool
+	oldValue           func(context.Context) (*Media, error)
+	predicates         []predicate.Media
+}
+
+var _ ent.Mutation = (*MediaMutation)(nil)
+
+// mediaOption allows management of the mutation configuration using functional options.
+type mediaOption func(*MediaMutation)
+
+// newMediaMutation creates new mutation for the Media entity.
+func newMediaMutation(c config, op Op, opts ...mediaOption) *MediaMutation {
+	m := &MediaMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMedia,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMediaID sets the ID field of the mutation.
+func withMediaID(id int) mediaOption {
+	return func(m *MediaMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Media
+		)
+		m.oldValue = func(ctx context.Context) (*Media, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Media.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMedia sets the old Media of the mutation.
+func withMedia(node *Media) mediaOption {
+	return func(m *MediaMutation) {
+		m.oldValue = func(context.Context) (*Media, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MediaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MediaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MediaMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MediaMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Media.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetKey sets the "key" field.
+func (m *MediaMutation) SetKey(s string) {
+	m.key = &s
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *MediaMutation) Key() (r string, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *MediaMutation) ResetKey() {
+	m.key = nil
+}
+
+// SetURL sets the "url" field.
+func (m *MediaMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *MediaMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *MediaMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetContentType sets the "content_type" field.
+func (m *MediaMutation) SetContentType(s string) {
+	m.content_type = &s
+}
+
+// ContentType returns the value of the "content_type" field in the mutation.
+func (m *MediaMutation) ContentType() (r string, exists bool) {
+	v := m.content_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContentType returns the old "content_type" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldContentType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContentType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContentType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContentType: %w", err)
+	}
+	return oldValue.ContentType, nil
+}
+
+// ResetContentType resets all changes to the "content_type" field.
+func (m *MediaMutation) ResetContentType() {
+	m.content_type = nil
+}
+
+// SetSize sets the "size" field.
+func (m *MediaMutation) SetSize(i int64) {
+	m.size = &i
+	m.addsize = nil
+}
+
+// Size returns the value of the "size" field in the mutation.
+func (m *MediaMutation) Size() (r int64, exists bool) {
+	v := m.size
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSize returns the old "size" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldSize(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSize is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSize requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSize: %w", err)
+	}
+	return oldValue.Size, nil
+}
+
+// AddSize adds i to the "size" field.
+func (m *MediaMutation) AddSize(i int64) {
+	if m.addsize != nil {
+		*m.addsize += i
+	} else {
+		m.addsize = &i
+	}
+}
+
+// AddedSize returns the value that was added to the "size" field in this mutation.
+func (m *MediaMutation) AddedSize() (r int64, exists bool) {
+	v := m.addsize
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSize resets all changes to the "size" field.
+func (m *MediaMutation) ResetSize() {
+	m.size = nil
+	m.addsize = nil
+}
+
+// SetWidth sets the "width" field.
+func (m *MediaMutation) SetWidth(i int) {
+	m.width = &i
+	m.addwidth = nil
+}
+
+// Width returns the value of the "width" field in the mutation.
+func (m *MediaMutation) Width() (r int, exists bool) {
+	v := m.width
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWidth returns the old "width" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldWidth(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWidth is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWidth requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWidth: %w", err)
+	}
+	return oldValue.Width, nil
+}
+
+// AddWidth adds i to the "width" field.
+func (m *MediaMutation) AddWidth(i int) {
+	if m.addwidth != nil {
+		*m.addwidth += i
+	} else {
+		m.addwidth = &i
+	}
+}
+
+// AddedWidth returns the value that was added to the "width" field in this mutation.
+func (m *MediaMutation) AddedWidth() (r int, exists bool) {
+	v := m.addwidth
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWidth resets all changes to the "width" field.
+func (m *MediaMutation) ResetWidth() {
+	m.width = nil
+	m.addwidth = nil
+}
+
+// SetHeight sets the "height" field.
+func (m *MediaMutation) SetHeight(i int) {
+	m.height = &i
+	m.addheight = nil
+}
+
+// Height returns the value of the "height" field in the mutation.
+func (m *MediaMutation) Height() (r int, exists bool) {
+	v := m.height
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHeight returns the old "height" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldHeight(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHeight is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHeight requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHeight: %w", err)
+	}
+	return oldValue.Height, nil
+}
+
+// AddHeight adds i to the "height" field.
+func (m *MediaMutation) AddHeight(i int) {
+	if m.addheight != nil {
+		*m.addheight += i
+	} else {
+		m.addheight = &i
+	}
+}
+
+// AddedHeight returns the value that was added to the "height" field in this mutation.
+func (m *MediaMutation) AddedHeight() (r int, exists bool) {
+	v := m.addheight
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHeight resets all changes to the "height" field.
+func (m *MediaMutation) ResetHeight() {
+	m.height = nil
+	m.addheight = nil
+}
+
+// SetAlt sets the "alt" field.
+func (m *MediaMutation) SetAlt(s string) {
+	m.alt = &s
+}
+
+// Alt returns the value of the "alt" field in the mutation.
+func (m *MediaMutation) Alt() (r string, exists bool) {
+	v := m.alt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAlt returns the old "alt" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldAlt(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAlt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAlt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAlt: %w", err)
+	}
+	return oldValue.Alt, nil
+}
+
+// ClearAlt clears the value of the "alt" field.
+func (m *MediaMutation) ClearAlt() {
+	m.alt = nil
+	m.clearedFields[media.FieldAlt] = struct{}{}
+}
+
+// AltCleared returns if the "alt" field was cleared in this mutation.
+func (m *MediaMutation) AltCleared() bool {
+	_, ok := m.clearedFields[media.FieldAlt]
+	return ok
+}
+
+// ResetAlt resets all changes to the "alt" field.
+func (m *MediaMutation) ResetAlt() {
+	m.alt = nil
+	delete(m.clearedFields, media.FieldAlt)
+}
+
+// SetVariants sets the "variants" field.
+func (m *MediaMutation) SetVariants(i []imaging.Variant) {
+	m.variants = &i
+	m.appendvariants = nil
+}
+
+// Variants returns the value of the "variants" field in the mutation.
+func (m *MediaMutation) Variants() (r []imaging.Variant, exists bool) {
+	v := m.variants
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVariants returns the old "variants" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldVariants(ctx context.Context) (v []imaging.Variant, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVariants is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVariants requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVariants: %w", err)
+	}
+	return oldValue.Variants, nil
+}
+
+// AppendVariants adds i to the "variants" field.
+func (m *MediaMutation) AppendVariants(i []imaging.Variant) {
+	m.appendvariants = append(m.appendvariants, i...)
+}
+
+// AppendedVariants returns the list of values that were appended to the "variants" field in this mutation.
+func (m *MediaMutation) AppendedVariants() ([]imaging.Variant, bool) {
+	if len(m.appendvariants) == 0 {
+		return nil, false
+	}
+	return m.appendvariants, true
+}
+
+// ClearVariants clears the value of the "variants" field.
+func (m *MediaMutation) ClearVariants() {
+	m.variants = nil
+	m.appendvariants = nil
+	m.clearedFields[media.FieldVariants] = struct{}{}
+}
+
+// VariantsCleared returns if the "variants" field was cleared in this mutation.
+func (m *MediaMutation) VariantsCleared() bool {
+	_, ok := m.clearedFields[media.FieldVariants]
+	return ok
+}
+
+// ResetVariants resets all changes to the "variants" field.
+func (m *MediaMutation) ResetVariants() {
+	m.variants = nil
+	m.appendvariants = nil
+	delete(m.clearedFields, media.FieldVariants)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MediaMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MediaMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Media entity.
+// If the Media object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MediaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MediaMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddFeaturedInIDs adds the "featured_in" edge to the Blog entity by ids.
+func (m *MediaMutation) AddFeaturedInIDs(ids ...int) {
+	if m.featured_in == nil {
+		m.featured_in = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.featured_in[ids[i]] = struct{}{}
+	}
+}
+
+// ClearFeaturedIn clears the "featured_in" edge to the Blog entity.
+func (m *MediaMutation) ClearFeaturedIn() {
+	m.clearedfeatured_in = true
+}
+
+// FeaturedInCleared reports if the "featured_in" edge to the Blog entity was cleared.
+func (m *MediaMutation) FeaturedInCleared() bool {
+	return m.clearedfeatured_in
+}
+
+// RemoveFeaturedInIDs removes the "featured_in" edge to the Blog entity by IDs.
+func (m *MediaMutation) RemoveFeaturedInIDs(ids ...int) {
+	if m.removedfeatured_in == nil {
+		m.removedfeatured_in = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.featured_in, ids[i])
+		m.removedfeatured_in[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedFeaturedIn returns the removed IDs of the "featured_in" edge to the Blog entity.
+func (m *MediaMutation) RemovedFeaturedInIDs() (ids []int) {
+	for id := range m.removedfeatured_in {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// FeaturedInIDs returns the "featured_in" edge IDs in the mutation.
+func (m *MediaMutation) FeaturedInIDs() (ids []int) {
+	for id := range m.featured_in {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetFeaturedIn resets all changes to the "featured_in" edge.
+func (m *MediaMutation) ResetFeaturedIn() {
+	m.featured_in = nil
+	m.clearedfeatured_in = false
+	m.removedfeatured_in = nil
+}
+
+// Where appends a list predicates to the MediaMutation builder.
+func (m *MediaMutation) Where(ps ...predicate.Media) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MediaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MediaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Media, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MediaMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MediaMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Media).
+func (m *MediaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MediaMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.key != nil {
+		fields = append(fields, media.FieldKey)
+	}
+	if m.url != nil {
+		fields = append(fields, media.FieldURL)
+	}
+	if m.content_type != nil {
+		fields = append(fields, media.FieldContentType)
+	}
+	if m.size != nil {
+		fields = append(fields, media.FieldSize)
+	}
+	if m.width != nil {
+		fields = append(fields, media.FieldWidth)
+	}
+	if m.height != nil {
+		fields = append(fields, media.FieldHeight)
+	}
+	if m.alt != nil {
+		fields = append(fields, media.FieldAlt)
+	}
+	if m.variants != nil {
+		fields = append(fields, media.FieldVariants)
+	}
+	if m.created_at != nil {
+		fields = append(fields, media.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MediaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case media.FieldKey:
+		return m.Key()
+	case media.FieldURL:
+		return m.URL()
+	case media.FieldContentType:
+		return m.ContentType()
+	case media.FieldSize:
+		return m.Size()
+	case media.FieldWidth:
+		return m.Width()
+	case media.FieldHeight:
+		return m.Height()
+	case media.FieldAlt:
+		return m.Alt()
+	case media.FieldVariants:
+		return m.Variants()
+	case media.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MediaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case media.FieldKey:
+		return m.OldKey(ctx)
+	case media.FieldURL:
+		return m.OldURL(ctx)
+	case media.FieldContentType:
+		return m.OldContentType(ctx)
+	case media.FieldSize:
+		return m.OldSize(ctx)
+	case media.FieldWidth:
+		return m.OldWidth(ctx)
+	case media.FieldHeight:
+		return m.OldHeight(ctx)
+	case media.FieldAlt:
+		return m.OldAlt(ctx)
+	case media.FieldVariants:
+		return m.OldVariants(ctx)
+	case media.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Media field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MediaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case media.FieldKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case media.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case media.FieldContentType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContentType(v)
+		return nil
+	case media.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSize(v)
+		return nil
+	case media.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWidth(v)
+		return nil
+	case media.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHeight(v)
+		return nil
+	case media.FieldAlt:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAlt(v)
+		return nil
+	case media.FieldVariants:
+		v, ok := value.([]imaging.Variant)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVariants(v)
+		return nil
+	case media.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Media field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MediaMutation) AddedFields() []string {
+	var fields []string
+	if m.addsize != nil {
+		fields = append(fields, media.FieldSize)
+	}
+	if m.addwidth != nil {
+		fields = append(fields, media.FieldWidth)
+	}
+	if m.addheight != nil {
+		fields = append(fields, media.FieldHeight)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MediaMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case media.FieldSize:
+		return m.AddedSize()
+	case media.FieldWidth:
+		return m.AddedWidth()
+	case media.FieldHeight:
+		return m.AddedHeight()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MediaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case media.FieldSize:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSize(v)
+		return nil
+	case media.FieldWidth:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWidth(v)
+		return nil
+	case media.FieldHeight:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHeight(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Media numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MediaMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(media.FieldAlt) {
+		fields = append(fields, media.FieldAlt)
+	}
+	if m.FieldCleared(media.FieldVariants) {
+		fields = append(fields, media.FieldVariants)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MediaMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MediaMutation) ClearField(name string) error {
+	switch name {
+	case media.FieldAlt:
+		m.ClearAlt()
+		return nil
+	case media.FieldVariants:
+		m.ClearVariants()
+		return nil
+	}
+	return fmt.Errorf("unknown Media nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MediaMutation) ResetField(name string) error {
+	switch name {
+	case media.FieldKey:
+		m.ResetKey()
+		return nil
+	case media.FieldURL:
+		m.ResetURL()
+		return nil
+	case media.FieldContentType:
+		m.ResetContentType()
+		return nil
+	case media.FieldSize:
+		m.ResetSize()
+		return nil
+	case media.FieldWidth:
+		m.ResetWidth()
+		return nil
+	case media.FieldHeight:
+		m.ResetHeight()
+		return nil
+	case media.FieldAlt:
+		m.ResetAlt()
+		return nil
+	case media.FieldVariants:
+		m.ResetVariants()
+		return nil
+	case media.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Media field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MediaMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.featured_in != nil {
+		edges = append(edges, media.EdgeFeaturedIn)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MediaMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case media.EdgeFeaturedIn:
+		ids := make([]ent.Value, 0, len(m.featured_in))
+		for id := range m.featured_in {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MediaMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedfeatured_in != nil {
+		edges = append(edges, media.EdgeFeaturedIn)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MediaMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case media.EdgeFeaturedIn:
+		ids := make([]ent.Value, 0, len(m.removedfeatured_in))
+		for id := range m.removedfeatured_in {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MediaMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedfeatured_in {
+		edges = append(edges, media.EdgeFeaturedIn)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MediaMutation) EdgeCleared(name string) bool {
+	switch name {
+	case media.EdgeFeaturedIn:
+		return m.clearedfeatured_in
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MediaMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Media unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MediaMutation) ResetEdge(name string) error {
+	switch name {
+	case media.EdgeFeaturedIn:
+		m.ResetFeaturedIn()
+		return nil
+	}
+	return fmt.Errorf("unknown Media edge %s", name)
+}
+
+// PlaceholderMutation represents an operation that mutates the Placeholder nodes in the graph.
+type PlaceholderMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	name          *string
+	value         *string
+	description   *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Placeholder, error)
+	predicates    []predicate.Placeholder
+}
+
+var _ ent.Mutation = (*PlaceholderMutation)(nil)
+
+// placeholderOption allows management of the mutation configuration using functional options.
+type placeholderOption func(*PlaceholderMutation)
+
+// newPlaceholderMutation creates new mutation for the Placeholder entity.
+func newPlaceholderMutation(c config, op Op, opts ...placeholderOption) *PlaceholderMutation {
+	m := &PlaceholderMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePlaceholder,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPlaceholderID sets the ID field of the mutation.
+func withPlaceholderID(id int) placeholderOption {
+	return func(m *PlaceholderMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Placeholder
+		)
+		m.oldValue = func(ctx context.Context) (*Placeholder, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Placeholder.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPlaceholder sets the old Placeholder of the mutation.
+func withPlaceholder(node *Placeholder) placeholderOption {
+	return func(m *PlaceholderMutation) {
+		m.oldValue = func(context.Context) (*Placeholder, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PlaceholderMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PlaceholderMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PlaceholderMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PlaceholderMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Placeholder.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *PlaceholderMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PlaceholderMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Placeholder entity.
+// If the Placeholder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceholderMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PlaceholderMutation) ResetName() {
+	m.name = nil
+}
+
+// SetValue sets the "value" field.
+func (m *PlaceholderMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *PlaceholderMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the Placeholder entity.
+// If the Placeholder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceholderMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *PlaceholderMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *PlaceholderMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *PlaceholderMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Placeholder entity.
+// If the Placeholder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PlaceholderMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *PlaceholderMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[placeholder.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *PlaceholderMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[placeholder.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *PlaceholderMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, placeholder.FieldDescription)
+}
+
+// Where appends a list predicates to the PlaceholderMutation builder.
+func (m *PlaceholderMutation) Where(ps ...predicate.Placeholder) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PlaceholderMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PlaceholderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Placeholder, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PlaceholderMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PlaceholderMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Placeholder).
+func (m *PlaceholderMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PlaceholderMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, placeholder.FieldName)
+	}
+	if m.value != nil {
+		fields = append(fields, placeholder.FieldValue)
+	}
+	if m.description != nil {
+		fields = append(fields, placeholder.FieldDescription)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PlaceholderMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case placeholder.FieldName:
+		return m.Name()
+	case placeholder.FieldValue:
+		return m.Value()
+	case placeholder.FieldDescription:
+		return m.Description()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PlaceholderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case placeholder.FieldName:
+		return m.OldName(ctx)
+	case placeholder.FieldValue:
+		return m.OldValue(ctx)
+	case placeholder.FieldDescription:
+		return m.OldDescription(ctx)
+	}
+	return nil, fmt.Errorf("unknown Placeholder field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlaceholderMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case placeholder.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case placeholder.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case placeholder.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Placeholder field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PlaceholderMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PlaceholderMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PlaceholderMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Placeholder numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PlaceholderMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(placeholder.FieldDescription) {
+		fields = append(fields, placeholder.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PlaceholderMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PlaceholderMutation) ClearField(name string) error {
+	switch name {
+	case placeholder.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Placeholder nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PlaceholderMutation) ResetField(name string) error {
+	switch name {
+	case placeholder.FieldName:
+		m.ResetName()
+		return nil
+	case placeholder.FieldValue:
+		m.ResetValue()
+		return nil
+	case placeholder.FieldDescription:
+		m.ResetDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown Placeholder field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PlaceholderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PlaceholderMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PlaceholderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PlaceholderMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PlaceholderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PlaceholderMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PlaceholderMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Placeholder unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PlaceholderMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Placeholder edge %s", name)
+}
+
+// TagMutation represents an operation that mutates the Tag nodes in the graph.
+type TagMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	slug          *string
+	name          *string
+	clearedFields map[string]struct{}
+	blogs         map[int]struct{}
+	removedblogs  map[int]struct{}
+	clearedblogs  bool
+	done          bool
+	oldValue      func(context.Context) (*Tag, error)
+	predicates    []predicate.Tag
+}
+
+var _ ent.Mutation = (*TagMutation)(nil)
+
+// tagOption allows management of the mutation configuration using functional options.
+type tagOption func(*TagMutation)
+
+// newTagMutation creates new mutation for the Tag entity.
+func newTagMutation(c config, op Op, opts ...tagOption) *TagMutation {
+	m := &TagMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTag,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTagID sets the ID field of the mutation.
+func withTagID(id int) tagOption {
+	return func(m *TagMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tag
+		)
+		m.oldValue = func(ctx context.Context) (*Tag, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tag.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTag sets the old Tag of the mutation.
+func withTag(node *Tag) tagOption {
+	return func(m *TagMutation) {
+		m.oldValue = func(context.Context) (*Tag, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TagMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TagMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TagMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TagMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tag.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSlug sets the "slug" field.
+func (m *TagMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *TagMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlug returns the old "slug" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldSlug(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *TagMutation) ResetSlug() {
+	m.slug = nil
+}
+
+// SetName sets the "name" field.
+func (m *TagMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TagMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Tag entity.
+// If the Tag object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TagMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TagMutation) ResetName() {
+	m.name = nil
+}
+
+// AddBlogIDs adds the "blogs" edge to the Blog entity by ids.
+func (m *TagMutation) AddBlogIDs(ids ...int) {
+	if m.blogs == nil {
+		m.blogs = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.blogs[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBlogs clears the "blogs" edge to the Blog entity.
+func (m *TagMutation) ClearBlogs() {
+	m.clearedblogs = true
+}
+
+// BlogsCleared reports if the "blogs" edge to the Blog entity was cleared.
+func (m *TagMutation) BlogsCleared() bool {
+	return m.clearedblogs
+}
+
+// RemoveBlogIDs removes the "blogs" edge to the Blog entity by IDs.
+func (m *TagMutation) RemoveBlogIDs(ids ...int) {
+	if m.removedblogs == nil {
+		m.removedblogs = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.blogs, ids[i])
+		m.removedblogs[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBlogs returns the removed IDs of the "blogs" edge to the Blog entity.
+func (m *TagMutation) RemovedBlogsIDs() (ids []int) {
+	for id := range m.removedblogs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BlogsIDs returns the "blogs" edge IDs in the mutation.
+func (m *TagMutation) BlogsIDs() (ids []int) {
+	for id := range m.blogs {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBlogs resets all changes to the "blogs" edge.
+func (m *TagMutation) ResetBlogs() {
+	m.blogs = nil
+	m.clearedblogs = false
+	m.removedblogs = nil
+}
+
+// Where appends a list predicates to the TagMutation builder.
+func (m *TagMutation) Where(ps ...predicate.Tag) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TagMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TagMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tag, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TagMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TagMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Tag).
+func (m *TagMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TagMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.slug != nil {
+		fields = append(fields, tag.FieldSlug)
+	}
+	if m.name != nil {
+		fields = append(fields, tag.FieldName)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TagMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tag.FieldSlug:
+		return m.Slug()
+	case tag.FieldName:
+		return m.Name()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TagMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tag.FieldSlug:
+		return m.OldSlug(ctx)
+	case tag.FieldName:
+		return m.OldName(ctx)
 	}
-	return nil, fmt.Errorf("unknown Blog field %s", name)
+	return nil, fmt.Errorf("unknown Tag field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BlogMutation) SetField(name string, value ent.Value) error {
+func (m *TagMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case blog.FieldCategory:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCategory(v)
-		return nil
-	case blog.FieldText:
+	case tag.FieldSlug:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetText(v)
+		m.SetSlug(v)
 		return nil
-	case blog.FieldPath:
+	case tag.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPath(v)
-		return nil
-	case blog.FieldEmbedding:
-		v, ok := value.([]float32)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEmbedding(v)
+		m.SetName(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Blog field %s", name)
+	return fmt.Errorf("unknown Tag field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *BlogMutation) AddedFields() []string {
+func (m *TagMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *BlogMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TagMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *BlogMutation) AddField(name string, value ent.Value) error {
+func (m *TagMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Blog numeric field %s", name)
+	return fmt.Errorf("unknown Tag numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *BlogMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(blog.FieldEmbedding) {
-		fields = append(fields, blog.FieldEmbedding)
-	}
-	return fields
+func (m *TagMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *BlogMutation) FieldCleared(name string) bool {
+func (m *TagMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *BlogMutation) ClearField(name string) error {
-	switch name {
-	case blog.FieldEmbedding:
-		m.ClearEmbedding()
-		return nil
-	}
-	return fmt.Errorf("unknown Blog nullable field %s", name)
+func (m *TagMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Tag nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *BlogMutation) ResetField(name string) error {
+func (m *TagMutation) ResetField(name string) error {
 	switch name {
-	case blog.FieldCategory:
-		m.ResetCategory()
-		return nil
-	case blog.FieldText:
-		m.ResetText()
+	case tag.FieldSlug:
+		m.ResetSlug()
 		return nil
-	case blog.FieldPath:
-		m.ResetPath()
-		return nil
-	case blog.FieldEmbedding:
-		m.ResetEmbedding()
+	case tag.FieldName:
+		m.ResetName()
 		return nil
 	}
-	return fmt.Errorf("unknown Blog field %s", name)
+	return fmt.Errorf("unknown Tag field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *BlogMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *TagMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.blogs != nil {
+		edges = append(edges, tag.EdgeBlogs)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *BlogMutation) AddedIDs(name string) []ent.Value {
+func (m *TagMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgeBlogs:
+		ids := make([]ent.Value, 0, len(m.blogs))
+		for id := range m.blogs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *BlogMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *TagMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedblogs != nil {
+		edges = append(edges, tag.EdgeBlogs)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *BlogMutation) RemovedIDs(name string) []ent.Value {
+func (m *TagMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tag.EdgeBlogs:
+		ids := make([]ent.Value, 0, len(m.removedblogs))
+		for id := range m.removedblogs {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *BlogMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *TagMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedblogs {
+		edges = append(edges, tag.EdgeBlogs)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *BlogMutation) EdgeCleared(name string) bool {
+func (m *TagMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tag.EdgeBlogs:
+		return m.clearedblogs
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *BlogMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Blog unique edge %s", name)
+func (m *TagMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Tag unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *BlogMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Blog edge %s", name)
+func (m *TagMutation) ResetEdge(name string) error {
+	switch name {
+	case tag.EdgeBlogs:
+		m.ResetBlogs()
+		return nil
+	}
+	return fmt.Errorf("unknown Tag edge %s", name)
 }
 
 // UserMutation represents an operation that mutates the User nodes in the graph.
@@ -562,8 +6670,15 @@ type UserMutation struct {
 	typ           string
 	id            *int
 	email         *string
+	display_name  *string
 	name          *string
+	bio           *string
+	avatar        *string
+	social_links  *map[string]string
 	clearedFields map[string]struct{}
+	posts         map[int]struct{}
+	removedposts  map[int]struct{}
+	clearedposts  bool
 	done          bool
 	oldValue      func(context.Context) (*User, error)
 	predicates    []predicate.User
@@ -703,6 +6818,42 @@ func (m *UserMutation) ResetEmail() {
 	m.email = nil
 }
 
+// SetDisplayName sets the "display_name" field.
+func (m *UserMutation) SetDisplayName(s string) {
+	m.display_name = &s
+}
+
+// DisplayName returns the value of the "display_name" field in the mutation.
+func (m *UserMutation) DisplayName() (r string, exists bool) {
+	v := m.display_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDisplayName returns the old "display_name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldDisplayName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDisplayName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDisplayName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDisplayName: %w", err)
+	}
+	return oldValue.DisplayName, nil
+}
+
+// ResetDisplayName resets all changes to the "display_name" field.
+func (m *UserMutation) ResetDisplayName() {
+	m.display_name = nil
+}
+
 // SetName sets the "name" field.
 func (m *UserMutation) SetName(s string) {
 	m.name = &s
@@ -752,6 +6903,207 @@ func (m *UserMutation) ResetName() {
 	delete(m.clearedFields, user.FieldName)
 }
 
+// SetBio sets the "bio" field.
+func (m *UserMutation) SetBio(s string) {
+	m.bio = &s
+}
+
+// Bio returns the value of the "bio" field in the mutation.
+func (m *UserMutation) Bio() (r string, exists bool) {
+	v := m.bio
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBio returns the old "bio" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldBio(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBio is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBio requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBio: %w", err)
+	}
+	return oldValue.Bio, nil
+}
+
+// ClearBio clears the value of the "bio" field.
+func (m *UserMutation) ClearBio() {
+	m.bio = nil
+	m.clearedFields[user.FieldBio] = struct{}{}
+}
+
+// BioCleared returns if the "bio" field was cleared in this mutation.
+func (m *UserMutation) BioCleared() bool {
+	_, ok := m.clearedFields[user.FieldBio]
+	return ok
+}
+
+// ResetBio resets all changes to the "bio" field.
+func (m *UserMutation) ResetBio() {
+	m.bio = nil
+	delete(m.clearedFields, user.FieldBio)
+}
+
+// SetAvatar sets the "avatar" field.
+func (m *UserMutation) SetAvatar(s string) {
+	m.avatar = &s
+}
+
+// Avatar returns the value of the "avatar" field in the mutation.
+func (m *UserMutation) Avatar() (r string, exists bool) {
+	v := m.avatar
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvatar returns the old "avatar" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAvatar(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatar is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatar requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatar: %w", err)
+	}
+	return oldValue.Avatar, nil
+}
+
+// ClearAvatar clears the value of the "avatar" field.
+func (m *UserMutation) ClearAvatar() {
+	m.avatar = nil
+	m.clearedFields[user.FieldAvatar] = struct{}{}
+}
+
+// AvatarCleared returns if the "avatar" field was cleared in this mutation.
+func (m *UserMutation) AvatarCleared() bool {
+	_, ok := m.clearedFields[user.FieldAvatar]
+	return ok
+}
+
+// ResetAvatar resets all changes to the "avatar" field.
+func (m *UserMutation) ResetAvatar() {
+	m.avatar = nil
+	delete(m.clearedFields, user.FieldAvatar)
+}
+
+// SetSocialLinks sets the "social_links" field.
+func (m *UserMutation) SetSocialLinks(value map[string]string) {
+	m.social_links = &value
+}
+
+// SocialLinks returns the value of the "social_links" field in the mutation.
+func (m *UserMutation) SocialLinks() (r map[string]string, exists bool) {
+	v := m.social_links
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSocialLinks returns the old "social_links" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldSocialLinks(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSocialLinks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSocialLinks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSocialLinks: %w", err)
+	}
+	return oldValue.SocialLinks, nil
+}
+
+// ClearSocialLinks clears the value of the "social_links" field.
+func (m *UserMutation) ClearSocialLinks() {
+	m.social_links = nil
+	m.clearedFields[user.FieldSocialLinks] = struct{}{}
+}
+
+// SocialLinksCleared returns if the "social_links" field was cleared in this mutation.
+func (m *UserMutation) SocialLinksCleared() bool {
+	_, ok := m.clearedFields[user.FieldSocialLinks]
+	return ok
+}
+
+// ResetSocialLinks resets all changes to the "social_links" field.
+func (m *UserMutation) ResetSocialLinks() {
+	m.social_links = nil
+	delete(m.clearedFields, user.FieldSocialLinks)
+}
+
+// AddPostIDs adds the "posts" edge to the Blog entity by ids.
+func (m *UserMutation) AddPostIDs(ids ...int) {
+	if m.posts == nil {
+		m.posts = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.posts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPosts clears the "posts" edge to the Blog entity.
+func (m *UserMutation) ClearPosts() {
+	m.clearedposts = true
+}
+
+// PostsCleared reports if the "posts" edge to the Blog entity was cleared.
+func (m *UserMutation) PostsCleared() bool {
+	return m.clearedposts
+}
+
+// RemovePostIDs removes the "posts" edge to the Blog entity by IDs.
+func (m *UserMutation) RemovePostIDs(ids ...int) {
+	if m.removedposts == nil {
+		m.removedposts = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.posts, ids[i])
+		m.removedposts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPosts returns the removed IDs of the "posts" edge to the Blog entity.
+func (m *UserMutation) RemovedPostsIDs() (ids []int) {
+	for id := range m.removedposts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PostsIDs returns the "posts" edge IDs in the mutation.
+func (m *UserMutation) PostsIDs() (ids []int) {
+	for id := range m.posts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPosts resets all changes to the "posts" edge.
+func (m *UserMutation) ResetPosts() {
+	m.posts = nil
+	m.clearedposts = false
+	m.removedposts = nil
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -786,13 +7138,25 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 2)
+	fields := make([]string, 0, 6)
 	if m.email != nil {
 		fields = append(fields, user.FieldEmail)
 	}
+	if m.display_name != nil {
+		fields = append(fields, user.FieldDisplayName)
+	}
 	if m.name != nil {
 		fields = append(fields, user.FieldName)
 	}
+	if m.bio != nil {
+		fields = append(fields, user.FieldBio)
+	}
+	if m.avatar != nil {
+		fields = append(fields, user.FieldAvatar)
+	}
+	if m.social_links != nil {
+		fields = append(fields, user.FieldSocialLinks)
+	}
 	return fields
 }
 
@@ -803,8 +7167,16 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
 	case user.FieldEmail:
 		return m.Email()
+	case user.FieldDisplayName:
+		return m.DisplayName()
 	case user.FieldName:
 		return m.Name()
+	case user.FieldBio:
+		return m.Bio()
+	case user.FieldAvatar:
+		return m.Avatar()
+	case user.FieldSocialLinks:
+		return m.SocialLinks()
 	}
 	return nil, false
 }
@@ -816,8 +7188,16 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 	switch name {
 	case user.FieldEmail:
 		return m.OldEmail(ctx)
+	case user.FieldDisplayName:
+		return m.OldDisplayName(ctx)
 	case user.FieldName:
 		return m.OldName(ctx)
+	case user.FieldBio:
+		return m.OldBio(ctx)
+	case user.FieldAvatar:
+		return m.OldAvatar(ctx)
+	case user.FieldSocialLinks:
+		return m.OldSocialLinks(ctx)
 	}
 	return nil, fmt.Errorf("unknown User field %s", name)
 }
@@ -834,6 +7214,13 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetEmail(v)
 		return nil
+	case user.FieldDisplayName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDisplayName(v)
+		return nil
 	case user.FieldName:
 		v, ok := value.(string)
 		if !ok {
@@ -841,6 +7228,27 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetName(v)
 		return nil
+	case user.FieldBio:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBio(v)
+		return nil
+	case user.FieldAvatar:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvatar(v)
+		return nil
+	case user.FieldSocialLinks:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSocialLinks(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -874,6 +7282,15 @@ func (m *UserMutation) ClearedFields() []string {
 	if m.FieldCleared(user.FieldName) {
 		fields = append(fields, user.FieldName)
 	}
+	if m.FieldCleared(user.FieldBio) {
+		fields = append(fields, user.FieldBio)
+	}
+	if m.FieldCleared(user.FieldAvatar) {
+		fields = append(fields, user.FieldAvatar)
+	}
+	if m.FieldCleared(user.FieldSocialLinks) {
+		fields = append(fields, user.FieldSocialLinks)
+	}
 	return fields
 }
 
@@ -891,6 +7308,15 @@ func (m *UserMutation) ClearField(name string) error {
 	case user.FieldName:
 		m.ClearName()
 		return nil
+	case user.FieldBio:
+		m.ClearBio()
+		return nil
+	case user.FieldAvatar:
+		m.ClearAvatar()
+		return nil
+	case user.FieldSocialLinks:
+		m.ClearSocialLinks()
+		return nil
 	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
@@ -902,57 +7328,105 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldEmail:
 		m.ResetEmail()
 		return nil
+	case user.FieldDisplayName:
+		m.ResetDisplayName()
+		return nil
 	case user.FieldName:
 		m.ResetName()
 		return nil
+	case user.FieldBio:
+		m.ResetBio()
+		return nil
+	case user.FieldAvatar:
+		m.ResetAvatar()
+		return nil
+	case user.FieldSocialLinks:
+		m.ResetSocialLinks()
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 1)
+	if m.posts != nil {
+		edges = append(edges, user.EdgePosts)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
 func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgePosts:
+		ids := make([]ent.Value, 0, len(m.posts))
+		for id := range m.posts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 1)
+	if m.removedposts != nil {
+		edges = append(edges, user.EdgePosts)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
 func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgePosts:
+		ids := make([]ent.Value, 0, len(m.removedposts))
+		for id := range m.removedposts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 1)
+	if m.clearedposts {
+		edges = append(edges, user.EdgePosts)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
 func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgePosts:
+		return m.clearedposts
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
 func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	}
 	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
 func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgePosts:
+		m.ResetPosts()
+		return nil
+	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
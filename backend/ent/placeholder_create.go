@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"landing/backend/ent/placeholder"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PlaceholderCreate is the builder for creating a Placeholder entity.
+type PlaceholderCreate struct {
+	config
+	mutation *PlaceholderMutation
+	hooks    []Hook
+}
+
+// SetName sets the "name" field.
+func (_c *PlaceholderCreate) SetName(v string) *PlaceholderCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *PlaceholderCreate) SetValue(v string) *PlaceholderCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *PlaceholderCreate) SetDescription(v string) *PlaceholderCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *PlaceholderCreate) SetNillableDescription(v *string) *PlaceholderCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// Mutation returns the PlaceholderMutation object of the builder.
+func (_c *PlaceholderCreate) Mutation() *PlaceholderMutation {
+	return _c.mutation
+}
+
+// Save creates the Placeholder in the database.
+func (_c *PlaceholderCreate) Save(ctx context.Context) (*Placeholder, error) {
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PlaceholderCreate) SaveX(ctx context.Context) *Placeholder {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlaceholderCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlaceholderCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PlaceholderCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Placeholder.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := placeholder.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Placeholder.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "Placeholder.value"`)}
+	}
+	return nil
+}
+
+func (_c *PlaceholderCreate) sqlSave(ctx context.Context) (*Placeholder, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PlaceholderCreate) createSpec() (*Placeholder, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Placeholder{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(placeholder.Table, sqlgraph.NewFieldSpec(placeholder.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(placeholder.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(placeholder.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(placeholder.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	return _node, _spec
+}
+
+// PlaceholderCreateBulk is the builder for creating many Placeholder entities in bulk.
+type PlaceholderCreateBulk struct {
+	config
+	err      error
+	builders []*PlaceholderCreate
+}
+
+// Save creates the Placeholder entities in the database.
+func (_c *PlaceholderCreateBulk) Save(ctx context.Context) ([]*Placeholder, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Placeholder, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PlaceholderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PlaceholderCreateBulk) SaveX(ctx context.Context) []*Placeholder {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PlaceholderCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PlaceholderCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
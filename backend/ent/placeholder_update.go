@@ -0,0 +1,321 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"landing/backend/ent/placeholder"
+	"landing/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PlaceholderUpdate is the builder for updating Placeholder entities.
+type PlaceholderUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PlaceholderMutation
+}
+
+// Where appends a list predicates to the PlaceholderUpdate builder.
+func (_u *PlaceholderUpdate) Where(ps ...predicate.Placeholder) *PlaceholderUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *PlaceholderUpdate) SetName(v string) *PlaceholderUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PlaceholderUpdate) SetNillableName(v *string) *PlaceholderUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *PlaceholderUpdate) SetValue(v string) *PlaceholderUpdate {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *PlaceholderUpdate) SetNillableValue(v *string) *PlaceholderUpdate {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *PlaceholderUpdate) SetDescription(v string) *PlaceholderUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *PlaceholderUpdate) SetNillableDescription(v *string) *PlaceholderUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *PlaceholderUpdate) ClearDescription() *PlaceholderUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// Mutation returns the PlaceholderMutation object of the builder.
+func (_u *PlaceholderUpdate) Mutation() *PlaceholderMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PlaceholderUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlaceholderUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PlaceholderUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlaceholderUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlaceholderUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := placeholder.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Placeholder.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PlaceholderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(placeholder.Table, placeholder.Columns, sqlgraph.NewFieldSpec(placeholder.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(placeholder.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(placeholder.FieldValue, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(placeholder.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(placeholder.FieldDescription, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{placeholder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PlaceholderUpdateOne is the builder for updating a single Placeholder entity.
+type PlaceholderUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PlaceholderMutation
+}
+
+// SetName sets the "name" field.
+func (_u *PlaceholderUpdateOne) SetName(v string) *PlaceholderUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *PlaceholderUpdateOne) SetNillableName(v *string) *PlaceholderUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *PlaceholderUpdateOne) SetValue(v string) *PlaceholderUpdateOne {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *PlaceholderUpdateOne) SetNillableValue(v *string) *PlaceholderUpdateOne {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *PlaceholderUpdateOne) SetDescription(v string) *PlaceholderUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *PlaceholderUpdateOne) SetNillableDescription(v *string) *PlaceholderUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *PlaceholderUpdateOne) ClearDescription() *PlaceholderUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// Mutation returns the PlaceholderMutation object of the builder.
+func (_u *PlaceholderUpdateOne) Mutation() *PlaceholderMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PlaceholderUpdate builder.
+func (_u *PlaceholderUpdateOne) Where(ps ...predicate.Placeholder) *PlaceholderUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PlaceholderUpdateOne) Select(field string, fields ...string) *PlaceholderUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Placeholder entity.
+func (_u *PlaceholderUpdateOne) Save(ctx context.Context) (*Placeholder, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PlaceholderUpdateOne) SaveX(ctx context.Context) *Placeholder {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PlaceholderUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PlaceholderUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PlaceholderUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := placeholder.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Placeholder.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PlaceholderUpdateOne) sqlSave(ctx context.Context) (_node *Placeholder, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(placeholder.Table, placeholder.Columns, sqlgraph.NewFieldSpec(placeholder.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Placeholder.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, placeholder.FieldID)
+		for _, f := range fields {
+			if !placeholder.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != placeholder.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(placeholder.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(placeholder.FieldValue, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(placeholder.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(placeholder.FieldDescription, field.TypeString)
+	}
+	_node = &Placeholder{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{placeholder.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
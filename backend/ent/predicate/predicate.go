@@ -9,5 +9,23 @@ import (
 // Blog is the predicate function for blog builders.
 type Blog func(*sql.Selector)
 
+// BlogChunk is the predicate function for blogchunk builders.
+type BlogChunk func(*sql.Selector)
+
+// CTABlock is the predicate function for ctablock builders.
+type CTABlock func(*sql.Selector)
+
+// Category is the predicate function for category builders.
+type Category func(*sql.Selector)
+
+// Media is the predicate function for media builders.
+type Media func(*sql.Selector)
+
+// Placeholder is the predicate function for placeholder builders.
+type Placeholder func(*sql.Selector)
+
+// Tag is the predicate function for tag builders.
+type Tag func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
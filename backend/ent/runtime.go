@@ -4,7 +4,15 @@ package ent
 
 import (
 	"landing/backend/ent/blog"
+	"landing/backend/ent/blogchunk"
+	"landing/backend/ent/category"
+	"landing/backend/ent/ctablock"
+	"landing/backend/ent/media"
+	"landing/backend/ent/placeholder"
 	"landing/backend/ent/schema"
+	"landing/backend/ent/tag"
+	"landing/backend/ent/user"
+	"time"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -18,7 +26,127 @@ func init() {
 	// blog.CategoryValidator is a validator for the "category" field. It is called by the builders before save.
 	blog.CategoryValidator = blogDescCategory.Validators[0].(func(string) error)
 	// blogDescPath is the schema descriptor for path field.
-	blogDescPath := blogFields[2].Descriptor()
+	blogDescPath := blogFields[4].Descriptor()
 	// blog.PathValidator is a validator for the "path" field. It is called by the builders before save.
 	blog.PathValidator = blogDescPath.Validators[0].(func(string) error)
+	// blogDescWordCount is the schema descriptor for word_count field.
+	blogDescWordCount := blogFields[11].Descriptor()
+	// blog.DefaultWordCount holds the default value on creation for the word_count field.
+	blog.DefaultWordCount = blogDescWordCount.Default.(int)
+	// blog.WordCountValidator is a validator for the "word_count" field. It is called by the builders before save.
+	blog.WordCountValidator = blogDescWordCount.Validators[0].(func(int) error)
+	// blogDescCharCount is the schema descriptor for char_count field.
+	blogDescCharCount := blogFields[12].Descriptor()
+	// blog.DefaultCharCount holds the default value on creation for the char_count field.
+	blog.DefaultCharCount = blogDescCharCount.Default.(int)
+	// blog.CharCountValidator is a validator for the "char_count" field. It is called by the builders before save.
+	blog.CharCountValidator = blogDescCharCount.Validators[0].(func(int) error)
+	// blogDescReadingMinutes is the schema descriptor for reading_minutes field.
+	blogDescReadingMinutes := blogFields[13].Descriptor()
+	// blog.DefaultReadingMinutes holds the default value on creation for the reading_minutes field.
+	blog.DefaultReadingMinutes = blogDescReadingMinutes.Default.(int)
+	// blog.ReadingMinutesValidator is a validator for the "reading_minutes" field. It is called by the builders before save.
+	blog.ReadingMinutesValidator = blogDescReadingMinutes.Validators[0].(func(int) error)
+	// blogDescExcerptCustom is the schema descriptor for excerpt_custom field.
+	blogDescExcerptCustom := blogFields[16].Descriptor()
+	// blog.DefaultExcerptCustom holds the default value on creation for the excerpt_custom field.
+	blog.DefaultExcerptCustom = blogDescExcerptCustom.Default.(bool)
+	// blogDescMetaDescriptionCustom is the schema descriptor for meta_description_custom field.
+	blogDescMetaDescriptionCustom := blogFields[17].Descriptor()
+	// blog.DefaultMetaDescriptionCustom holds the default value on creation for the meta_description_custom field.
+	blog.DefaultMetaDescriptionCustom = blogDescMetaDescriptionCustom.Default.(bool)
+	// blogDescDisableCta is the schema descriptor for disable_cta field.
+	blogDescDisableCta := blogFields[19].Descriptor()
+	// blog.DefaultDisableCta holds the default value on creation for the disable_cta field.
+	blog.DefaultDisableCta = blogDescDisableCta.Default.(bool)
+	// blogDescCreatedAt is the schema descriptor for created_at field.
+	blogDescCreatedAt := blogFields[20].Descriptor()
+	// blog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	blog.DefaultCreatedAt = blogDescCreatedAt.Default.(func() time.Time)
+	// blogDescUpdatedAt is the schema descriptor for updated_at field.
+	blogDescUpdatedAt := blogFields[21].Descriptor()
+	// blog.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	blog.DefaultUpdatedAt = blogDescUpdatedAt.Default.(func() time.Time)
+	// blog.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	blog.UpdateDefaultUpdatedAt = blogDescUpdatedAt.UpdateDefault.(func() time.Time)
+	blogchunkFields := schema.BlogChunk{}.Fields()
+	_ = blogchunkFields
+	// blogchunkDescPosition is the schema descriptor for position field.
+	blogchunkDescPosition := blogchunkFields[1].Descriptor()
+	// blogchunk.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	blogchunk.PositionValidator = blogchunkDescPosition.Validators[0].(func(int) error)
+	ctablockFields := schema.CTABlock{}.Fields()
+	_ = ctablockFields
+	// ctablockDescName is the schema descriptor for name field.
+	ctablockDescName := ctablockFields[0].Descriptor()
+	// ctablock.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	ctablock.NameValidator = ctablockDescName.Validators[0].(func(string) error)
+	// ctablockDescParagraph is the schema descriptor for paragraph field.
+	ctablockDescParagraph := ctablockFields[6].Descriptor()
+	// ctablock.DefaultParagraph holds the default value on creation for the paragraph field.
+	ctablock.DefaultParagraph = ctablockDescParagraph.Default.(int)
+	// ctablock.ParagraphValidator is a validator for the "paragraph" field. It is called by the builders before save.
+	ctablock.ParagraphValidator = ctablockDescParagraph.Validators[0].(func(int) error)
+	// ctablockDescActive is the schema descriptor for active field.
+	ctablockDescActive := ctablockFields[7].Descriptor()
+	// ctablock.DefaultActive holds the default value on creation for the active field.
+	ctablock.DefaultActive = ctablockDescActive.Default.(bool)
+	categoryFields := schema.Category{}.Fields()
+	_ = categoryFields
+	// categoryDescSlug is the schema descriptor for slug field.
+	categoryDescSlug := categoryFields[0].Descriptor()
+	// category.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	category.SlugValidator = categoryDescSlug.Validators[0].(func(string) error)
+	mediaFields := schema.Media{}.Fields()
+	_ = mediaFields
+	// mediaDescKey is the schema descriptor for key field.
+	mediaDescKey := mediaFields[0].Descriptor()
+	// media.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	media.KeyValidator = mediaDescKey.Validators[0].(func(string) error)
+	// mediaDescURL is the schema descriptor for url field.
+	mediaDescURL := mediaFields[1].Descriptor()
+	// media.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	media.URLValidator = mediaDescURL.Validators[0].(func(string) error)
+	// mediaDescContentType is the schema descriptor for content_type field.
+	mediaDescContentType := mediaFields[2].Descriptor()
+	// media.ContentTypeValidator is a validator for the "content_type" field. It is called by the builders before save.
+	media.ContentTypeValidator = mediaDescContentType.Validators[0].(func(string) error)
+	// mediaDescSize is the schema descriptor for size field.
+	mediaDescSize := mediaFields[3].Descriptor()
+	// media.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	media.SizeValidator = mediaDescSize.Validators[0].(func(int64) error)
+	// mediaDescWidth is the schema descriptor for width field.
+	mediaDescWidth := mediaFields[4].Descriptor()
+	// media.WidthValidator is a validator for the "width" field. It is called by the builders before save.
+	media.WidthValidator = mediaDescWidth.Validators[0].(func(int) error)
+	// mediaDescHeight is the schema descriptor for height field.
+	mediaDescHeight := mediaFields[5].Descriptor()
+	// media.HeightValidator is a validator for the "height" field. It is called by the builders before save.
+	media.HeightValidator = mediaDescHeight.Validators[0].(func(int) error)
+	// mediaDescCreatedAt is the schema descriptor for created_at field.
+	mediaDescCreatedAt := mediaFields[8].Descriptor()
+	// media.DefaultCreatedAt holds the default value on creation for the created_at field.
+	media.DefaultCreatedAt = mediaDescCreatedAt.Default.(func() time.Time)
+	placeholderFields := schema.Placeholder{}.Fields()
+	_ = placeholderFields
+	// placeholderDescName is the schema descriptor for name field.
+	placeholderDescName := placeholderFields[0].Descriptor()
+	// placeholder.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	placeholder.NameValidator = placeholderDescName.Validators[0].(func(string) error)
+	tagFields := schema.Tag{}.Fields()
+	_ = tagFields
+	// tagDescSlug is the schema descriptor for slug field.
+	tagDescSlug := tagFields[0].Descriptor()
+	// tag.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
+	tag.SlugValidator = tagDescSlug.Validators[0].(func(string) error)
+	// tagDescName is the schema descriptor for name field.
+	tagDescName := tagFields[1].Descriptor()
+	// tag.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tag.NameValidator = tagDescName.Validators[0].(func(string) error)
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescDisplayName is the schema descriptor for display_name field.
+	userDescDisplayName := userFields[1].Descriptor()
+	// user.DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
+	user.DisplayNameValidator = userDescDisplayName.Validators[0].(func(string) error)
 }
@@ -1,7 +1,11 @@
 package schema
 
 import (
+	"time"
+
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
 
@@ -12,13 +16,71 @@ type Blog struct{ ent.Schema }
 func (Blog) Fields() []ent.Field {
 	return []ent.Field{
 		field.String("category").NotEmpty(),
+		// Text is the published HTML produced by the publishing pipeline.
 		field.Text("text"),
+		// Format is the authoring format; for markdown posts Source keeps the
+		// Markdown so it can be edited later.
+		field.Enum("format").Values("html", "markdown").Default("html"),
+		field.Text("source").Optional(),
 		field.String("path").NotEmpty().Unique(),
 		// Embedding stores a vector representation for similarity search (offline-generated).
         // Note: Nillable() is not supported for JSON in this Ent version; Optional() suffices.
         field.JSON("embedding", []float32{}).Optional(),
+		// EmbeddingHash is embeddings.ContentHash of the text the embedding was
+		// generated from; a matching hash means the embedding is current.
+		field.String("embedding_hash").Optional(),
+		// SchemaJSON is the generated JSON-LD (@graph of BlogPosting, BreadcrumbList, Organization).
+		field.Text("schema_json").Optional(),
+		// CategoryID links the blog to its Category entity. The "category" string above
+		// is kept in sync with the category slug for API compatibility.
+		field.Int("category_id").Optional().Nillable(),
+		field.Int("author_id").Optional().Nillable(),
+		field.Int("featured_image_id").Optional().Nillable(),
+		// Length of the published body, computed on save (see content.Measure).
+		field.Int("word_count").Default(0).NonNegative(),
+		field.Int("char_count").Default(0).NonNegative(),
+		field.Int("reading_minutes").Default(0).NonNegative(),
+		// Excerpt and MetaDescription are generated from the body on save unless
+		// the author supplied them (the *_custom flags record which).
+		field.Text("excerpt").Optional(),
+		field.String("meta_description").Optional(),
+		field.Bool("excerpt_custom").Default(false),
+		field.Bool("meta_description_custom").Default(false),
+		// Keywords are the TF-IDF terms of the body, extracted on save (see
+		// nlp.Keywords); they pre-fill {KEYWORDS} for posts without tags.
+		field.Strings("keywords").Optional(),
+		// DisableCTA opts the post out of automatic call-to-action blocks.
+		field.Bool("disable_cta").Default(false),
+		// Timestamps; the SQL defaults let the migration add them to existing rows.
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Annotations(entsql.Default("CURRENT_TIMESTAMP")),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Annotations(entsql.Default("CURRENT_TIMESTAMP")),
 	}
 }
 
 // Edges of the Blog.
-func (Blog) Edges() []ent.Edge { return nil }
+func (Blog) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("primary_category", Category.Type).
+			Ref("blogs").
+			Field("category_id").
+			Unique(),
+		edge.To("tags", Tag.Type),
+		edge.From("author", User.Type).
+			Ref("posts").
+			Field("author_id").
+			Unique(),
+		edge.From("featured_image", Media.Type).
+			Ref("featured_in").
+			Field("featured_image_id").
+			Unique(),
+		// Chunks are derived from the text and go with the post.
+		edge.To("chunks", BlogChunk.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
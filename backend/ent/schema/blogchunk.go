@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// BlogChunk holds a passage of a blog post (see content.Passages) and its
+// embedding, for passage-level similarity and retrieval. Chunks are rebuilt
+// by a Blog hook whenever the post text changes (see db.RegisterChunkHooks).
+type BlogChunk struct{ ent.Schema }
+
+// Fields of the BlogChunk.
+func (BlogChunk) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("blog_id"),
+		// Position is the chunk's order within the post, from 0.
+		field.Int("position").NonNegative(),
+		// Heading is the nearest heading above the passage.
+		field.String("heading").Optional(),
+		field.Text("text"),
+		field.JSON("embedding", []float32{}).Optional(),
+		// EmbeddingHash is embeddings.ContentHash of the embedded text, so
+		// unchanged passages keep their vectors when a post is edited.
+		field.String("embedding_hash").Optional(),
+	}
+}
+
+// Edges of the BlogChunk.
+func (BlogChunk) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("blog", Blog.Type).
+			Ref("chunks").
+			Field("blog_id").
+			Unique().
+			Required(),
+	}
+}
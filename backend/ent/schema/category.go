@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// Category holds the schema definition for the Category entity.
+type Category struct{ ent.Schema }
+
+// Fields of the Category.
+func (Category) Fields() []ent.Field {
+	return []ent.Field{
+		// Slug is the normalized, URL-safe identifier (e.g. "ai"). Blogs reference it.
+		field.String("slug").NotEmpty().Unique(),
+		// Name holds display names keyed by locale, e.g. {"fa": "هوش مصنوعی", "en": "AI"}.
+		field.JSON("name", map[string]string{}).Optional(),
+		field.Text("description").Optional(),
+		field.Int("parent_id").Optional().Nillable(),
+	}
+}
+
+// Edges of the Category.
+func (Category) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("children", Category.Type).
+			From("parent").
+			Field("parent_id").
+			Unique(),
+		edge.To("blogs", Blog.Type),
+		edge.To("cta_blocks", CTABlock.Type),
+	}
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// CTABlock holds the schema definition for call-to-action blocks appended to posts.
+// Blocks without a category apply to posts in categories that have none of their own.
+type CTABlock struct{ ent.Schema }
+
+// Fields of the CTABlock.
+func (CTABlock) Fields() []ent.Field {
+	return []ent.Field{
+		// Name identifies the variant for editors; it is not rendered.
+		field.String("name").NotEmpty(),
+		field.String("heading").Optional(),
+		field.Text("body").Optional(),
+		field.String("button_text").Optional(),
+		field.String("button_url").Optional(),
+		field.Enum("placement").Values("end", "after_paragraph").Default("end"),
+		// Paragraph is N for after_paragraph placement.
+		field.Int("paragraph").Default(3).NonNegative(),
+		field.Bool("active").Default(true),
+		field.Int("category_id").Optional().Nillable(),
+	}
+}
+
+// Edges of the CTABlock.
+func (CTABlock) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("category", Category.Type).
+			Ref("cta_blocks").
+			Field("category_id").
+			Unique(),
+	}
+}
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+
+	"landing/backend/internal/imaging"
+)
+
+// Media holds the schema definition for an uploaded image.
+type Media struct{ ent.Schema }
+
+// Fields of the Media.
+func (Media) Fields() []ent.Field {
+	return []ent.Field{
+		// Key is the storage object key, e.g. "2025/08/3f2a...c1.jpg".
+		field.String("key").NotEmpty().Unique(),
+		field.String("url").NotEmpty(),
+		field.String("content_type").NotEmpty(),
+		field.Int64("size").NonNegative(),
+		field.Int("width").NonNegative(),
+		field.Int("height").NonNegative(),
+		field.String("alt").Optional(),
+		// Variants lists the resized renditions generated at upload time.
+		field.JSON("variants", []imaging.Variant{}).Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+// Edges of the Media.
+func (Media) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("featured_in", Blog.Type),
+	}
+}
@@ -0,0 +1,21 @@
+package schema
+
+import (
+	"regexp"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// Placeholder holds the schema definition for site-defined placeholder variables.
+// Authors write them as {NAME} in post HTML alongside the built-in placeholders.
+type Placeholder struct{ ent.Schema }
+
+// Fields of the Placeholder.
+func (Placeholder) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").Unique().Match(regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)),
+		field.Text("value"),
+		field.String("description").Optional(),
+	}
+}
@@ -2,23 +2,38 @@ package schema
 
 import (
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
 
 // User holds the schema definition for the User entity.
+// Users double as author profiles for blog posts.
 type User struct{ ent.Schema }
 
 // Fields of the User.
 func (User) Fields() []ent.Field {
 	return []ent.Field{
-		field.String("email").Unique(),
-		field.String("name").Optional(),
+		// Email is used for identification only and never serialized in API responses.
+		field.String("email").Unique().Sensitive(),
+		// DisplayName has a column default only so the migration can add it to
+		// existing rows; db.BackfillDisplayNames fills those in from Name.
+		field.String("display_name").NotEmpty().
+			Annotations(entsql.DefaultExpr("''")),
+		// Name is the legacy name column, kept until every display name is backfilled.
+		field.String("name").Optional().StructTag(`json:"-"`).
+			Deprecated("use display_name"),
+		field.Text("bio").Optional(),
+		// Avatar is an image URL (absolute or site-relative).
+		field.String("avatar").Optional(),
+		// SocialLinks maps a network name to a profile URL, e.g. {"github": "https://github.com/x"}.
+		field.JSON("social_links", map[string]string{}).Optional(),
 	}
 }
 
 // Edges of the User.
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
-		// add edges as needed
+		edge.To("posts", Blog.Type),
 	}
 }
@@ -14,6 +14,18 @@ type Tx struct {
 	config
 	// Blog is the client for interacting with the Blog builders.
 	Blog *BlogClient
+	// BlogChunk is the client for interacting with the BlogChunk builders.
+	BlogChunk *BlogChunkClient
+	// CTABlock is the client for interacting with the CTABlock builders.
+	CTABlock *CTABlockClient
+	// Category is the client for interacting with the Category builders.
+	Category *CategoryClient
+	// Media is the client for interacting with the Media builders.
+	Media *MediaClient
+	// Placeholder is the client for interacting with the Placeholder builders.
+	Placeholder *PlaceholderClient
+	// Tag is the client for interacting with the Tag builders.
+	Tag *TagClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -148,6 +160,12 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Blog = NewBlogClient(tx.config)
+	tx.BlogChunk = NewBlogChunkClient(tx.config)
+	tx.CTABlock = NewCTABlockClient(tx.config)
+	tx.Category = NewCategoryClient(tx.config)
+	tx.Media = NewMediaClient(tx.config)
+	tx.Placeholder = NewPlaceholderClient(tx.config)
+	tx.Tag = NewTagClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -0,0 +1,120 @@
+package embeddings
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Pair labels two documents of a benchmark corpus as related or not.
+type Pair struct {
+	A       string `json:"a"`
+	B       string `json:"b"`
+	Related bool   `json:"related"`
+}
+
+// BenchResult summarizes how well a model separates related from unrelated pairs.
+type BenchResult struct {
+	// AUC is the probability that a random related pair scores higher than a
+	// random unrelated one (ties count half); 0.5 is chance.
+	AUC float64 `json:"auc"`
+	// MeanRelated and MeanUnrelated are the average cosine similarities.
+	MeanRelated   float64 `json:"mean_related"`
+	MeanUnrelated float64 `json:"mean_unrelated"`
+	// Precision1 is the share of documents whose nearest neighbour in the
+	// corpus is labeled related to them, among documents with a related pair.
+	Precision1 float64 `json:"precision_at_1"`
+}
+
+// Evaluate embeds every document of docs (keyed by ID) with embed and scores
+// the labeled pairs.
+func Evaluate(embed func(string) []float32, docs map[string]string, pairs []Pair) (BenchResult, error) {
+	vecs := make(map[string][]float32, len(docs))
+	for id, text := range docs {
+		vecs[id] = embed(text)
+	}
+	sim := func(a, b string) float64 {
+		va, vb := vecs[a], vecs[b]
+		if len(va) == 0 || len(va) != len(vb) {
+			return 0
+		}
+		var dot float64
+		for i := range va {
+			dot += float64(va[i]) * float64(vb[i])
+		}
+		return dot
+	}
+
+	var rel, unrel []float64
+	related := map[string]map[string]bool{}
+	for _, p := range pairs {
+		if _, ok := docs[p.A]; !ok {
+			return BenchResult{}, fmt.Errorf("pair references unknown document %q", p.A)
+		}
+		if _, ok := docs[p.B]; !ok {
+			return BenchResult{}, fmt.Errorf("pair references unknown document %q", p.B)
+		}
+		s := sim(p.A, p.B)
+		if !p.Related {
+			unrel = append(unrel, s)
+			continue
+		}
+		rel = append(rel, s)
+		for _, ab := range [][2]string{{p.A, p.B}, {p.B, p.A}} {
+			if related[ab[0]] == nil {
+				related[ab[0]] = map[string]bool{}
+			}
+			related[ab[0]][ab[1]] = true
+		}
+	}
+	if len(rel) == 0 || len(unrel) == 0 {
+		return BenchResult{}, fmt.Errorf("need both related and unrelated pairs")
+	}
+
+	res := BenchResult{AUC: auc(rel, unrel), MeanRelated: mean(rel), MeanUnrelated: mean(unrel)}
+	ids := make([]string, 0, len(docs))
+	for id := range docs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	hits := 0
+	for id, want := range related {
+		best, bestSim := "", math.Inf(-1)
+		for _, other := range ids {
+			if other == id {
+				continue
+			}
+			if s := sim(id, other); s > bestSim {
+				best, bestSim = other, s
+			}
+		}
+		if want[best] {
+			hits++
+		}
+	}
+	res.Precision1 = float64(hits) / float64(len(related))
+	return res, nil
+}
+
+func auc(pos, neg []float64) float64 {
+	var wins float64
+	for _, p := range pos {
+		for _, n := range neg {
+			switch {
+			case p > n:
+				wins++
+			case p == n:
+				wins += 0.5
+			}
+		}
+	}
+	return wins / float64(len(pos)*len(neg))
+}
+
+func mean(xs []float64) float64 {
+	var sum float64
+	for _, x := range xs {
+		sum += x
+	}
+	return sum / float64(len(xs))
+}
@@ -0,0 +1,163 @@
+package embeddings
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"landing/backend/internal/config"
+	"landing/backend/internal/metrics"
+)
+
+// DefaultCacheSize is the number of vectors DefaultCache holds unless
+// configured otherwise.
+const DefaultCacheSize = 2048
+
+// Version identifies the model configuration a vector was produced with;
+// vectors of different versions are not comparable. For v2 it includes the
+// generation of the corpus IDF set by SetIDF, so vectors weighed with other
+// corpus statistics neither hit the cache nor count as current.
+func Version(cfg config.Config) string {
+	return version(cfg, corpusIDF.Load())
+}
+
+func version(cfg config.Config, idf *IDF) string {
+	m := model(cfg)
+	if cfg.EmbeddingModel == ModelV1 || idf.Generation() == "" {
+		return m
+	}
+	return m + "/idf-" + idf.Generation()
+}
+
+// model names the embedder and vector size of cfg, e.g. "v2/512".
+func model(cfg config.Config) string {
+	if cfg.EmbeddingModel == ModelV1 {
+		return fmt.Sprintf("%s/%d", ModelV1, DimV1)
+	}
+	dim := cfg.EmbeddingDim
+	if dim <= 0 {
+		dim = DefaultDim
+	}
+	return fmt.Sprintf("%s/%d", ModelV2, dim)
+}
+
+// ContentHash returns the hex SHA-256 of the model version and text. A stored
+// vector whose hash matches is current and need not be regenerated. Callers
+// that persist a vector take the hash before embedding: should the IDF change
+// in between, the vector is then merely re-embedded later instead of being
+// kept under a hash it does not match.
+func ContentHash(cfg config.Config, text string) string {
+	return contentHash(version(cfg, corpusIDF.Load()), text)
+}
+
+func contentHash(version, text string) string {
+	h := sha256.New()
+	h.Write([]byte(version))
+	h.Write([]byte{0})
+	h.Write([]byte(text))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// CacheStats reports the effectiveness of a Cache.
+type CacheStats struct {
+	Hits    uint64 `json:"hits"`
+	Misses  uint64 `json:"misses"`
+	Entries int    `json:"entries"`
+	Size    int    `json:"size"`
+}
+
+// Cache memoizes GenerateEmbedding by ContentHash, evicting the least
+// recently used vectors beyond its size. Returned vectors are shared and must
+// not be modified.
+type Cache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List // of *cacheEntry, most recent first
+	entries map[string]*list.Element
+
+	hits, misses atomic.Uint64
+}
+
+type cacheEntry struct {
+	key string
+	vec []float32
+}
+
+// NewCache returns a cache holding up to size vectors (DefaultCacheSize if size <= 0).
+func NewCache(size int) *Cache {
+	if size <= 0 {
+		size = DefaultCacheSize
+	}
+	return &Cache{size: size, order: list.New(), entries: map[string]*list.Element{}}
+}
+
+// DefaultCache is the process-wide cache used by Embed.
+var DefaultCache = NewCache(DefaultCacheSize)
+
+// Embed returns the embedding of text from DefaultCache, generating it on a miss.
+func Embed(ctx context.Context, cfg config.Config, text string) ([]float32, error) {
+	return DefaultCache.Embed(ctx, cfg, text)
+}
+
+// Embed returns the cached embedding of text for the model of cfg, generating
+// and storing it on a miss. Errors and empty results are not cached.
+func (c *Cache) Embed(ctx context.Context, cfg config.Config, text string) ([]float32, error) {
+	// One IDF for both the key and the vector, in case SetIDF runs meanwhile.
+	idf := corpusIDF.Load()
+	key := contentHash(version(cfg, idf), text)
+	if vec, ok := c.get(key); ok {
+		c.hits.Add(1)
+		metrics.CountEmbeddingCache(true)
+		trace.SpanFromContext(ctx).AddEvent("embedding cache hit", trace.WithAttributes(attribute.String("embedding.hash", key)))
+		return vec, nil
+	}
+	c.misses.Add(1)
+	metrics.CountEmbeddingCache(false)
+	vec, err := generateEmbedding(ctx, cfg, idf, text)
+	if err != nil || len(vec) == 0 {
+		return vec, err
+	}
+	c.put(key, vec)
+	return vec, nil
+}
+
+// Stats returns the hit and miss counts since the cache was created.
+func (c *Cache) Stats() CacheStats {
+	c.mu.Lock()
+	n := c.order.Len()
+	c.mu.Unlock()
+	return CacheStats{Hits: c.hits.Load(), Misses: c.misses.Load(), Entries: n, Size: c.size}
+}
+
+func (c *Cache) get(key string) ([]float32, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(el)
+	return el.Value.(*cacheEntry).vec, true
+}
+
+func (c *Cache) put(key string, vec []float32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.entries[key]; ok {
+		c.order.MoveToFront(el)
+		return
+	}
+	c.entries[key] = c.order.PushFront(&cacheEntry{key: key, vec: vec})
+	for c.order.Len() > c.size {
+		last := c.order.Back()
+		c.order.Remove(last)
+		delete(c.entries, last.Value.(*cacheEntry).key)
+	}
+}
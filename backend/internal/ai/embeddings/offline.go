@@ -6,58 +6,74 @@ import (
     "hash/fnv"
     "math"
     "strings"
-    "unicode"
+    "time"
 
+    "go.opentelemetry.io/otel/attribute"
     "golang.org/x/net/html"
     "landing/backend/internal/config"
+    "landing/backend/internal/metrics"
+    "landing/backend/internal/textnorm"
+    "landing/backend/internal/tracing"
 )
 
-// GenerateEmbedding creates a simple offline embedding using the hashing trick.
+// GenerateEmbedding embeds input with the model selected by cfg.EmbeddingModel:
+// the v2 Encoder (default) with cfg.EmbeddingDim buckets and the corpus IDF
+// set by SetIDF, or the original v1 bag of words.
+func GenerateEmbedding(ctx context.Context, cfg config.Config, input string) ([]float32, error) {
+    return generateEmbedding(ctx, cfg, corpusIDF.Load(), input)
+}
+
+func generateEmbedding(ctx context.Context, cfg config.Config, idf *IDF, input string) ([]float32, error) {
+    defer func(start time.Time) { metrics.ObserveEmbedding(model(cfg), time.Since(start)) }(time.Now())
+    _, span := tracing.Tracer().Start(ctx, "embeddings.generate")
+    defer span.End()
+    var vec []float32
+    if cfg.EmbeddingModel == ModelV1 {
+        vec = EmbedV1(input)
+    } else {
+        vec = Encoder{Dim: cfg.EmbeddingDim, IDF: idf}.Embed(input)
+    }
+    span.SetAttributes(
+        attribute.String("embedding.model", version(cfg, idf)),
+        attribute.Int("embedding.input_bytes", len(input)),
+        attribute.Bool("embedding.empty", vec == nil),
+    )
+    return vec, nil
+}
+
+// EmbedV1 creates a simple offline embedding using the hashing trick.
 // Steps:
 // 1) Strip HTML to text
-// 2) Tokenize on unicode letter/digit boundaries, lowercase
+// 2) Normalize and tokenize with textnorm, dropping stopwords and stemming
 // 3) Hash tokens into a fixed-size bag-of-words vector (dimension D)
 // 4) L2-normalize the vector
 // Returns nil if no tokens are found.
-func GenerateEmbedding(_ context.Context, _ config.Config, input string) ([]float32, error) {
+func EmbedV1(input string) []float32 {
     text := strings.TrimSpace(stripHTML(input))
     if text == "" {
-        return nil, nil
+        return nil
     }
-    const D = 256 // embedding dimension
+    const D = DimV1 // embedding dimension
     vec := make([]float64, D)
 
-    // Simple tokenizer: accumulate letters/digits, split on others
-    var tok strings.Builder
-    flush := func() {
-        if tok.Len() == 0 { return }
-        t := strings.ToLower(tok.String())
-        // Hash token into [0, D)
-        idx := hashToBucket(t, D)
-        vec[idx] += 1.0
-        tok.Reset()
-    }
-    for _, r := range text {
-        if unicode.IsLetter(r) || unicode.IsDigit(r) {
-            tok.WriteRune(r)
-        } else {
-            flush()
-        }
+    // Normalized, stemmed content terms (see textnorm.Terms), so Arabic and
+    // Persian letter forms, half-spaces and plural suffixes hash alike.
+    for _, t := range textnorm.Terms(text) {
+        vec[hashToBucket(t, D)] += 1.0
     }
-    flush()
 
     // L2 normalize
     var norm float64
     for i := range vec { norm += vec[i] * vec[i] }
     norm = math.Sqrt(norm)
     if norm == 0 {
-        return nil, nil
+        return nil
     }
     out := make([]float32, D)
     for i := range vec {
         out[i] = float32(vec[i] / norm)
     }
-    return out, nil
+    return out
 }
 
 func stripHTML(in string) string {
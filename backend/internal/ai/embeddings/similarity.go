@@ -0,0 +1,70 @@
+package embeddings
+
+import "math"
+
+// Ways to combine the chunk scores of a post (config.SimilarityAggregate).
+const (
+	// AggregateMax scores a post by its best-matching chunk.
+	AggregateMax = "max"
+	// AggregateMean scores a post by the average of its chunk scores, which
+	// favors posts that are about the subject throughout.
+	AggregateMean = "mean"
+)
+
+// Cosine returns the cosine similarity of two equal-length vectors, or 0 when
+// the lengths differ (e.g. vectors of different models).
+func Cosine(a, b []float32) float64 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+	var dot, na, nb float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		na += float64(a[i]) * float64(a[i])
+		nb += float64(b[i]) * float64(b[i])
+	}
+	if na == 0 || nb == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(na) * math.Sqrt(nb))
+}
+
+// Aggregate combines scores with mode (AggregateMean, otherwise AggregateMax).
+func Aggregate(scores []float64, mode string) float64 {
+	if len(scores) == 0 {
+		return 0
+	}
+	if mode == AggregateMean {
+		var sum float64
+		for _, s := range scores {
+			sum += s
+		}
+		return sum / float64(len(scores))
+	}
+	best := scores[0]
+	for _, s := range scores[1:] {
+		best = max(best, s)
+	}
+	return best
+}
+
+// QuerySimilarity scores a post, given as its chunk vectors, against a query vector.
+func QuerySimilarity(query []float32, chunks [][]float32, mode string) float64 {
+	scores := make([]float64, len(chunks))
+	for i, c := range chunks {
+		scores[i] = Cosine(query, c)
+	}
+	return Aggregate(scores, mode)
+}
+
+// ChunkSimilarity compares two posts given as chunk vectors. Each chunk of a is
+// matched with its most similar chunk of b and the matches are aggregated, so
+// with AggregateMax it is the closest pair of passages and with AggregateMean
+// how much of a is covered by b.
+func ChunkSimilarity(a, b [][]float32, mode string) float64 {
+	scores := make([]float64, len(a))
+	for i, va := range a {
+		scores[i] = QuerySimilarity(va, b, AggregateMax)
+	}
+	return Aggregate(scores, mode)
+}
@@ -0,0 +1,198 @@
+package embeddings
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash/fnv"
+	"math"
+	"sort"
+	"strconv"
+	"sync/atomic"
+
+	"landing/backend/internal/content"
+	"landing/backend/internal/textnorm"
+)
+
+// Model names accepted by config.EmbeddingModel.
+const (
+	ModelV1 = "v1"
+	ModelV2 = "v2"
+)
+
+// DefaultDim is the v2 vector size used when none is configured.
+const DefaultDim = 512
+
+// DimV1 is the fixed vector size of the v1 model.
+const DimV1 = 256
+
+// Feature group weights of the v2 model. Words carry the topic; bigrams add
+// phrase context ("هوش مصنوعی" vs "هوش" and "مصنوعی"); character n-grams match
+// inflections the stemmer misses, so they count for less.
+const (
+	wordWeight   = 1.0
+	bigramWeight = 0.7
+	charWeight   = 0.25
+)
+
+// IDF holds document frequencies of v2 features over a corpus.
+type IDF struct {
+	docs int
+	df   map[string]int
+	gen  string
+}
+
+// NewIDF counts in how many of docs (HTML or plain text) each feature occurs.
+func NewIDF(docs []string) *IDF {
+	idf := &IDF{docs: len(docs), df: map[string]int{}}
+	for _, d := range docs {
+		for f := range features(content.PlainText(d)) {
+			idf.df[f]++
+		}
+	}
+	idf.gen = idf.hash()
+	return idf
+}
+
+// Generation identifies the statistics of idf: two IDFs with the same
+// generation weigh every feature the same. It is empty without a corpus.
+func (idf *IDF) Generation() string {
+	if idf == nil || idf.docs == 0 {
+		return ""
+	}
+	return idf.gen
+}
+
+// hash returns a short hex SHA-256 of the document count and the sorted
+// document frequencies.
+func (idf *IDF) hash() string {
+	feats := make([]string, 0, len(idf.df))
+	for f := range idf.df {
+		feats = append(feats, f)
+	}
+	sort.Strings(feats)
+	h := sha256.New()
+	h.Write([]byte(strconv.Itoa(idf.docs)))
+	for _, f := range feats {
+		h.Write([]byte{0})
+		h.Write([]byte(f))
+		h.Write([]byte{0})
+		h.Write([]byte(strconv.Itoa(idf.df[f])))
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+// Weight returns the smoothed inverse document frequency of feature; 1 without a corpus.
+func (idf *IDF) Weight(feature string) float64 {
+	if idf == nil || idf.docs == 0 {
+		return 1
+	}
+	return math.Log(float64(1+idf.docs)/float64(1+idf.df[feature])) + 1
+}
+
+// corpusIDF is the IDF used by GenerateEmbedding; see SetIDF.
+var corpusIDF atomic.Pointer[IDF]
+
+// SetIDF replaces the corpus statistics used by GenerateEmbedding. Until it is
+// called every feature weighs the same. A new generation changes Version, so
+// stored vectors count as stale until they are re-embedded (db.RefreshEmbeddings).
+func SetIDF(idf *IDF) { corpusIDF.Store(idf) }
+
+// Encoder is the v2 offline embedder: IDF-weighted words, word bigrams and
+// character n-grams, folded into Dim buckets with signed feature hashing so
+// that collisions cancel out on average instead of piling up.
+type Encoder struct {
+	Dim int
+	IDF *IDF
+}
+
+// Embed returns the L2-normalized vector of input (HTML or plain text), or nil
+// when it has no content words.
+func (e Encoder) Embed(input string) []float32 {
+	dim := e.Dim
+	if dim <= 0 {
+		dim = DefaultDim
+	}
+	feats := features(content.PlainText(input))
+	if len(feats) == 0 {
+		return nil
+	}
+	vec := make([]float64, dim)
+	for f, tf := range feats {
+		// ln(1+tf) dampens repetition and stays proportional for the
+		// fractional counts of character n-grams. The square root of the IDF
+		// keeps words unique to one post from crowding out shared ones; it
+		// separates related posts better than the plain IDF on cmd/embedbench.
+		w := math.Log1p(tf) * groupWeight(f) * math.Sqrt(e.IDF.Weight(f))
+		bucket, sign := signedHash(f, dim)
+		vec[bucket] += sign * w
+	}
+	var norm float64
+	for _, v := range vec {
+		norm += v * v
+	}
+	if norm == 0 {
+		return nil
+	}
+	norm = math.Sqrt(norm)
+	out := make([]float32, dim)
+	for i, v := range vec {
+		out[i] = float32(v / norm)
+	}
+	return out
+}
+
+// features counts the v2 features of plain text. Each feature is prefixed
+// with its group ("w:", "b:" or "c:") so a word never collides with an n-gram
+// spelled the same way. Character n-gram counts are spread over the word so
+// that long words do not outweigh short ones.
+func features(text string) map[string]float64 {
+	terms := textnorm.Terms(text)
+	out := map[string]float64{}
+	for i, t := range terms {
+		out["w:"+t]++
+		if i > 0 {
+			out["b:"+terms[i-1]+" "+t]++
+		}
+		grams := charNgrams(t, 3, 4)
+		for _, g := range grams {
+			out["c:"+g] += 1 / float64(len(grams))
+		}
+	}
+	return out
+}
+
+// charNgrams returns the rune n-grams of word padded with "<" and ">", for
+// every n from min to max.
+func charNgrams(word string, min, max int) []string {
+	r := []rune("<" + word + ">")
+	var out []string
+	for n := min; n <= max; n++ {
+		for i := 0; i+n <= len(r); i++ {
+			out = append(out, string(r[i:i+n]))
+		}
+	}
+	return out
+}
+
+func groupWeight(feature string) float64 {
+	switch feature[:2] {
+	case "b:":
+		return bigramWeight
+	case "c:":
+		return charWeight
+	}
+	return wordWeight
+}
+
+// signedHash maps feature to a bucket in [0, dim) and a sign taken from an
+// independent bit of the same 64-bit hash.
+func signedHash(feature string, dim int) (int, float64) {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(feature))
+	sum := h.Sum64()
+	sign := 1.0
+	if sum>>63 == 1 {
+		sign = -1
+	}
+	return int((sum & (1<<63 - 1)) % uint64(dim)), sign
+}
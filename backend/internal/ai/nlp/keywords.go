@@ -0,0 +1,120 @@
+package nlp
+
+import (
+	"math"
+	"sort"
+	"strings"
+)
+
+// Keyword is a term with its TF-IDF score.
+type Keyword struct {
+	Term  string  `json:"term"`
+	Score float64 `json:"score"`
+}
+
+// IDF holds document frequencies of a corpus.
+type IDF struct {
+	docs int
+	df   map[string]int
+}
+
+// NewIDF counts in how many of docs (plain texts) each term and two-word phrase occurs.
+func NewIDF(docs []string) *IDF {
+	idf := &IDF{docs: len(docs), df: map[string]int{}}
+	for _, d := range docs {
+		for t := range terms(d) {
+			idf.df[t]++
+		}
+	}
+	return idf
+}
+
+// Weight returns the smoothed inverse document frequency of term; 1 without a corpus.
+func (idf *IDF) Weight(term string) float64 {
+	if idf == nil || idf.docs == 0 {
+		return 1
+	}
+	return math.Log(float64(1+idf.docs)/float64(1+idf.df[term])) + 1
+}
+
+// PostKeywords is how many keywords are stored for each post.
+const PostKeywords = 8
+
+// minPhraseCount is how often a two-word phrase must occur to be a keyword candidate.
+const minPhraseCount = 2
+
+// Keywords returns up to k terms of text ranked by TF-IDF against idf (which
+// may be nil). Two-word phrases such as "هوش مصنوعی" compete with single words;
+// a word is dropped when a higher-ranked phrase already contains it.
+func Keywords(text string, idf *IDF, k int) []Keyword {
+	counts := terms(text)
+	total := 0
+	for t, n := range counts {
+		if !strings.Contains(t, " ") {
+			total += n
+		}
+	}
+	if total == 0 {
+		return []Keyword{}
+	}
+
+	ranked := make([]Keyword, 0, len(counts))
+	for t, n := range counts {
+		score := float64(n) / float64(total) * idf.Weight(t)
+		if strings.Contains(t, " ") {
+			if n < minPhraseCount {
+				continue
+			}
+			// A recurring phrase is more specific than either of its words.
+			score *= 2
+		}
+		ranked = append(ranked, Keyword{Term: t, Score: score})
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].Score != ranked[j].Score {
+			return ranked[i].Score > ranked[j].Score
+		}
+		return ranked[i].Term < ranked[j].Term
+	})
+
+	out := []Keyword{}
+	covered := map[string]bool{}
+	for _, kw := range ranked {
+		if len(out) == k {
+			break
+		}
+		if covered[kw.Term] {
+			continue
+		}
+		for _, w := range strings.Fields(kw.Term) {
+			covered[w] = true
+		}
+		kw.Score = math.Round(kw.Score*10000) / 10000
+		out = append(out, kw)
+	}
+	return out
+}
+
+// KeywordTerms returns the terms of the top k Keywords of text, never nil.
+func KeywordTerms(text string, idf *IDF, k int) []string {
+	kws := Keywords(text, idf, k)
+	out := make([]string, len(kws))
+	for i, kw := range kws {
+		out[i] = kw.Term
+	}
+	return out
+}
+
+// terms counts the content words and adjacent two-word phrases of text.
+func terms(text string) map[string]int {
+	counts := map[string]int{}
+	for _, seg := range segments(text) {
+		for i, w := range seg {
+			counts[w]++
+			if i > 0 {
+				counts[seg[i-1]+" "+w]++
+			}
+		}
+	}
+	return counts
+}
@@ -0,0 +1,115 @@
+package nlp
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"landing/backend/internal/content"
+	"landing/backend/internal/textnorm"
+)
+
+// TextRank parameters.
+const (
+	damping       = 0.85
+	maxIterations = 50
+	tolerance     = 1e-4
+)
+
+// Summarize picks the n most central sentences of text with TextRank and
+// returns them in their original order. Sentences are nodes of a graph whose
+// edges weigh the content words two sentences share, normalized by their
+// lengths; centrality is the PageRank of that graph.
+func Summarize(text string, n int) []string {
+	var sents []string
+	var toks [][]string
+	for _, line := range strings.Split(text, "\n") {
+		for _, s := range content.Sentences(line) {
+			if t := tokens(s); len(t) > 0 {
+				sents = append(sents, s)
+				toks = append(toks, t)
+			}
+		}
+	}
+	if n <= 0 || len(sents) == 0 {
+		return []string{}
+	}
+	if len(sents) <= n {
+		return sents
+	}
+
+	sets := make([]map[string]bool, len(toks))
+	for i, t := range toks {
+		sets[i] = map[string]bool{}
+		for _, w := range t {
+			// Stems let "کتاب" and "کتاب‌ها" count as a shared word.
+			sets[i][textnorm.Stem(w)] = true
+		}
+	}
+	w := make([][]float64, len(sents))
+	out := make([]float64, len(sents))
+	for i := range w {
+		w[i] = make([]float64, len(sents))
+		for j := range w[i] {
+			if i != j {
+				w[i][j] = similarity(sets[i], sets[j])
+				out[i] += w[i][j]
+			}
+		}
+	}
+
+	score := make([]float64, len(sents))
+	for i := range score {
+		score[i] = 1
+	}
+	for it := 0; it < maxIterations; it++ {
+		next := make([]float64, len(sents))
+		delta := 0.0
+		for i := range next {
+			var sum float64
+			for j := range score {
+				if w[j][i] > 0 {
+					sum += w[j][i] / out[j] * score[j]
+				}
+			}
+			next[i] = (1 - damping) + damping*sum
+			delta += math.Abs(next[i] - score[i])
+		}
+		score = next
+		if delta < tolerance {
+			break
+		}
+	}
+
+	idx := make([]int, len(sents))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(a, b int) bool { return score[idx[a]] > score[idx[b]] })
+	top := idx[:n]
+	sort.Ints(top)
+	summary := make([]string, 0, n)
+	for _, i := range top {
+		summary = append(summary, sents[i])
+	}
+	return summary
+}
+
+// similarity is the TextRank sentence similarity: shared words over the sum of
+// the log sentence lengths.
+func similarity(a, b map[string]bool) float64 {
+	shared := 0
+	for w := range a {
+		if b[w] {
+			shared++
+		}
+	}
+	if shared == 0 {
+		return 0
+	}
+	denom := math.Log(float64(len(a))) + math.Log(float64(len(b)))
+	if denom <= 0 {
+		return float64(shared)
+	}
+	return float64(shared) / denom
+}
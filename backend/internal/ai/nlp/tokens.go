@@ -0,0 +1,72 @@
+// Package nlp implements offline text analysis for posts: TF-IDF keyword
+// extraction and TextRank extractive summaries, tuned for Persian text.
+package nlp
+
+import (
+	"strings"
+	"unicode"
+
+	"landing/backend/internal/textnorm"
+)
+
+// segments splits text into runs of content tokens. Stopwords, numbers and
+// one-letter tokens (see textnorm.IsContent) end a run, so adjacent tokens in a segment can form phrases.
+func segments(text string) [][]string {
+	var out [][]string
+	var cur []string
+	end := func() {
+		if len(cur) > 0 {
+			out = append(out, cur)
+			cur = nil
+		}
+	}
+	for _, w := range words(text) {
+		if w == "" {
+			end()
+			continue
+		}
+		if !textnorm.IsContent(w) {
+			end()
+			continue
+		}
+		cur = append(cur, w)
+	}
+	end()
+	return out
+}
+
+// tokens returns the content tokens of text.
+func tokens(text string) []string {
+	var out []string
+	for _, seg := range segments(text) {
+		out = append(out, seg...)
+	}
+	return out
+}
+
+// words normalizes and lowercases text and splits it into words; half-spaces
+// stay inside a word. Punctuation other than spaces yields an empty string so
+// callers can treat it as a phrase boundary.
+func words(text string) []string {
+	var out []string
+	var b strings.Builder
+	flush := func() {
+		if b.Len() > 0 {
+			out = append(out, strings.Trim(b.String(), string(textnorm.ZWNJ)))
+			b.Reset()
+		}
+	}
+	for _, r := range strings.ToLower(textnorm.Normalize(text)) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r) || r == textnorm.ZWNJ:
+			b.WriteRune(r)
+		case unicode.IsSpace(r):
+			flush()
+		default:
+			flush()
+			out = append(out, "")
+		}
+	}
+	flush()
+	return out
+}
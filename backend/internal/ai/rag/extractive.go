@@ -0,0 +1,123 @@
+package rag
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+
+	"landing/backend/internal/content"
+	"landing/backend/internal/textnorm"
+)
+
+// NoAnswer is the reply when nothing in the corpus relates to the question.
+const NoAnswer = "پاسخی برای این پرسش در مطالب وبلاگ پیدا نشد."
+
+// Extractive is an LLM that needs no model: it quotes the source sentences
+// sharing the most terms with the question, each run followed by its citation.
+type Extractive struct {
+	// Sentences is the answer length (default 3).
+	Sentences int
+}
+
+type candidate struct {
+	source, pos int
+	text        string
+	score       float64
+}
+
+// Answer emits the selected sentences word by word, in source order.
+func (e Extractive) Answer(ctx context.Context, question string, sources []Source, emit func(string) error) error {
+	n := e.Sentences
+	if n <= 0 {
+		n = 3
+	}
+	picked := pickSentences(question, sources, n)
+	if len(picked) == 0 {
+		return emitWords(NoAnswer, emit)
+	}
+	for i, c := range picked {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		text := c.text
+		// Cite once after each run of sentences from the same post.
+		if i == len(picked)-1 || sources[picked[i+1].source].Path != sources[c.source].Path {
+			text += " [" + sources[c.source].Citation() + "]"
+		}
+		if i > 0 {
+			text = " " + text
+		}
+		if err := emitWords(text, emit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pickSentences returns up to n distinct sentences ranked by how many question
+// terms they contain, weighted by the retrieval score of their passage, and
+// then ordered by source rank and position so the answer reads naturally.
+func pickSentences(question string, sources []Source, n int) []candidate {
+	want := map[string]bool{}
+	for _, t := range textnorm.Terms(question) {
+		want[t] = true
+	}
+	seen := map[string]bool{}
+	var cands []candidate
+	for si, s := range sources {
+		for pos, sent := range content.Sentences(s.Text) {
+			if seen[sent] {
+				continue // passages overlap
+			}
+			seen[sent] = true
+			terms := textnorm.Terms(sent)
+			hit := map[string]bool{}
+			for _, t := range terms {
+				if want[t] {
+					hit[t] = true
+				}
+			}
+			if len(hit) == 0 {
+				continue
+			}
+			// Favor sentences that are about the question rather than long ones
+			// that happen to mention it.
+			score := float64(len(hit)) / math.Sqrt(float64(len(terms))) * s.Score
+			cands = append(cands, candidate{source: si, pos: pos, text: sent, score: score})
+		}
+	}
+	if len(cands) == 0 && len(sources) > 0 {
+		// The embedding matched without shared words (e.g. only n-grams):
+		// fall back to the opening of the best passage.
+		if sents := content.Sentences(sources[0].Text); len(sents) > 0 {
+			return []candidate{{text: sents[0]}}
+		}
+	}
+	sort.SliceStable(cands, func(i, j int) bool { return cands[i].score > cands[j].score })
+	if len(cands) > n {
+		cands = cands[:n]
+	}
+	sort.SliceStable(cands, func(i, j int) bool {
+		if cands[i].source != cands[j].source {
+			return cands[i].source < cands[j].source
+		}
+		return cands[i].pos < cands[j].pos
+	})
+	return cands
+}
+
+// emitWords streams text one word at a time, keeping the spaces.
+func emitWords(text string, emit func(string) error) error {
+	for text != "" {
+		i := strings.IndexByte(text[1:], ' ')
+		if i < 0 {
+			return emit(text)
+		}
+		if err := emit(text[:i+1]); err != nil {
+			return err
+		}
+		text = text[i+1:]
+	}
+	return nil
+}
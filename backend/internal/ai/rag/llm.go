@@ -0,0 +1,59 @@
+package rag
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"landing/backend/internal/logging"
+)
+
+// LLM writes an answer to question from the retrieved sources, passing each
+// piece of text to emit as soon as it is produced. An error from emit (e.g. the
+// client went away) must stop generation and be returned.
+type LLM interface {
+	Answer(ctx context.Context, question string, sources []Source, emit func(token string) error) error
+}
+
+// systemPrompt instructs a model to stay within the sources and cite them the
+// same way the extractive fallback does.
+const systemPrompt = `You answer questions about the articles of a blog using only the numbered sources provided.
+Reply in the language of the question. After every statement, cite the source it comes from by its path in square brackets, for example [/blog/some-post].
+If the sources do not contain the answer, say so briefly instead of guessing.`
+
+// userPrompt lists the sources with their citations, followed by the question.
+func userPrompt(question string, sources []Source) string {
+	var b strings.Builder
+	for i, s := range sources {
+		fmt.Fprintf(&b, "Source %d [%s] %s", i+1, s.Citation(), s.Title)
+		if s.Heading != "" {
+			fmt.Fprintf(&b, " — %s", s.Heading)
+		}
+		fmt.Fprintf(&b, "\n%s\n\n", s.Text)
+	}
+	fmt.Fprintf(&b, "Question: %s", question)
+	return b.String()
+}
+
+// WithFallback answers with primary and switches to fallback when primary
+// fails before emitting anything, e.g. because the model server is down.
+func WithFallback(primary, fallback LLM) LLM {
+	return fallbackLLM{primary: primary, fallback: fallback}
+}
+
+type fallbackLLM struct {
+	primary, fallback LLM
+}
+
+func (f fallbackLLM) Answer(ctx context.Context, question string, sources []Source, emit func(string) error) error {
+	emitted := false
+	err := f.primary.Answer(ctx, question, sources, func(tok string) error {
+		emitted = true
+		return emit(tok)
+	})
+	if err == nil || emitted || ctx.Err() != nil {
+		return err
+	}
+	logging.FromContext(ctx).Warn("ask: model failed, answering with fallback", logging.Err(err))
+	return f.fallback.Answer(ctx, question, sources, emit)
+}
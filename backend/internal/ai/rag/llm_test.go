@@ -0,0 +1,72 @@
+package rag
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// stubLLM emits toks, then returns err.
+type stubLLM struct {
+	toks  []string
+	err   error
+	calls *int
+}
+
+func (s stubLLM) Answer(ctx context.Context, question string, sources []Source, emit func(string) error) error {
+	if s.calls != nil {
+		*s.calls++
+	}
+	for _, tok := range s.toks {
+		if err := emit(tok); err != nil {
+			return err
+		}
+	}
+	return s.err
+}
+
+func TestWithFallback(t *testing.T) {
+	down := errors.New("connection refused")
+	for _, tc := range []struct {
+		name      string
+		primary   stubLLM
+		want      []string
+		wantErr   error
+		fallbacks int
+	}{
+		{"primary answers", stubLLM{toks: []string{"a", "b"}}, []string{"a", "b"}, nil, 0},
+		{"primary fails first", stubLLM{err: down}, []string{"x"}, nil, 1},
+		// Once tokens reached the client, switching answers would garble it.
+		{"primary fails midway", stubLLM{toks: []string{"a"}, err: down}, []string{"a"}, down, 0},
+	} {
+		calls := 0
+		llm := WithFallback(tc.primary, stubLLM{toks: []string{"x"}, calls: &calls})
+		toks, err := collect(llm, nil)
+		if !errors.Is(err, tc.wantErr) || !reflect.DeepEqual(toks, tc.want) {
+			t.Errorf("%s: tokens = %q, err = %v, want %q, %v", tc.name, toks, err, tc.want, tc.wantErr)
+		}
+		if calls != tc.fallbacks {
+			t.Errorf("%s: fallback called %d times, want %d", tc.name, calls, tc.fallbacks)
+		}
+	}
+
+	// The emit error of a departed client is returned, not retried.
+	stop := errors.New("client went away")
+	calls := 0
+	err := WithFallback(stubLLM{toks: []string{"a", "b"}}, stubLLM{toks: []string{"x"}, calls: &calls}).
+		Answer(context.Background(), "q", nil, func(string) error { return stop })
+	if !errors.Is(err, stop) || calls != 0 {
+		t.Errorf("emit error: err = %v, fallback calls = %d, want the emit error and none", err, calls)
+	}
+
+	// A canceled request is not answered by the fallback either.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	calls = 0
+	err = WithFallback(stubLLM{err: context.Canceled}, stubLLM{toks: []string{"x"}, calls: &calls}).
+		Answer(ctx, "q", nil, func(string) error { return nil })
+	if !errors.Is(err, context.Canceled) || calls != 0 {
+		t.Errorf("canceled: err = %v, fallback calls = %d, want context.Canceled and none", err, calls)
+	}
+}
@@ -0,0 +1,101 @@
+package rag
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// chatServer serves a chat completions stream of deltas, then [DONE] unless
+// tail replaces it, recording the decoded request.
+func chatServer(t *testing.T, deltas []string, tail string) (*httptest.Server, *chatRequest) {
+	t.Helper()
+	var got chatRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/chat/completions" || r.Header.Get("Authorization") != "Bearer key" {
+			http.Error(w, "bad request line", http.StatusBadRequest)
+			return
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprint(w, ": keep-alive\n\n")
+		for _, d := range deltas {
+			data, _ := json.Marshal(map[string]any{"choices": []any{map[string]any{"delta": map[string]string{"content": d}}}})
+			fmt.Fprintf(w, "data: %s\n\n", data)
+			w.(http.Flusher).Flush()
+		}
+		if tail == "" {
+			tail = "data: [DONE]\n\n"
+		}
+		fmt.Fprint(w, tail)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &got
+}
+
+func collect(llm LLM, sources []Source) ([]string, error) {
+	var toks []string
+	err := llm.Answer(context.Background(), "RAG چیست؟", sources, func(tok string) error {
+		toks = append(toks, tok)
+		return nil
+	})
+	return toks, err
+}
+
+func TestOpenAIAnswer(t *testing.T) {
+	// An empty delta (e.g. the role-only first chunk) emits nothing.
+	srv, req := chatServer(t, []string{"RAG ", "", "بازیابی است", " [/blog/rag]"}, "data: [DONE]\n\ndata: {\"choices\":[{\"delta\":{\"content\":\"late\"}}]}\n\n")
+	llm := OpenAI{BaseURL: srv.URL + "/v1/", APIKey: "key", Model: "m"}
+	sources := []Source{{Path: "rag", Title: "RAG", Text: "RAG بازیابی است."}}
+
+	toks, err := collect(llm, sources)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"RAG ", "بازیابی است", " [/blog/rag]"}; !reflect.DeepEqual(toks, want) {
+		t.Errorf("tokens = %q, want %q (nothing after [DONE])", toks, want)
+	}
+	if !req.Stream || req.Model != "m" || len(req.Messages) != 2 || !strings.Contains(req.Messages[1].Content, "Source 1 [/blog/rag] RAG") {
+		t.Errorf("request = %+v, want a streamed request citing the sources", *req)
+	}
+}
+
+func TestOpenAIAnswerErrors(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "model overloaded", http.StatusServiceUnavailable)
+	}))
+	defer down.Close()
+	if _, err := collect(OpenAI{BaseURL: down.URL}, nil); err == nil || !strings.Contains(err.Error(), "503") || !strings.Contains(err.Error(), "model overloaded") {
+		t.Errorf("non-200: err = %v, want the status and body", err)
+	}
+
+	srv, _ := chatServer(t, []string{"a"}, "data: {not json\n\n")
+	if toks, err := collect(OpenAI{BaseURL: srv.URL + "/v1", APIKey: "key"}, nil); err == nil || !reflect.DeepEqual(toks, []string{"a"}) {
+		t.Errorf("broken stream: tokens = %q, err = %v, want [a] and an error", toks, err)
+	}
+}
+
+func TestOpenAIAnswerStopsOnEmitError(t *testing.T) {
+	srv, _ := chatServer(t, []string{"a", "b", "c"}, "")
+	stop := errors.New("client went away")
+	var toks []string
+	err := OpenAI{BaseURL: srv.URL + "/v1", APIKey: "key"}.Answer(context.Background(), "q", nil, func(tok string) error {
+		toks = append(toks, tok)
+		return stop
+	})
+	if !errors.Is(err, stop) {
+		t.Errorf("err = %v, want the emit error", err)
+	}
+	if !reflect.DeepEqual(toks, []string{"a"}) {
+		t.Errorf("tokens = %q, want generation to stop after the first", toks)
+	}
+}
@@ -0,0 +1,95 @@
+// Package rag answers questions from the blog corpus: it retrieves the
+// passages most similar to a question and has an LLM answer from them, citing
+// the posts they come from.
+package rag
+
+import (
+	"sort"
+
+	"landing/backend/internal/ai/embeddings"
+)
+
+// Source is a retrieved passage and the post it belongs to.
+type Source struct {
+	Path    string  `json:"path"`
+	Title   string  `json:"title"`
+	Heading string  `json:"heading,omitempty"`
+	Text    string  `json:"text"`
+	Score   float64 `json:"score"`
+}
+
+// Citation is how answers refer to the source: the public URL path of its post.
+func (s Source) Citation() string { return "/blog/" + s.Path }
+
+// Chunk is a stored passage (ent.BlogChunk) with its embedding.
+type Chunk struct {
+	Source
+	Embedding []float32
+}
+
+// Retrieve returns the k chunks most similar to query, best first.
+func Retrieve(query []float32, chunks []Chunk, k int) []Source {
+	hits := []Source{}
+	if len(query) == 0 || k <= 0 {
+		return hits
+	}
+	for _, c := range chunks {
+		if score := embeddings.Cosine(query, c.Embedding); score > 0 {
+			s := c.Source
+			s.Score = score
+			hits = append(hits, s)
+		}
+	}
+	sort.SliceStable(hits, func(i, j int) bool { return hits[i].Score > hits[j].Score })
+	if len(hits) > k {
+		hits = hits[:k]
+	}
+	return hits
+}
+
+// PostHit is a post ranked by the aggregated scores of its chunks.
+type PostHit struct {
+	Path  string  `json:"path"`
+	Title string  `json:"title"`
+	Score float64 `json:"score"`
+	// Best is the post's highest-scoring passage.
+	Best Source `json:"best"`
+}
+
+// Search ranks the posts of chunks against query, combining each post's chunk
+// scores with mode (embeddings.AggregateMax or AggregateMean), and returns the
+// top limit posts.
+func Search(query []float32, chunks []Chunk, mode string, limit int) []PostHit {
+	hits := []PostHit{}
+	if len(query) == 0 || limit <= 0 {
+		return hits
+	}
+	byPost := map[string]*PostHit{}
+	scores := map[string][]float64{}
+	var order []string
+	for _, c := range chunks {
+		score := embeddings.Cosine(query, c.Embedding)
+		h, ok := byPost[c.Path]
+		if !ok {
+			h = &PostHit{Path: c.Path, Title: c.Title}
+			byPost[c.Path] = h
+			order = append(order, c.Path)
+		}
+		scores[c.Path] = append(scores[c.Path], score)
+		if len(scores[c.Path]) == 1 || score > h.Best.Score {
+			h.Best = c.Source
+			h.Best.Score = score
+		}
+	}
+	for _, path := range order {
+		h := byPost[path]
+		if h.Score = embeddings.Aggregate(scores[path], mode); h.Score > 0 {
+			hits = append(hits, *h)
+		}
+	}
+	sort.SliceStable(hits, func(i, j int) bool { return hits[i].Score > hits[j].Score })
+	if len(hits) > limit {
+		hits = hits[:limit]
+	}
+	return hits
+}
@@ -0,0 +1,154 @@
+// Package apperr defines the typed errors handlers return and the Fiber error
+// handler that renders them as RFC 7807 problem details
+// (application/problem+json).
+package apperr
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// Stable, machine-readable error codes. Clients may switch on them; they are
+// never renamed, only added to.
+const (
+	CodeValidation           = "validation_failed"
+	CodeInvalidBody          = "invalid_body"
+	CodeNotFound             = "not_found"
+	CodeConflict             = "conflict"
+	CodeUnauthorized         = "unauthorized"
+	CodePayloadTooLarge      = "payload_too_large"
+	CodeUnsupportedMediaType = "unsupported_media_type"
+	CodeInternal             = "internal_error"
+)
+
+// Field-level codes used in FieldError.Code.
+const (
+	FieldRequired   = "required"
+	FieldInvalid    = "invalid"
+	FieldUnknown    = "unknown"
+	FieldTooLong    = "too_long"
+	FieldTooShort   = "too_short"
+	FieldNotAllowed = "not_allowed"
+	FieldOutOfRange = "out_of_range"
+)
+
+// Error is an error with an HTTP status, a stable code and a client-facing
+// detail. The wrapped cause is logged but never sent to the client.
+type Error struct {
+	Status int
+	Code   string
+	Detail string
+	// Fields lists per-field problems of a validation error.
+	Fields []FieldError
+	// Extensions are additional members of the problem document.
+	Extensions map[string]any
+	Err        error
+}
+
+// FieldError describes what is wrong with one request field.
+// swagger:model
+type FieldError struct {
+	// Field is the JSON name of the field (dotted for nested fields).
+	Field string `json:"field" example:"path"`
+	// Code is a stable field-level code.
+	Code    string `json:"code" enums:"required,invalid,unknown,too_long,too_short,not_allowed,out_of_range" example:"required"`
+	Message string `json:"message" example:"path is required"`
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Detail + ": " + e.Err.Error()
+	}
+	return e.Detail
+}
+
+func (e *Error) Unwrap() error { return e.Err }
+
+// With returns a copy of e with the extension member key set to v.
+func (e *Error) With(key string, v any) *Error {
+	out := *e
+	out.Extensions = make(map[string]any, len(e.Extensions)+1)
+	for k, x := range e.Extensions {
+		out.Extensions[k] = x
+	}
+	out.Extensions[key] = v
+	return &out
+}
+
+// New returns an error with the given status, code and detail.
+func New(status int, code, detail string) *Error {
+	return &Error{Status: status, Code: code, Detail: detail}
+}
+
+// Validation reports an invalid request (400), optionally with the problems
+// of individual fields.
+func Validation(detail string, fields ...FieldError) *Error {
+	return &Error{Status: http.StatusBadRequest, Code: CodeValidation, Detail: detail, Fields: fields}
+}
+
+// Field returns the problem of one field for Validation.
+func Field(field, code, message string) FieldError {
+	return FieldError{Field: field, Code: code, Message: message}
+}
+
+// InvalidField reports a single invalid field (400).
+func InvalidField(field, code, message string) *Error {
+	return Validation(message, Field(field, code, message))
+}
+
+// InvalidBody reports a request body that could not be parsed (400).
+func InvalidBody(err error) *Error {
+	return &Error{Status: http.StatusBadRequest, Code: CodeInvalidBody, Detail: "invalid JSON body", Err: err}
+}
+
+// NotFound reports a missing resource (404).
+func NotFound(detail string) *Error {
+	return New(http.StatusNotFound, CodeNotFound, detail)
+}
+
+// Conflict reports a request that conflicts with the current state (409),
+// such as a duplicate unique value or deleting a resource still in use.
+func Conflict(detail string) *Error {
+	return New(http.StatusConflict, CodeConflict, detail)
+}
+
+// Unauthorized reports missing or invalid credentials (401).
+func Unauthorized(detail string) *Error {
+	return New(http.StatusUnauthorized, CodeUnauthorized, detail)
+}
+
+// Internal wraps an unexpected failure (500). Its detail is generic; err is
+// only logged. Errors that already are *Error are returned unchanged.
+func Internal(err error) *Error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+	return &Error{Status: http.StatusInternalServerError, Code: CodeInternal, Detail: "internal server error", Err: err}
+}
+
+// codeForStatus maps statuses of errors that are not *Error (e.g. Fiber's
+// own 404 and 405) to a code.
+func codeForStatus(status int) string {
+	switch status {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return CodeValidation
+	case http.StatusUnauthorized:
+		return CodeUnauthorized
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusConflict:
+		return CodeConflict
+	case http.StatusRequestEntityTooLarge:
+		return CodePayloadTooLarge
+	case http.StatusUnsupportedMediaType:
+		return CodeUnsupportedMediaType
+	default:
+		if status >= http.StatusInternalServerError {
+			return CodeInternal
+		}
+		// e.g. 405 -> "method_not_allowed"
+		return strings.ToLower(strings.ReplaceAll(http.StatusText(status), " ", "_"))
+	}
+}
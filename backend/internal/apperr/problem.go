@@ -0,0 +1,82 @@
+package apperr
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// ContentType is the media type of problem documents.
+const ContentType = "application/problem+json"
+
+// typePrefix makes problem types URIs that identify the code.
+const typePrefix = "urn:landing:problem:"
+
+// Problem is an RFC 7807 problem details document, returned by every failing
+// endpoint with Content-Type application/problem+json.
+//
+// Codes: validation_failed (400, see errors), invalid_body (400),
+// unauthorized (401), not_found (404), conflict (409), payload_too_large (413),
+// unsupported_media_type (415), internal_error (500).
+// swagger:model
+type Problem struct {
+	// Type is "urn:landing:problem:" followed by the code.
+	Type   string `json:"type" example:"urn:landing:problem:not_found"`
+	Title  string `json:"title" example:"Not Found"`
+	Status int    `json:"status" example:"404"`
+	Detail string `json:"detail" example:"blog not found"`
+	// Instance is the request path.
+	Instance string `json:"instance" example:"/api/blogs/missing"`
+	// Code is the stable error code clients should switch on.
+	Code string `json:"code" enums:"validation_failed,invalid_body,unauthorized,not_found,conflict,payload_too_large,unsupported_media_type,internal_error" example:"not_found"`
+	// RequestID matches the X-Request-ID response header and the server logs.
+	RequestID string `json:"request_id,omitempty" example:"5805817e-0046-434a-abb6-e4f2f990e47b"`
+	// Errors lists the invalid fields of a validation_failed problem.
+	Errors []FieldError `json:"errors,omitempty"`
+}
+
+// Handler is the Fiber ErrorHandler: it renders err as a problem document.
+// *Error values keep their status and code, *fiber.Error values (unknown
+// routes, body limit, ...) are mapped by status, and anything else is an
+// internal error whose message is not exposed.
+func Handler(c *fiber.Ctx, err error) error {
+	var e *Error
+	if !errors.As(err, &e) {
+		var fe *fiber.Error
+		if errors.As(err, &fe) {
+			e = New(fe.Code, codeForStatus(fe.Code), fe.Message)
+		} else {
+			e = Internal(err)
+		}
+	}
+	requestID, _ := c.Locals("requestid").(string)
+	p := Problem{
+		Type:      typePrefix + e.Code,
+		Title:     http.StatusText(e.Status),
+		Status:    e.Status,
+		Detail:    e.Detail,
+		Instance:  c.Path(),
+		Code:      e.Code,
+		RequestID: requestID,
+		Errors:    e.Fields,
+	}
+	var body any = p
+	if len(e.Extensions) > 0 {
+		// Extension members sit next to the standard ones, which take precedence.
+		m := fiber.Map{}
+		for k, v := range e.Extensions {
+			m[k] = v
+		}
+		raw, err := json.Marshal(p)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(raw, &m); err != nil {
+			return err
+		}
+		body = m
+	}
+	return c.Status(e.Status).JSON(body, ContentType)
+}
@@ -0,0 +1,107 @@
+package apperr
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestHandler(t *testing.T) {
+	app := fiber.New(fiber.Config{ErrorHandler: Handler})
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("requestid", "req-1")
+		return c.Next()
+	})
+	routes := map[string]error{
+		"/validation": Validation("title is required; tags has too many items",
+			Field("title", FieldRequired, "title is required"),
+			Field("tags", FieldTooLong, "tags has too many items")),
+		"/not-found": NotFound("blog not found"),
+		// Extensions cannot replace standard members such as status or code.
+		"/extended": Conflict("path already taken").With("path", "hello").With("status", 200).With("code", "ok"),
+		"/internal": errors.New("pq: connection refused to 10.0.0.5"),
+		"/wrapped":  Internal(NotFound("media not found")),
+		"/fiber":    fiber.NewError(fiber.StatusRequestEntityTooLarge, "Request Entity Too Large"),
+	}
+	for path, err := range routes {
+		app.Get(path, func(*fiber.Ctx) error { return err })
+	}
+
+	for _, tc := range []struct {
+		path   string
+		status int
+		want   map[string]any
+	}{
+		{"/validation", 400, map[string]any{
+			"type": "urn:landing:problem:validation_failed", "title": "Bad Request", "status": 400.0,
+			"detail": "title is required; tags has too many items", "instance": "/validation",
+			"code": "validation_failed", "request_id": "req-1",
+			"errors": []any{
+				map[string]any{"field": "title", "code": "required", "message": "title is required"},
+				map[string]any{"field": "tags", "code": "too_long", "message": "tags has too many items"},
+			},
+		}},
+		{"/not-found", 404, map[string]any{
+			"type": "urn:landing:problem:not_found", "title": "Not Found", "status": 404.0,
+			"detail": "blog not found", "instance": "/not-found", "code": "not_found", "request_id": "req-1",
+		}},
+		{"/extended", 409, map[string]any{
+			"type": "urn:landing:problem:conflict", "title": "Conflict", "status": 409.0,
+			"detail": "path already taken", "instance": "/extended", "code": "conflict", "request_id": "req-1",
+			"path": "hello",
+		}},
+		// The cause of an internal error is logged, never sent.
+		{"/internal", 500, map[string]any{
+			"type": "urn:landing:problem:internal_error", "title": "Internal Server Error", "status": 500.0,
+			"detail": "internal server error", "instance": "/internal", "code": "internal_error", "request_id": "req-1",
+		}},
+		{"/wrapped", 404, map[string]any{
+			"type": "urn:landing:problem:not_found", "title": "Not Found", "status": 404.0,
+			"detail": "media not found", "instance": "/wrapped", "code": "not_found", "request_id": "req-1",
+		}},
+		{"/fiber", 413, map[string]any{
+			"type": "urn:landing:problem:payload_too_large", "title": "Request Entity Too Large", "status": 413.0,
+			"detail": "Request Entity Too Large", "instance": "/fiber", "code": "payload_too_large", "request_id": "req-1",
+		}},
+		// Fiber's own errors for unknown routes and methods.
+		{"/missing", 404, map[string]any{
+			"type": "urn:landing:problem:not_found", "title": "Not Found", "status": 404.0,
+			"detail": "Cannot GET /missing", "instance": "/missing", "code": "not_found", "request_id": "req-1",
+		}},
+	} {
+		resp, err := app.Test(httptest.NewRequest(fiber.MethodGet, tc.path, nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != tc.status {
+			t.Errorf("%s: status = %d, want %d", tc.path, resp.StatusCode, tc.status)
+		}
+		if ct := resp.Header.Get(fiber.HeaderContentType); ct != ContentType {
+			t.Errorf("%s: Content-Type = %q, want %q", tc.path, ct, ContentType)
+		}
+		var got map[string]any
+		if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+			t.Fatalf("%s: %v", tc.path, err)
+		}
+		resp.Body.Close()
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: body = %v\nwant %v", tc.path, got, tc.want)
+		}
+	}
+}
+
+func TestCodeForStatus(t *testing.T) {
+	for status, want := range map[int]string{
+		400: CodeValidation, 401: CodeUnauthorized, 404: CodeNotFound, 405: "method_not_allowed",
+		409: CodeConflict, 413: CodePayloadTooLarge, 415: CodeUnsupportedMediaType,
+		422: CodeValidation, 429: "too_many_requests", 502: CodeInternal,
+	} {
+		if got := codeForStatus(status); got != want {
+			t.Errorf("codeForStatus(%d) = %q, want %q", status, got, want)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // Config holds application configuration.
@@ -19,6 +20,44 @@ type Config struct {
 	// API key for protecting endpoints
 	APIKey string
 
+	// MaxBodyBytes caps request bodies on every route but the media upload,
+	// which is bounded by MediaMaxBytes.
+	MaxBodyBytes int
+
+	// Offline embedding model ("v1" or "v2") and v2 vector size
+	EmbeddingModel string
+	EmbeddingDim   int
+	// EmbeddingCacheSize is the number of vectors kept in memory.
+	EmbeddingCacheSize int
+	// SimilarityAggregate combines a post's chunk scores: "max" or "mean".
+	SimilarityAggregate string
+	// EmbeddingRefreshInterval is how often the corpus IDF is recomputed and
+	// the vectors it invalidates are re-embedded; 0 leaves it to
+	// POST /api/embeddings/refresh and restarts.
+	EmbeddingRefreshInterval time.Duration
+
+	// Question answering (/api/ask): an OpenAI-compatible chat completions API.
+	// Without LLMBaseURL answers are extracted from the retrieved passages.
+	LLMBaseURL string
+	LLMAPIKey  string
+	LLMModel   string
+	AskTopK    int
+
+	// MetricsAddr serves /metrics on a separate listener (e.g. ":9090") for an
+	// admin network. When empty, /metrics is served on the main port behind the
+	// API key, or on LocalMetricsAddr when there is no key (see MetricsListenAddr).
+	MetricsAddr string
+
+	// Logging: level ("debug", "info", "warn" or "error") and format ("text" or
+	// "json"; empty means text in development and JSON elsewhere).
+	LogLevel  string
+	LogFormat string
+
+	// OpenTelemetry tracing: exporter ("none", "otlp" or "stdout") and the share
+	// of new traces to sample. The OTLP endpoint comes from OTEL_EXPORTER_OTLP_ENDPOINT.
+	TracingExporter    string
+	TracingSampleRatio float64
+
 	// Build/Version metadata
 	Version    string
 	CommitHash string
@@ -29,8 +68,31 @@ type Config struct {
 	SiteBaseURL          string
 	SiteLogo             string
 	DefaultFeaturedImage string
-	AuthorName           string
-	AuthorBio            string
+	// PlaceholderStrict rejects posts that use placeholders with no known value.
+	PlaceholderStrict bool
+	// AuthorName is the legacy site-wide author name, used only as the display
+	// name of author profiles that have neither a display name nor a name.
+	AuthorName string
+
+	// Media uploads
+	MediaStorage   string // "local" or "s3"
+	MediaDir       string // local storage root
+	MediaPublicURL string // public URL prefix for stored objects
+	MediaMaxBytes  int
+	// MediaMaxPixels caps width×height of uploaded images, which decoding
+	// allocates memory for regardless of the file size.
+	MediaMaxPixels int
+	// Responsive image variants generated on upload, and the default `sizes` attribute.
+	MediaVariantWidths []int
+	MediaImageSizes    string
+
+	// S3-compatible storage (used when MediaStorage is "s3")
+	S3Endpoint  string
+	S3Region    string
+	S3Bucket    string
+	S3AccessKey string
+	S3SecretKey string
+	S3UseSSL    bool
 }
 
 // Load reads configuration from environment variables with defaults.
@@ -45,13 +107,54 @@ func Load() Config {
 		CommitHash:  getEnv("COMMIT_HASH", ""),
 		BuildDate:   getEnv("BUILD_DATE", ""),
 
+		MetricsAddr: getEnv("METRICS_ADDR", ""),
+
+		MaxBodyBytes: getEnvAsInt("MAX_BODY_BYTES", 1<<20),
+
+		// Logging
+		LogLevel:  strings.ToLower(getEnv("LOG_LEVEL", "info")),
+		LogFormat: strings.ToLower(getEnv("LOG_FORMAT", "")),
+
+		// Tracing
+		TracingExporter:    strings.ToLower(getEnv("TRACING_EXPORTER", "none")),
+		TracingSampleRatio: getEnvAsFloat("TRACING_SAMPLE_RATIO", 1),
+
+		// Embeddings
+		EmbeddingModel:      strings.ToLower(getEnv("EMBEDDING_MODEL", "v2")),
+		EmbeddingDim:        getEnvAsInt("EMBEDDING_DIM", 512),
+		EmbeddingCacheSize:  getEnvAsInt("EMBEDDING_CACHE_SIZE", 2048),
+		SimilarityAggregate: strings.ToLower(getEnv("SIMILARITY_AGGREGATE", "max")),
+
+		EmbeddingRefreshInterval: getEnvAsDuration("EMBEDDING_REFRESH_INTERVAL", 24*time.Hour),
+
+		// Question answering
+		LLMBaseURL: getEnv("LLM_BASE_URL", ""),
+		LLMAPIKey:  getEnv("LLM_API_KEY", ""),
+		LLMModel:   getEnv("LLM_MODEL", "gpt-4o-mini"),
+		AskTopK:    getEnvAsInt("ASK_TOP_K", 5),
+
 		// Site metadata
 		SiteName:             getEnv("SITE_NAME", "Landing"),
 		SiteBaseURL:          getEnv("SITE_BASE_URL", ""),
 		SiteLogo:             getEnv("SITE_LOGO", "/favicon.ico"),
 		DefaultFeaturedImage: getEnv("FEATURED_IMAGE", "/og-default.jpg"),
+		PlaceholderStrict:    getEnvAsBool("PLACEHOLDER_STRICT", false),
 		AuthorName:           getEnv("AUTHOR_NAME", "Admin"),
-		AuthorBio:            getEnv("AUTHOR_BIO", ""),
+
+		// Media
+		MediaStorage:       strings.ToLower(getEnv("MEDIA_STORAGE", "local")),
+		MediaDir:           getEnv("MEDIA_DIR", "./data/media"),
+		MediaPublicURL:     getEnv("MEDIA_PUBLIC_URL", "/media"),
+		MediaMaxBytes:      getEnvAsInt("MEDIA_MAX_BYTES", 10<<20),
+		MediaMaxPixels:     getEnvAsInt("MEDIA_MAX_PIXELS", 40_000_000),
+		MediaVariantWidths: getEnvAsIntList("MEDIA_VARIANT_WIDTHS", []int{320, 640, 960, 1280}),
+		MediaImageSizes:    getEnv("MEDIA_IMAGE_SIZES", "(max-width: 768px) 100vw, 768px"),
+		S3Endpoint:         getEnv("S3_ENDPOINT", ""),
+		S3Region:           getEnv("S3_REGION", ""),
+		S3Bucket:           getEnv("S3_BUCKET", ""),
+		S3AccessKey:        getEnv("S3_ACCESS_KEY", ""),
+		S3SecretKey:        getEnv("S3_SECRET_KEY", ""),
+		S3UseSSL:           getEnvAsBool("S3_USE_SSL", false),
 	}
 	return cfg
 }
@@ -95,5 +198,59 @@ func getEnvAsInt(key string, def int) int {
 	return def
 }
 
+func getEnvAsFloat(key string, def float64) float64 {
+	if v, ok := os.LookupEnv(key); ok && v != "" {
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			return f
+		}
+	}
+	return def
+}
+
+func getEnvAsDuration(key string, def time.Duration) time.Duration {
+	if v, ok := os.LookupEnv(key); ok && v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+	}
+	return def
+}
+
+func getEnvAsBool(key string, def bool) bool {
+	if v, ok := os.LookupEnv(key); ok && v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			return b
+		}
+	}
+	return def
+}
+
+func getEnvAsIntList(key string, def []int) []int {
+	v, ok := os.LookupEnv(key)
+	if !ok || strings.TrimSpace(v) == "" {
+		return def
+	}
+	out := []int{}
+	for _, part := range strings.Split(v, ",") {
+		if n, err := strconv.Atoi(strings.TrimSpace(part)); err == nil && n > 0 {
+			out = append(out, n)
+		}
+	}
+	return out
+}
+
 // Addr returns ":port" string
 func (c Config) Addr() string { return fmt.Sprintf(":%d", c.Port) }
+
+// LocalMetricsAddr is where /metrics is served when neither METRICS_ADDR nor
+// API_KEY is set, so that an unprotected main port does not expose it.
+const LocalMetricsAddr = "127.0.0.1:9090"
+
+// MetricsListenAddr returns the address of the separate /metrics listener, or
+// "" when /metrics is served on the main port behind the API key.
+func (c Config) MetricsListenAddr() string {
+	if c.MetricsAddr == "" && c.APIKey == "" {
+		return LocalMetricsAddr
+	}
+	return c.MetricsAddr
+}
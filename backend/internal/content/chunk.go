@@ -0,0 +1,119 @@
+package content
+
+import "strings"
+
+// Default passage size, in words, for retrieval.
+const (
+	PassageWords   = 120
+	PassageOverlap = 30
+)
+
+// Passage is a retrieval-sized piece of a post: a few paragraphs and the
+// nearest heading above them.
+type Passage struct {
+	Heading string `json:"heading"`
+	Text    string `json:"text"`
+}
+
+// Passages splits an HTML fragment along its headings and paragraphs into
+// passages of at most about maxWords words. A heading always starts a new
+// passage; paragraphs are packed until the next one would overflow, and a
+// paragraph longer than maxWords is split at sentence boundaries. Within a
+// section each passage repeats the last sentences (up to overlap words) of the
+// previous one, so a statement spanning a break is still found as a whole.
+func Passages(fragment string, maxWords, overlap int) []Passage {
+	if maxWords <= 0 {
+		maxWords = PassageWords
+	}
+	var out []Passage
+	heading := ""
+	var cur []string
+	curWords, fresh := 0, false
+	emit := func() {
+		if fresh {
+			out = append(out, Passage{Heading: heading, Text: strings.Join(cur, " ")})
+		}
+	}
+	for _, b := range Blocks(fragment) {
+		if headingTag(b.Tag) {
+			emit()
+			heading, cur, curWords, fresh = b.Text, nil, 0, false
+			continue
+		}
+		for _, u := range passageUnits(b.Text, maxWords) {
+			n := len(strings.Fields(u))
+			if fresh && curWords+n > maxWords {
+				emit()
+				cur = overlapTail(cur, overlap)
+				curWords = 0
+				for _, s := range cur {
+					curWords += len(strings.Fields(s))
+				}
+				fresh = false
+			}
+			cur = append(cur, u)
+			curWords += n
+			fresh = true
+		}
+	}
+	emit()
+	return out
+}
+
+// passageUnits returns text whole when it fits in maxWords, otherwise its
+// sentences, with sentences longer than maxWords cut into word runs.
+func passageUnits(text string, maxWords int) []string {
+	if len(strings.Fields(text)) <= maxWords {
+		return []string{text}
+	}
+	var out []string
+	for _, s := range Sentences(text) {
+		words := strings.Fields(s)
+		for len(words) > maxWords {
+			out = append(out, strings.Join(words[:maxWords], " "))
+			words = words[maxWords:]
+		}
+		if len(words) > 0 {
+			out = append(out, strings.Join(words, " "))
+		}
+	}
+	return out
+}
+
+// overlapTail returns the trailing sentences of units that fit in overlap
+// words, or the last overlap words when even the final sentence is longer.
+func overlapTail(units []string, overlap int) []string {
+	if overlap <= 0 || len(units) == 0 {
+		return nil
+	}
+	var sentences []string
+	for _, u := range units {
+		sentences = append(sentences, Sentences(u)...)
+	}
+	var tail []string
+	words := 0
+	for i := len(sentences) - 1; i >= 0; i-- {
+		n := len(strings.Fields(sentences[i]))
+		if words+n > overlap {
+			break
+		}
+		tail = append([]string{sentences[i]}, tail...)
+		words += n
+	}
+	if len(tail) == 0 && len(sentences) > 0 {
+		last := strings.Fields(sentences[len(sentences)-1])
+		if len(last) > overlap {
+			last = last[len(last)-overlap:]
+		}
+		tail = []string{strings.Join(last, " ")}
+	}
+	return tail
+}
+
+func headingTag(tag string) bool {
+	switch tag {
+	case "h1", "h2", "h3", "h4", "h5", "h6":
+		return true
+	}
+	return false
+}
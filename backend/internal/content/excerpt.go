@@ -0,0 +1,155 @@
+package content
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+)
+
+// Excerpt lengths in characters.
+const (
+	ExcerptMin = 150
+	ExcerptMax = 300
+)
+
+// sentenceEnds are the runes that end a sentence when followed by a space or
+// the end of the text: Latin and Persian question marks, the Urdu/Persian full
+// stop and the ellipsis.
+const sentenceEnds = ".!?؟۔…"
+
+// Summarize builds a summary of at most max characters from the first
+// meaningful paragraphs of an HTML fragment. It takes whole sentences while
+// they fit, stops at a paragraph boundary once min characters are reached, and
+// only when the first sentence alone is too long cuts it at a word boundary
+// with an ellipsis. CTA sections, the table of contents and captions are skipped.
+func Summarize(fragment string, min, max int) string {
+	var out []rune
+	for i, para := range paragraphs(fragment) {
+		if i > 0 && len(out) >= min {
+			break
+		}
+		full := false
+		for _, s := range Sentences(para) {
+			sr := []rune(s)
+			sep := 0
+			if len(out) > 0 {
+				sep = 1
+			}
+			if len(out)+sep+len(sr) > max {
+				full = true
+				break
+			}
+			if sep == 1 {
+				out = append(out, ' ')
+			}
+			out = append(out, sr...)
+		}
+		if full {
+			break
+		}
+	}
+	if len(out) > 0 {
+		return string(out)
+	}
+	// The first sentence does not fit: cut it between words.
+	paras := paragraphs(fragment)
+	if len(paras) == 0 {
+		return ""
+	}
+	return truncateWords(paras[0], max)
+}
+
+// MetaDescription returns the content of a <meta name="description"> in doc, if any.
+func MetaDescription(doc string) string {
+	root, err := html.Parse(strings.NewReader(doc))
+	if err != nil {
+		return ""
+	}
+	var out string
+	walkElements(root, func(n *html.Node) bool {
+		if out == "" && n.Data == "meta" && strings.EqualFold(attr(n, "name"), "description") {
+			out = collapseSpace(attr(n, "content"))
+		}
+		return out == ""
+	})
+	return out
+}
+
+// paragraphs returns the collapsed text of the substantial <p> elements, or
+// the first non-empty one when none is long enough.
+func paragraphs(fragment string) []string {
+	nodes, err := parseBody(fragment)
+	if err != nil {
+		return nil
+	}
+	var out []string
+	first := ""
+	for _, n := range nodes {
+		walkElements(n, func(e *html.Node) bool {
+			switch {
+			case hasClass(e, "cta-section"), e.Data == "nav", e.Data == "figure", e.Data == "figcaption":
+				return false
+			case e.Data == "p":
+				t := collapseSpace(nodeText(e))
+				if first == "" {
+					first = t
+				}
+				if utf8.RuneCountInString(t) >= minParagraphRunes {
+					out = append(out, t)
+				}
+				return false
+			}
+			return true
+		})
+	}
+	if len(out) == 0 && first != "" {
+		out = []string{first}
+	}
+	return out
+}
+
+// Sentences splits text after sentence-ending punctuation followed by a space.
+func Sentences(text string) []string {
+	var out []string
+	r := []rune(text)
+	start := 0
+	for i := 0; i < len(r); i++ {
+		if !strings.ContainsRune(sentenceEnds, r[i]) {
+			continue
+		}
+		// Keep runs such as "?!" or "..." together.
+		for i+1 < len(r) && strings.ContainsRune(sentenceEnds, r[i+1]) {
+			i++
+		}
+		if i+1 == len(r) || unicode.IsSpace(r[i+1]) {
+			out = append(out, strings.TrimSpace(string(r[start:i+1])))
+			start = i + 1
+		}
+	}
+	if rest := strings.TrimSpace(string(r[start:])); rest != "" {
+		out = append(out, rest)
+	}
+	return out
+}
+
+// truncateWords shortens text to at most max characters including a trailing
+// ellipsis, cutting at the last space so no word is split.
+func truncateWords(text string, max int) string {
+	r := []rune(text)
+	if len(r) <= max {
+		return text
+	}
+	cut := max - 1
+	for cut > 0 && !unicode.IsSpace(r[cut]) {
+		cut--
+	}
+	if cut == 0 {
+		// A single word longer than max; nothing better than a hard cut.
+		cut = max - 1
+	}
+	return strings.TrimRightFunc(string(r[:cut]), func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsPunct(r)
+	}) + "…"
+}
@@ -0,0 +1,88 @@
+package content
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestSentences(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want []string
+	}{
+		{"یادگیری ماشین چیست؟ پاسخ ساده است. ادامه دارد", []string{"یادگیری ماشین چیست؟", "پاسخ ساده است.", "ادامه دارد"}},
+		{"واقعاً؟! بله… تمام.", []string{"واقعاً؟!", "بله…", "تمام."}},
+		// A dot inside a number or name does not end a sentence.
+		{"Go 1.24 is out. example.com works", []string{"Go 1.24 is out.", "example.com works"}},
+		{"پایان۔ شروع", []string{"پایان۔", "شروع"}},
+		{"  ", nil},
+	} {
+		if got := Sentences(tc.in); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("Sentences(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestTruncateWords(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		max  int
+		want string
+	}{
+		{"کوتاه است", 20, "کوتاه است"},
+		{"یک دو سه چهار", 10, "یک دو سه…"},
+		// Punctuation before the cut is dropped so the ellipsis does not follow it.
+		{"اول، دوم سوم", 6, "اول…"},
+		{"abcdefghij", 5, "abcd…"},
+	} {
+		got := truncateWords(tc.in, tc.max)
+		if got != tc.want {
+			t.Errorf("truncateWords(%q, %d) = %q, want %q", tc.in, tc.max, got, tc.want)
+		}
+		if n := utf8.RuneCountInString(got); n > tc.max {
+			t.Errorf("truncateWords(%q, %d) has %d characters", tc.in, tc.max, n)
+		}
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	s1 := "هوش مصنوعی در سال‌های اخیر رشد زیادی داشته است."
+	s2 := "آیا این رشد ادامه خواهد داشت؟"
+	s3 := "پاسخ به داده‌ها و سخت‌افزار بستگی دارد."
+	para := "<p>" + s1 + " " + s2 + " " + s3 + "</p>"
+	n1, n2 := utf8.RuneCountInString(s1), utf8.RuneCountInString(s2)
+
+	for _, tc := range []struct {
+		name     string
+		in       string
+		min, max int
+		want     string
+	}{
+		{"whole sentences within max", para, 10, n1 + 1 + n2, s1 + " " + s2},
+		{"one character short", para, 10, n1 + n2, s1},
+		{"stops at a paragraph once min is reached",
+			"<p>" + s1 + "</p><p>" + s2 + " " + s3 + "</p>", 10, 500, s1},
+		{"continues into the next paragraph below min",
+			"<p>" + s1 + "</p><p>" + s2 + " " + s3 + "</p>", n1 + 5, 500, s1 + " " + s2 + " " + s3},
+		// Paragraphs shorter than minParagraphRunes read as labels.
+		{"skips short paragraphs", "<p>برچسب کوتاه</p>" + para, 10, n1, s1},
+		{"skips cta, toc and captions",
+			`<nav class="toc"><p>` + s2 + " " + s3 + `</p></nav><section class="cta-section"><p>` + s3 + " " + s2 + `</p></section>` +
+				`<figure><figcaption>` + s2 + " " + s3 + `</figcaption></figure>` + para, 10, n1, s1},
+		{"first sentence too long", "<p>" + s1 + "</p>", 10, 20, "هوش مصنوعی در…"},
+		{"empty", "", 10, 100, ""},
+	} {
+		got := Summarize(tc.in, tc.min, tc.max)
+		if got != tc.want {
+			t.Errorf("%s: Summarize = %q, want %q", tc.name, got, tc.want)
+		}
+		if n := utf8.RuneCountInString(got); n > tc.max {
+			t.Errorf("%s: %d characters, over max %d", tc.name, n, tc.max)
+		}
+		if strings.HasSuffix(got, "…") != strings.HasPrefix(tc.name, "first sentence") {
+			t.Errorf("%s: Summarize = %q, ellipsis only when a sentence is cut", tc.name, got)
+		}
+	}
+}
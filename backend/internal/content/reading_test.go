@@ -0,0 +1,56 @@
+package content
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMeasure(t *testing.T) {
+	for _, tc := range []struct {
+		name, in string
+		want     ReadingStats
+	}{
+		// "می‌خواهم" and "کتاب‌ها" are single words joined by a half-space (ZWNJ).
+		{"zwnj joins words", "<p>من می‌خواهم کتاب‌ها را بخوانم</p>",
+			ReadingStats{Words: 5, Chars: 23, PersianWords: 5, Minutes: 1}},
+		// Numbers are words; outside code a dot splits them, and Persian digits
+		// are Arabic script.
+		{"digits are words", "<p>Go 1.24 shipped in 2025 with ۳ fixes</p>",
+			ReadingStats{Words: 9, Chars: 29, PersianWords: 1, Minutes: 1}},
+		{"mixed scripts", "<p>RAG یعنی retrieval-augmented generation</p>",
+			ReadingStats{Words: 5, Chars: 36, PersianWords: 1, Minutes: 1}},
+		{"code reads as identifiers", "<p>Call</p><pre><code>fmt.Println(user_id)</code></pre>",
+			ReadingStats{Words: 3, Chars: 24, CodeWords: 2, Minutes: 1}},
+		{"skipped sections", `<p>one</p><script>var x</script><section class="cta-section"><p>two three</p></section><nav class="toc"><a>four</a></nav>`,
+			ReadingStats{Words: 1, Chars: 3, Minutes: 1}},
+		{"empty", "", ReadingStats{Minutes: 1}},
+	} {
+		if got := Measure(tc.in); got != tc.want {
+			t.Errorf("%s: Measure = %+v, want %+v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestMeasureMinutes(t *testing.T) {
+	words := func(w string, n int) string { return strings.TrimSpace(strings.Repeat(w+" ", n)) }
+	imgs := func(n int) string { return strings.Repeat(`<img src="a.png">`, n) }
+	for _, tc := range []struct {
+		name string
+		in   string
+		want int
+	}{
+		// Minutes round up: any started minute counts.
+		{"exactly one minute of Persian", "<p>" + words("کتاب", PersianWPM) + "</p>", 1},
+		{"one Persian word over", "<p>" + words("کتاب", PersianWPM+1) + "</p>", 2},
+		{"Latin reads faster", "<p>" + words("book", PersianWPM+1) + "</p>", 1},
+		{"one Latin word over", "<p>" + words("book", LatinWPM+1) + "</p>", 2},
+		{"code reads slower", "<pre>" + words("x", CodeWPM+1) + "</pre>", 2},
+		// 12+11+10+9+8+7 = 57 seconds of images, plus a little text, crosses a minute.
+		{"images", "<p>" + words("book", 20) + "</p>" + imgs(6), 2},
+		{"images floor at three seconds", imgs(20), 2},
+	} {
+		if got := Measure(tc.in).Minutes; got != tc.want {
+			t.Errorf("%s: Minutes = %d, want %d", tc.name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package content
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+)
+
+// Title returns the text of the first <h1> (or <h2> if there is no h1) in the given
+// HTML fragment, or fallback when neither exists.
+func Title(htmlStr, fallback string) string {
+	doc, err := html.Parse(strings.NewReader(htmlStr))
+	if err != nil {
+		return fallback
+	}
+	for _, tag := range []string{"h1", "h2"} {
+		if n := findElement(doc, tag); n != nil {
+			if t := collapseSpace(nodeText(n)); t != "" {
+				return t
+			}
+		}
+	}
+	return fallback
+}
+
+// minParagraphRunes is the length below which a paragraph is considered a label
+// (e.g. "Author: ...") rather than body text.
+const minParagraphRunes = 40
+
+// FirstParagraph returns the whitespace-collapsed text of the first substantial <p>
+// (falling back to the first non-empty one), truncated to at most max runes
+// (0 means no limit) with a trailing ellipsis.
+func FirstParagraph(htmlStr string, max int) string {
+	doc, err := html.Parse(strings.NewReader(htmlStr))
+	if err != nil {
+		return ""
+	}
+	var first, out string
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if out != "" {
+			return
+		}
+		if n.Type == html.ElementNode && n.Data == "p" {
+			t := collapseSpace(nodeText(n))
+			if first == "" {
+				first = t
+			}
+			if utf8.RuneCountInString(t) >= minParagraphRunes {
+				out = t
+			}
+			return
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+	if out == "" {
+		out = first
+	}
+	if max > 0 && utf8.RuneCountInString(out) > max {
+		r := []rune(out)
+		out = strings.TrimSpace(string(r[:max])) + "…"
+	}
+	return out
+}
+
+// PlainText returns the visible text of an HTML fragment with one paragraph
+// per line, skipping scripts, styles, CTA sections and the table of contents.
+func PlainText(fragment string) string {
+	blocks := Blocks(fragment)
+	lines := make([]string, len(blocks))
+	for i, b := range blocks {
+		lines[i] = b.Text
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Block is a run of visible text and the innermost block element holding it.
+type Block struct {
+	Tag  string
+	Text string
+}
+
+// Blocks splits an HTML fragment into its text blocks (paragraphs, headings,
+// list items…) in document order, skipping the same content as PlainText.
+// Text outside any block element has an empty Tag.
+func Blocks(fragment string) []Block {
+	nodes, err := parseBody(fragment)
+	if err != nil {
+		return nil
+	}
+	var out []Block
+	var tags []string
+	var inline strings.Builder
+	flush := func() {
+		if t := collapseSpace(inline.String()); t != "" {
+			tag := ""
+			if len(tags) > 0 {
+				tag = tags[len(tags)-1]
+			}
+			out = append(out, Block{Tag: tag, Text: t})
+		}
+		inline.Reset()
+	}
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		switch n.Type {
+		case html.TextNode:
+			inline.WriteString(n.Data)
+			return
+		case html.ElementNode:
+			switch {
+			case n.Data == "script" || n.Data == "style" || n.Data == "template":
+				return
+			case hasClass(n, "cta-section"), n.Data == "nav" && hasClass(n, "toc"):
+				return
+			case isBlock(n.Data):
+				flush()
+				tags = append(tags, n.Data)
+				defer func() {
+					flush()
+					tags = tags[:len(tags)-1]
+				}()
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	for _, n := range nodes {
+		walk(n)
+	}
+	flush()
+	return out
+}
+
+func isBlock(tag string) bool {
+	switch tag {
+	case "p", "div", "section", "article", "h1", "h2", "h3", "h4", "h5", "h6",
+		"li", "ul", "ol", "blockquote", "pre", "table", "tr", "td", "th", "figure", "figcaption", "br", "hr":
+		return true
+	}
+	return false
+}
+
+func findElement(n *html.Node, tag string) *html.Node {
+	if n.Type == html.ElementNode && n.Data == tag {
+		return n
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if found := findElement(c, tag); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// nodeText concatenates all text below n, skipping script and style contents.
+func nodeText(n *html.Node) string {
+	var b strings.Builder
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && (n.Data == "script" || n.Data == "style") {
+			return
+		}
+		if n.Type == html.TextNode {
+			b.WriteString(n.Data)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(n)
+	return b.String()
+}
+
+func collapseSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,226 @@
+package content
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"landing/backend/internal/slug"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// TOCMarker is replaced with the table of contents by AnchorHeadings.
+const TOCMarker = `<nav class="toc"></nav>`
+
+// tocLabel is the accessible name of the injected table of contents.
+const tocLabel = "فهرست مطالب"
+
+// TOCEntry is a heading in the table of contents. Children holds the
+// lower-level headings that follow it.
+type TOCEntry struct {
+	ID       string     `json:"id"`
+	Text     string     `json:"text"`
+	Level    int        `json:"level"`
+	Children []TOCEntry `json:"children,omitempty"`
+}
+
+// AnchorHeadings gives every h2–h4 an id derived from its text, keeping ids
+// that are already set, and fills empty <nav class="toc"> elements with a
+// nested list of links. Ids are stable: the same headings always produce the
+// same ids, and repeats get a numeric suffix. Headings inside CTA sections and
+// the TOC itself are skipped.
+func AnchorHeadings(fragment string) string {
+	nodes, err := parseBody(fragment)
+	if err != nil {
+		return fragment
+	}
+
+	used := map[string]bool{}
+	for _, n := range nodes {
+		walkElements(n, func(e *html.Node) bool {
+			if id := attr(e, "id"); id != "" {
+				used[id] = true
+			}
+			return true
+		})
+	}
+	var navs []*html.Node
+	for _, n := range nodes {
+		walkHeadings(n, func(h *html.Node) {
+			if attr(h, "id") != "" {
+				return
+			}
+			base := slug.Make(nodeText(h))
+			if base == "" {
+				base = "section"
+			}
+			id := base
+			for i := 2; used[id]; i++ {
+				id = base + "-" + strconv.Itoa(i)
+			}
+			used[id] = true
+			h.Attr = append(h.Attr, html.Attribute{Key: "id", Val: id})
+		}, func(nav *html.Node) {
+			if nav.FirstChild == nil {
+				navs = append(navs, nav)
+			}
+		})
+	}
+
+	if len(navs) > 0 {
+		entries := tocFromNodes(nodes)
+		for _, nav := range navs {
+			if len(entries) == 0 {
+				nodes = removeNode(nodes, nav)
+				continue
+			}
+			nav.Attr = append(nav.Attr, html.Attribute{Key: "aria-label", Val: tocLabel})
+			nav.AppendChild(tocList(entries))
+		}
+	}
+
+	var buf bytes.Buffer
+	for _, n := range nodes {
+		if err := html.Render(&buf, n); err != nil {
+			return fragment
+		}
+	}
+	return buf.String()
+}
+
+// removeNode detaches n from the tree. Top-level fragment nodes have no
+// parent, so those are dropped from nodes instead.
+func removeNode(nodes []*html.Node, n *html.Node) []*html.Node {
+	if n.Parent != nil {
+		n.Parent.RemoveChild(n)
+		return nodes
+	}
+	out := nodes[:0]
+	for _, m := range nodes {
+		if m != n {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// TOC returns the nested table of contents of the h2–h4 headings that have ids.
+func TOC(fragment string) []TOCEntry {
+	nodes, err := parseBody(fragment)
+	if err != nil {
+		return nil
+	}
+	return tocFromNodes(nodes)
+}
+
+func tocFromNodes(nodes []*html.Node) []TOCEntry {
+	var flat []TOCEntry
+	for _, n := range nodes {
+		walkHeadings(n, func(h *html.Node) {
+			if id := attr(h, "id"); id != "" {
+				flat = append(flat, TOCEntry{ID: id, Text: collapseSpace(nodeText(h)), Level: headingLevel(h)})
+			}
+		}, nil)
+	}
+	entries, _ := nestTOC(flat, 0)
+	return entries
+}
+
+// nestTOC groups flat entries under the preceding entry of a lower level,
+// starting at flat[0]; it returns the entries and how many it consumed.
+func nestTOC(flat []TOCEntry, parentLevel int) ([]TOCEntry, int) {
+	var out []TOCEntry
+	i := 0
+	for i < len(flat) && flat[i].Level > parentLevel {
+		e := flat[i]
+		i++
+		children, n := nestTOC(flat[i:], e.Level)
+		e.Children = children
+		i += n
+		out = append(out, e)
+	}
+	return out, i
+}
+
+func tocList(entries []TOCEntry) *html.Node {
+	ol := &html.Node{Type: html.ElementNode, Data: "ol", DataAtom: atom.Ol}
+	for _, e := range entries {
+		li := &html.Node{Type: html.ElementNode, Data: "li", DataAtom: atom.Li}
+		a := &html.Node{Type: html.ElementNode, Data: "a", DataAtom: atom.A, Attr: []html.Attribute{{Key: "href", Val: "#" + e.ID}}}
+		a.AppendChild(&html.Node{Type: html.TextNode, Data: e.Text})
+		li.AppendChild(a)
+		if len(e.Children) > 0 {
+			li.AppendChild(tocList(e.Children))
+		}
+		ol.AppendChild(li)
+	}
+	return ol
+}
+
+// walkHeadings calls onHeading for each h2–h4 and onNav for each <nav class="toc">,
+// without descending into CTA sections or TOC navs.
+func walkHeadings(n *html.Node, onHeading func(*html.Node), onNav func(*html.Node)) {
+	walkElements(n, func(e *html.Node) bool {
+		switch {
+		case hasClass(e, "cta-section"):
+			return false
+		case e.Data == "nav" && hasClass(e, "toc"):
+			if onNav != nil {
+				onNav(e)
+			}
+			return false
+		case headingLevel(e) > 0:
+			onHeading(e)
+			return false
+		}
+		return true
+	})
+}
+
+// walkElements visits element nodes depth-first; visit returns false to skip children.
+func walkElements(n *html.Node, visit func(*html.Node) bool) {
+	if n.Type == html.ElementNode && !visit(n) {
+		return
+	}
+	for c := n.FirstChild; c != nil; {
+		next := c.NextSibling
+		walkElements(c, visit)
+		c = next
+	}
+}
+
+func headingLevel(n *html.Node) int {
+	switch n.DataAtom {
+	case atom.H2:
+		return 2
+	case atom.H3:
+		return 3
+	case atom.H4:
+		return 4
+	}
+	return 0
+}
+
+func parseBody(fragment string) ([]*html.Node, error) {
+	return html.ParseFragment(strings.NewReader(fragment), &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body})
+}
+
+func hasClass(n *html.Node, class string) bool {
+	for _, c := range strings.Fields(attr(n, "class")) {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
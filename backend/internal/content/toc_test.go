@@ -0,0 +1,66 @@
+package content
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestAnchorHeadingsRemovesEmptyTOC(t *testing.T) {
+	cases := []struct {
+		name, in, want string
+	}{
+		{"top level", `<nav class="toc"></nav><p>hello</p>`, `<p>hello</p>`},
+		{"nested", `<div><nav class="toc"></nav></div><p>hello</p>`, `<div></div><p>hello</p>`},
+		{"only the nav", `<nav class="toc"></nav>`, ``},
+		// {TOC} in its own paragraph: the parser moves the nav out of the <p>.
+		{"from placeholder", `<p><nav class="toc"></nav></p><p>hello world text</p>`, `<p></p><p></p><p>hello world text</p>`},
+	}
+	for _, tc := range cases {
+		if got := AnchorHeadings(tc.in); got != tc.want {
+			t.Errorf("%s: AnchorHeadings(%q) = %q, want %q", tc.name, tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestAnchorHeadingsFillsNestedTOC(t *testing.T) {
+	in := `<nav class="toc"></nav>` +
+		`<h2>Intro</h2><h3>Details</h3><h4>Fine print</h4><h3>Details</h3>` +
+		`<h2 id="custom">Next</h2><div><nav class="toc"></nav></div>`
+	got := AnchorHeadings(in)
+
+	for _, want := range []string{
+		`<h2 id="intro">Intro</h2>`,
+		`<h3 id="details">Details</h3>`,
+		`<h4 id="fine-print">Fine print</h4>`,
+		`<h3 id="details-2">Details</h3>`,
+		`<h2 id="custom">Next</h2>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("AnchorHeadings output lacks %s:\n%s", want, got)
+		}
+	}
+	list := `<ol><li><a href="#intro">Intro</a><ol>` +
+		`<li><a href="#details">Details</a><ol><li><a href="#fine-print">Fine print</a></li></ol></li>` +
+		`<li><a href="#details-2">Details</a></li></ol></li>` +
+		`<li><a href="#custom">Next</a></li></ol>`
+	if n := strings.Count(got, list); n != 2 {
+		t.Errorf("want the nested list in both navs, found it %d times:\n%s", n, got)
+	}
+
+	want := []TOCEntry{
+		{ID: "intro", Text: "Intro", Level: 2, Children: []TOCEntry{
+			{ID: "details", Text: "Details", Level: 3, Children: []TOCEntry{
+				{ID: "fine-print", Text: "Fine print", Level: 4},
+			}},
+			{ID: "details-2", Text: "Details", Level: 3},
+		}},
+		{ID: "custom", Text: "Next", Level: 2},
+	}
+	if toc := TOC(got); !reflect.DeepEqual(toc, want) {
+		t.Errorf("TOC = %+v, want %+v", toc, want)
+	}
+	if again := AnchorHeadings(got); again != got {
+		t.Errorf("AnchorHeadings is not stable:\n%s\n%s", got, again)
+	}
+}
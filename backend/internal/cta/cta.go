@@ -0,0 +1,205 @@
+// Package cta detects and inserts call-to-action blocks in post HTML.
+package cta
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// Placements of a CTA block.
+const (
+	PlaceEnd            = "end"
+	PlaceAfterParagraph = "after_paragraph"
+)
+
+// autoAttr marks blocks inserted by Insert so Remove leaves author-written CTAs alone.
+const autoAttr = "data-cta"
+
+// Block is a call to action rendered as a <section class="cta-section">.
+type Block struct {
+	// ID identifies the stored block; 0 for the built-in default.
+	ID         int
+	Heading    string
+	Body       string
+	ButtonText string
+	ButtonURL  string
+	Placement  string
+	// Paragraph is N for PlaceAfterParagraph: the block follows the Nth paragraph.
+	Paragraph int
+}
+
+// Default is used when no CTA block is configured for a post.
+var Default = Block{
+	Heading:    "آماده شروع هستید؟",
+	Body:       "برای مشاوره و شروع همکاری با ما در ارتباط باشید.",
+	ButtonText: "تماس با ما",
+	ButtonURL:  "/contact",
+	Placement:  PlaceEnd,
+}
+
+// HTML renders the block. Text is escaped; the block is marked as auto-inserted.
+func (b Block) HTML() string {
+	var s strings.Builder
+	s.WriteString(`<section class="cta-section" ` + autoAttr + `="` + strconv.Itoa(b.ID) + `"><div class="cta-container">`)
+	if b.Heading != "" {
+		s.WriteString("<h3>" + html.EscapeString(b.Heading) + "</h3>")
+	}
+	if b.Body != "" {
+		s.WriteString("<p>" + html.EscapeString(b.Body) + "</p>")
+	}
+	if b.ButtonText != "" && b.ButtonURL != "" {
+		s.WriteString(`<div class="cta-buttons"><a href="` + html.EscapeString(b.ButtonURL) + `" class="cta-button primary">` + html.EscapeString(b.ButtonText) + "</a></div>")
+	}
+	s.WriteString("</div></section>")
+	return s.String()
+}
+
+// IsCTA reports whether n is a call to action: a CTA section or a link to the contact page.
+func IsCTA(n *html.Node) bool {
+	if n.Type != html.ElementNode {
+		return false
+	}
+	for _, cls := range strings.Fields(attr(n, "class")) {
+		if cls == "cta-section" {
+			return true
+		}
+	}
+	if n.Data == "a" {
+		if u, err := url.Parse(strings.TrimSpace(attr(n, "href"))); err == nil && u.Host == "" {
+			return strings.TrimSuffix(u.Path, "/") == "/contact"
+		}
+	}
+	return false
+}
+
+// Present reports whether doc already contains a call to action.
+func Present(doc string) bool {
+	root, err := html.Parse(strings.NewReader(doc))
+	if err != nil {
+		return false
+	}
+	var found bool
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if found {
+			return
+		}
+		if IsCTA(n) {
+			found = true
+			return
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(root)
+	return found
+}
+
+// Insert places b in doc according to its placement. Paragraph placement falls
+// back to the end when doc has fewer paragraphs. The rest of doc is kept byte for byte.
+func Insert(doc string, b Block) string {
+	if b.Placement == PlaceAfterParagraph && b.Paragraph > 0 {
+		if at := paragraphEnd(doc, b.Paragraph); at >= 0 {
+			return doc[:at] + b.HTML() + doc[at:]
+		}
+	}
+	return doc + "\n" + b.HTML()
+}
+
+// paragraphEnd returns the byte offset just after the nth closing </p> outside
+// other CTA blocks, or -1.
+func paragraphEnd(doc string, n int) int {
+	z := html.NewTokenizer(strings.NewReader(doc))
+	offset, count, skipDepth := 0, 0, 0
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			return -1
+		}
+		offset += len(z.Raw())
+		name, _ := z.TagName()
+		switch tt {
+		case html.StartTagToken:
+			if skipDepth > 0 && string(name) == "section" {
+				skipDepth++
+			} else if string(name) == "section" && strings.Contains(string(z.Raw()), "cta-section") {
+				skipDepth = 1
+			}
+		case html.EndTagToken:
+			switch {
+			case skipDepth > 0 && string(name) == "section":
+				skipDepth--
+			case skipDepth == 0 && string(name) == "p":
+				count++
+				if count == n {
+					return offset
+				}
+			}
+		}
+	}
+}
+
+// Remove strips blocks previously added by Insert, along with the newline
+// Insert puts before a block at the end. The rest of doc is kept byte for byte.
+func Remove(doc string) string {
+	z := html.NewTokenizer(strings.NewReader(doc))
+	var b strings.Builder
+	depth := 0
+	// removedAt is the output length where the last removed block was.
+	removedAt := -1
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			break
+		}
+		// Copy before TagName, which lowercases the name in the raw bytes.
+		raw := append([]byte(nil), z.Raw()...)
+		name, hasAttr := z.TagName()
+		switch {
+		case depth > 0:
+			if string(name) == "section" {
+				if tt == html.StartTagToken {
+					depth++
+				} else if tt == html.EndTagToken {
+					depth--
+				}
+			}
+			continue
+		case tt == html.StartTagToken && string(name) == "section" && hasAttr && isAuto(z):
+			depth = 1
+			removedAt = b.Len()
+			continue
+		}
+		b.Write(raw)
+	}
+	out := b.String()
+	if removedAt == len(out) {
+		out = strings.TrimSuffix(out, "\n")
+	}
+	return out
+}
+
+func isAuto(z *html.Tokenizer) bool {
+	for {
+		key, _, more := z.TagAttr()
+		if string(key) == autoAttr {
+			return true
+		}
+		if !more {
+			return false
+		}
+	}
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
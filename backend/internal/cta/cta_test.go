@@ -0,0 +1,79 @@
+package cta
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestInsert(t *testing.T) {
+	b := Block{ID: 7, Heading: "H", Placement: PlaceAfterParagraph, Paragraph: 2}
+	block := b.HTML()
+	for _, tc := range []struct {
+		name, doc, want string
+		block           Block
+	}{
+		{"end", "<p>a</p>", "<p>a</p>\n" + Default.HTML(), Default},
+		{"after paragraph", "<p>a</p><p>b</p><p>c</p>", "<p>a</p><p>b</p>" + block + "<p>c</p>", b},
+		{"after last paragraph", "<p>a</p>\n<p>b</p>", "<p>a</p>\n<p>b</p>" + block, b},
+		{"too few paragraphs", "<p>a</p>", "<p>a</p>\n" + block, b},
+		{"zero paragraph means end", "<p>a</p><p>b</p>", "<p>a</p><p>b</p>\n" + Block{Placement: PlaceAfterParagraph}.HTML(), Block{Placement: PlaceAfterParagraph}},
+		// Paragraphs inside an existing CTA, even in a nested section, do not count.
+		{"skips cta paragraphs",
+			`<p>a</p><section class="cta-section"><section><p>x</p></section><p>y</p></section><p>b</p><p>c</p>`,
+			`<p>a</p><section class="cta-section"><section><p>x</p></section><p>y</p></section><p>b</p>` + block + `<p>c</p>`, b},
+		{"other sections count", "<section><p>a</p></section><p>b</p>", "<section><p>a</p></section><p>b</p>" + block, b},
+	} {
+		if got := Insert(tc.doc, tc.block); got != tc.want {
+			t.Errorf("%s:\n got %s\nwant %s", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestRemove(t *testing.T) {
+	auto := Block{ID: 3, Heading: "H", Body: "B", ButtonText: "T", ButtonURL: "/contact"}.HTML()
+	author := `<section class="cta-section"><h3>Call us</h3><a href="/contact">x</a></section>`
+	for _, tc := range []struct {
+		name, doc, want string
+	}{
+		{"auto block at end", "<p>a</p>\n" + auto, "<p>a</p>"},
+		{"auto block mid-document", "<p>a</p>" + auto + "<p>b</p>", "<p>a</p><p>b</p>"},
+		{"author block kept", "<p>a</p>" + author, "<p>a</p>" + author},
+		{"author block kept beside auto block", author + "<p>a</p>\n" + auto, author + "<p>a</p>"},
+		{"nested sections", `<p>a</p><section class="cta-section" data-cta="0"><section><p>x</p></section><p>y</p></section><p>b</p>`, "<p>a</p><p>b</p>"},
+		{"no block", "<p>a</p>", "<p>a</p>"},
+	} {
+		if got := Remove(tc.doc); got != tc.want {
+			t.Errorf("%s:\n got %s\nwant %s", tc.name, got, tc.want)
+		}
+	}
+}
+
+// TestInsertRemoveRoundTrip checks that Remove(Insert(doc)) gives doc back
+// byte for byte, whatever the markup style of doc.
+func TestInsertRemoveRoundTrip(t *testing.T) {
+	docs := []string{
+		"<p>a</p>",
+		"<p>a</p>\n",
+		"<P CLASS=x>a<BR>b</P>\n\n<p>c &amp; d</p>",
+		`<h2 id="x">T</h2><p>a<!-- note --></p><img src="a.png" alt=a><p>b</p>`,
+		"<section class=\"cta-section\"><p>author</p></section><p>a</p>",
+		"متن بدون پاراگراف",
+		"",
+	}
+	blocks := []Block{
+		Default,
+		{ID: 1, Heading: "H", Placement: PlaceAfterParagraph, Paragraph: 1},
+		{ID: 2, Body: "B", Placement: PlaceAfterParagraph, Paragraph: 5},
+	}
+	for _, doc := range docs {
+		for _, b := range blocks {
+			inserted := Insert(doc, b)
+			if !strings.Contains(inserted, b.HTML()) {
+				t.Errorf("Insert(%q, %d) = %q, block missing", doc, b.ID, inserted)
+			}
+			if got := Remove(inserted); got != doc {
+				t.Errorf("Remove(Insert(%q, %d)) = %q", doc, b.ID, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"landing/backend/ent"
+	"landing/backend/ent/blog"
+	"landing/backend/ent/category"
+	"landing/backend/internal/logging"
+	"landing/backend/internal/slug"
+)
+
+// EnsureCategory returns the category identified by the normalized form of name,
+// creating it (with name as its "fa" display name) if it does not exist yet.
+func EnsureCategory(ctx context.Context, client *ent.Client, name string) (*ent.Category, error) {
+	s := slug.Make(name)
+	if s == "" {
+		return nil, fmt.Errorf("category %q has no usable slug", name)
+	}
+	cat, err := client.Category.Query().Where(category.SlugEQ(s)).Only(ctx)
+	if err == nil {
+		return cat, nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+	cat, err = client.Category.Create().
+		SetSlug(s).
+		SetName(map[string]string{"fa": name}).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		// Lost a race with a concurrent insert; read the winner.
+		return client.Category.Query().Where(category.SlugEQ(s)).Only(ctx)
+	}
+	return cat, err
+}
+
+// BackfillCategories converts legacy free-form blog category strings into Category
+// entities. Every blog without a linked category gets one (created on demand from the
+// normalized string), and its category string is rewritten to the canonical slug.
+// It is idempotent and safe to run on every migration.
+func BackfillCategories(ctx context.Context, client *ent.Client) error {
+	items, err := client.Blog.Query().Where(blog.CategoryIDIsNil()).All(ctx)
+	if err != nil {
+		return fmt.Errorf("backfill categories: list blogs: %w", err)
+	}
+	linked := 0
+	for _, b := range items {
+		cat, err := EnsureCategory(ctx, client, b.Category)
+		if err != nil {
+			logging.FromContext(ctx).Warn("backfill categories: skipping blog", "path", b.Path, logging.Err(err))
+			continue
+		}
+		if err := client.Blog.UpdateOneID(b.ID).
+			SetPrimaryCategory(cat).
+			SetCategory(cat.Slug).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("backfill categories: link blog '%s': %w", b.Path, err)
+		}
+		linked++
+	}
+	if linked > 0 {
+		logging.FromContext(ctx).Info("backfill categories", "linked", linked)
+	}
+	return nil
+}
+
+// CategorySubtreeIDs returns the ID of the given category plus the IDs of all of its
+// descendants, so filters on a parent category also match posts in sub-categories.
+func CategorySubtreeIDs(ctx context.Context, client *ent.Client, root *ent.Category) ([]int, error) {
+	ids := []int{root.ID}
+	frontier := []int{root.ID}
+	seen := map[int]bool{root.ID: true}
+	for len(frontier) > 0 {
+		children, err := client.Category.Query().Where(category.ParentIDIn(frontier...)).IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		frontier = frontier[:0]
+		for _, id := range children {
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			ids = append(ids, id)
+			frontier = append(frontier, id)
+		}
+	}
+	return ids, nil
+}
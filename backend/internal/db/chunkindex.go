@@ -0,0 +1,137 @@
+package db
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"landing/backend/ent"
+	"landing/backend/ent/blog"
+	"landing/backend/internal/ai/rag"
+	"landing/backend/internal/content"
+)
+
+// ChunkIndex is an immutable snapshot of the passages of every post with
+// their embeddings, so that related posts, search and ask compare vectors
+// without loading all chunks from the database on each request. Returned
+// slices are shared and must not be modified.
+type ChunkIndex struct {
+	posts map[int][]rag.Chunk
+	all   []rag.Chunk
+}
+
+// Chunks returns the passages of all posts, ordered by post ID and position.
+func (ix *ChunkIndex) Chunks() []rag.Chunk { return ix.all }
+
+// Vectors returns the non-empty chunk embeddings of the post with id, in order.
+func (ix *ChunkIndex) Vectors(id int) [][]float32 {
+	var out [][]float32
+	for _, ch := range ix.posts[id] {
+		if len(ch.Embedding) > 0 {
+			out = append(out, ch.Embedding)
+		}
+	}
+	return out
+}
+
+// with returns a copy of ix in which the post with id has chunks.
+func (ix *ChunkIndex) with(id int, chunks []rag.Chunk) *ChunkIndex {
+	posts := make(map[int][]rag.Chunk, len(ix.posts)+1)
+	for k, v := range ix.posts {
+		posts[k] = v
+	}
+	if len(chunks) > 0 {
+		posts[id] = chunks
+	} else {
+		delete(posts, id)
+	}
+	return newChunkIndex(posts)
+}
+
+func newChunkIndex(posts map[int][]rag.Chunk) *ChunkIndex {
+	ids := make([]int, 0, len(posts))
+	n := 0
+	for id, chunks := range posts {
+		ids = append(ids, id)
+		n += len(chunks)
+	}
+	sort.Ints(ids)
+	all := make([]rag.Chunk, 0, n)
+	for _, id := range ids {
+		all = append(all, posts[id]...)
+	}
+	return &ChunkIndex{posts: posts, all: all}
+}
+
+// chunkIndex is the process-wide index: loaded on first use, updated by
+// SyncChunks and dropped (to be reloaded) after RefreshEmbeddings or a blog
+// deletion. indexMu serializes loads and updates so that an update never
+// lands on a snapshot loaded before it was written.
+var (
+	chunkIndex atomic.Pointer[ChunkIndex]
+	indexMu    sync.Mutex
+)
+
+// SharedChunkIndex returns the chunk index, loading it from client on first use.
+func SharedChunkIndex(ctx context.Context, client *ent.Client) (*ChunkIndex, error) {
+	if ix := chunkIndex.Load(); ix != nil {
+		return ix, nil
+	}
+	indexMu.Lock()
+	defer indexMu.Unlock()
+	if ix := chunkIndex.Load(); ix != nil {
+		return ix, nil
+	}
+	items, err := client.BlogChunk.Query().
+		WithBlog(func(q *ent.BlogQuery) { q.Select(blog.FieldPath, blog.FieldText) }).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Rows come unordered; sort so every post's passages stay in position order.
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].BlogID != items[j].BlogID {
+			return items[i].BlogID < items[j].BlogID
+		}
+		return items[i].Position < items[j].Position
+	})
+	titles := map[int]string{}
+	posts := map[int][]rag.Chunk{}
+	for _, ch := range items {
+		b := ch.Edges.Blog
+		if b == nil {
+			continue
+		}
+		title, ok := titles[b.ID]
+		if !ok {
+			title = content.Title(b.Text, b.Path)
+			titles[b.ID] = title
+		}
+		posts[b.ID] = append(posts[b.ID], rag.Chunk{
+			Source:    rag.Source{Path: b.Path, Title: title, Heading: ch.Heading, Text: ch.Text},
+			Embedding: ch.Embedding,
+		})
+	}
+	ix := newChunkIndex(posts)
+	chunkIndex.Store(ix)
+	return ix, nil
+}
+
+// indexPost replaces the passages of the post with id in a loaded index. An
+// index that is not loaded yet will read them from the database.
+func indexPost(id int, chunks []rag.Chunk) {
+	indexMu.Lock()
+	defer indexMu.Unlock()
+	if ix := chunkIndex.Load(); ix != nil {
+		chunkIndex.Store(ix.with(id, chunks))
+	}
+}
+
+// resetChunkIndex drops the index so that the next SharedChunkIndex call
+// reloads it, picking up writes made outside this process (e.g. by cmd/migrate).
+func resetChunkIndex() {
+	indexMu.Lock()
+	defer indexMu.Unlock()
+	chunkIndex.Store(nil)
+}
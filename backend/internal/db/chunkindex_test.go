@@ -0,0 +1,60 @@
+package db
+
+import (
+	"reflect"
+	"testing"
+
+	"landing/backend/internal/ai/rag"
+)
+
+func chunk(path, text string, vec ...float32) rag.Chunk {
+	return rag.Chunk{Source: rag.Source{Path: path, Text: text}, Embedding: vec}
+}
+
+func chunkTexts(chunks []rag.Chunk) []string {
+	out := make([]string, len(chunks))
+	for i, ch := range chunks {
+		out[i] = ch.Text
+	}
+	return out
+}
+
+func TestChunkIndexWith(t *testing.T) {
+	ix := newChunkIndex(map[int][]rag.Chunk{
+		2: {chunk("b", "b0", 1, 0), chunk("b", "b1")},
+		1: {chunk("a", "a0", 0, 1)},
+	})
+	if got, want := chunkTexts(ix.Chunks()), []string{"a0", "b0", "b1"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Chunks() = %v, want %v", got, want)
+	}
+	if got := ix.Vectors(2); len(got) != 1 || got[0][0] != 1 {
+		t.Errorf("Vectors(2) = %v, want only the embedded chunk", got)
+	}
+
+	next := ix.with(1, []rag.Chunk{chunk("a", "a0'", 1, 1), chunk("a", "a1", 1, 1)}).with(2, nil)
+	if got, want := chunkTexts(next.Chunks()), []string{"a0'", "a1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("after with: Chunks() = %v, want %v", got, want)
+	}
+	if got := next.Vectors(2); got != nil {
+		t.Errorf("after removing post 2: Vectors(2) = %v, want nil", got)
+	}
+	// Snapshots are immutable: readers holding the old one are unaffected.
+	if got, want := chunkTexts(ix.Chunks()), []string{"a0", "b0", "b1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("old snapshot changed: Chunks() = %v, want %v", got, want)
+	}
+}
+
+func TestIndexPostWithoutLoadedIndex(t *testing.T) {
+	resetChunkIndex()
+	indexPost(1, []rag.Chunk{chunk("a", "a0")})
+	if chunkIndex.Load() != nil {
+		t.Fatal("indexPost loaded a partial index; it must be left to SharedChunkIndex")
+	}
+
+	chunkIndex.Store(newChunkIndex(map[int][]rag.Chunk{}))
+	t.Cleanup(resetChunkIndex)
+	indexPost(1, []rag.Chunk{chunk("a", "a0")})
+	if got := chunkTexts(chunkIndex.Load().Chunks()); !reflect.DeepEqual(got, []string{"a0"}) {
+		t.Errorf("Chunks() = %v, want [a0]", got)
+	}
+}
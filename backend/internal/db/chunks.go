@@ -0,0 +1,116 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"landing/backend/ent"
+	"landing/backend/ent/blogchunk"
+	"landing/backend/ent/hook"
+	"landing/backend/internal/ai/embeddings"
+	"landing/backend/internal/ai/rag"
+	"landing/backend/internal/config"
+	"landing/backend/internal/content"
+	"landing/backend/internal/logging"
+)
+
+// RegisterChunkHooks rebuilds a post's chunks whenever its text is created or
+// changed through client. Chunking is best-effort: a failure is logged and
+// the post is saved anyway; RefreshEmbeddings fills in posts left without chunks.
+func RegisterChunkHooks(client *ent.Client, cfg config.Config) {
+	client.Blog.Use(hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.BlogFunc(func(ctx context.Context, m *ent.BlogMutation) (ent.Value, error) {
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			if _, changed := m.Text(); !changed {
+				return v, nil
+			}
+			if b, ok := v.(*ent.Blog); ok {
+				if err := SyncChunks(ctx, m.Client(), cfg, b); err != nil {
+					logging.FromContext(ctx).Error("chunks: sync failed", "path", b.Path, logging.Err(err))
+				}
+			}
+			return v, nil
+		})
+	}, ent.OpCreate|ent.OpUpdateOne))
+	// Deleting posts cascades to their chunks; reload the index from the database.
+	client.Blog.Use(hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.BlogFunc(func(ctx context.Context, m *ent.BlogMutation) (ent.Value, error) {
+			v, err := next.Mutate(ctx, m)
+			if err == nil {
+				resetChunkIndex()
+			}
+			return v, err
+		})
+	}, ent.OpDelete|ent.OpDeleteOne))
+}
+
+// SyncChunks replaces the chunks of b with the passages of its current text.
+// Passages whose embedded text and model are unchanged (same content hash)
+// keep their vectors, and nothing is written when no passage changed.
+func SyncChunks(ctx context.Context, client *ent.Client, cfg config.Config, b *ent.Blog) error {
+	existing, err := client.BlogChunk.Query().
+		Where(blogchunk.BlogID(b.ID)).
+		Order(ent.Asc(blogchunk.FieldPosition)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("list chunks: %w", err)
+	}
+	passages := content.Passages(b.Text, content.PassageWords, content.PassageOverlap)
+	title := content.Title(b.Text, b.Path)
+	texts := make([]string, len(passages))
+	hashes := make([]string, len(passages))
+	unchanged := len(passages) == len(existing)
+	for i, p := range passages {
+		// The title and heading keep a short passage on its topic.
+		texts[i] = title + "\n" + p.Heading + "\n" + p.Text
+		hashes[i] = embeddings.ContentHash(cfg, texts[i])
+		if unchanged && (existing[i].EmbeddingHash != hashes[i] || existing[i].Heading != p.Heading || existing[i].Text != p.Text) {
+			unchanged = false
+		}
+	}
+	if unchanged {
+		return nil
+	}
+
+	reuse := map[string][]float32{}
+	for _, ch := range existing {
+		if ch.EmbeddingHash != "" && len(ch.Embedding) > 0 {
+			reuse[ch.EmbeddingHash] = ch.Embedding
+		}
+	}
+	if _, err := client.BlogChunk.Delete().Where(blogchunk.BlogID(b.ID)).Exec(ctx); err != nil {
+		return fmt.Errorf("delete chunks: %w", err)
+	}
+	if len(passages) == 0 {
+		indexPost(b.ID, nil)
+		return nil
+	}
+	builders := make([]*ent.BlogChunkCreate, len(passages))
+	indexed := make([]rag.Chunk, len(passages))
+	for i, p := range passages {
+		builders[i] = client.BlogChunk.Create().
+			SetBlogID(b.ID).
+			SetPosition(i).
+			SetHeading(p.Heading).
+			SetText(p.Text)
+		e, ok := reuse[hashes[i]]
+		if !ok {
+			e, _ = embeddings.Embed(ctx, cfg, texts[i])
+		}
+		if len(e) > 0 {
+			builders[i].SetEmbedding(e).SetEmbeddingHash(hashes[i])
+		}
+		indexed[i] = rag.Chunk{
+			Source:    rag.Source{Path: b.Path, Title: title, Heading: p.Heading, Text: p.Text},
+			Embedding: e,
+		}
+	}
+	if err := client.BlogChunk.CreateBulk(builders...).Exec(ctx); err != nil {
+		return fmt.Errorf("create chunks: %w", err)
+	}
+	indexPost(b.ID, indexed)
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 
 	"landing/backend/ent"
 	"landing/backend/internal/config"
+	"landing/backend/internal/metrics"
 )
 
 // OpenClient opens an Ent client using DATABASE_URL from config.
@@ -40,9 +41,12 @@ func OpenClient(ctx context.Context, cfg config.Config) (*ent.Client, error) {
 		}
 	}
 	base := entsql.OpenDB(dialect.Postgres, sqldb)
+	// Export pool statistics (open/in-use/idle connections, waits) of the driver's pool.
+	metrics.RegisterDB(base.DB(), "postgres")
     // Prevent accidental closure during runtime; allow closing only on shutdown.
-    wrapped := wrapKeepOpen(base)
+    wrapped := wrapKeepOpen(wrapTraced(base))
 	client := ent.NewClient(ent.Driver(wrapped))
+	RegisterChunkHooks(client, cfg)
 
 	// Auto-migrate in development environment
 	if cfg.IsDevelopment() {
@@ -50,6 +54,34 @@ func OpenClient(ctx context.Context, cfg config.Config) (*ent.Client, error) {
 			_ = client.Close()
 			return nil, fmt.Errorf("failed running schema migrations: %w", err)
 		}
+		if err := BackfillSlugs(ctx, client); err != nil {
+			_ = client.Close()
+			return nil, err
+		}
+		if err := BackfillCategories(ctx, client); err != nil {
+			_ = client.Close()
+			return nil, err
+		}
+		if err := BackfillDisplayNames(ctx, client, cfg); err != nil {
+			_ = client.Close()
+			return nil, err
+		}
+		if err := BackfillReadingStats(ctx, client); err != nil {
+			_ = client.Close()
+			return nil, err
+		}
+		if err := BackfillSummaries(ctx, client); err != nil {
+			_ = client.Close()
+			return nil, err
+		}
+		if err := BackfillKeywords(ctx, client); err != nil {
+			_ = client.Close()
+			return nil, err
+		}
+		if _, err := RefreshEmbeddings(ctx, client, cfg); err != nil {
+			_ = client.Close()
+			return nil, err
+		}
 	}
 
 	return client, nil
@@ -0,0 +1,97 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"landing/backend/ent"
+	"landing/backend/internal/ai/embeddings"
+	"landing/backend/internal/config"
+	"landing/backend/internal/logging"
+)
+
+// EmbeddingRefresh reports the outcome of RefreshEmbeddings.
+type EmbeddingRefresh struct {
+	// Model is the embedding version after the refresh, IDF generation included.
+	Model string `json:"model"`
+	// Posts is the number of posts checked; Reembedded those whose vectors
+	// were regenerated.
+	Posts      int `json:"posts"`
+	Reembedded int `json:"reembedded"`
+}
+
+// refreshMu keeps scheduled and admin-triggered refreshes from interleaving.
+var refreshMu sync.Mutex
+
+// RefreshEmbeddings recomputes the corpus IDF the v2 embedder weighs features
+// with and, in the same step, re-embeds every post and chunk vector the new
+// IDF generation invalidates. It also chunks posts saved before chunks
+// existed. Vectors that are already current are left untouched, so it is
+// idempotent and safe to run on every migration.
+func RefreshEmbeddings(ctx context.Context, client *ent.Client, cfg config.Config) (EmbeddingRefresh, error) {
+	refreshMu.Lock()
+	defer refreshMu.Unlock()
+
+	items, err := client.Blog.Query().All(ctx)
+	if err != nil {
+		return EmbeddingRefresh{}, fmt.Errorf("refresh embeddings: list blogs: %w", err)
+	}
+	embeddings.SetIDF(embeddings.NewIDF(blogTexts(items)))
+	res := EmbeddingRefresh{Model: embeddings.Version(cfg), Posts: len(items)}
+	for _, b := range items {
+		if err := SyncChunks(ctx, client, cfg, b); err != nil {
+			return res, fmt.Errorf("refresh embeddings: blog '%s': chunks: %w", b.Path, err)
+		}
+		hash := embeddings.ContentHash(cfg, b.Text)
+		if b.EmbeddingHash == hash && len(b.Embedding) > 0 {
+			continue
+		}
+		e, err := embeddings.Embed(ctx, cfg, b.Text)
+		if err != nil || len(e) == 0 {
+			continue
+		}
+		if err := client.Blog.UpdateOneID(b.ID).
+			SetEmbedding(e).
+			SetEmbeddingHash(hash).
+			// Keep the modification time: the content itself did not change.
+			SetUpdatedAt(b.UpdatedAt).
+			Exec(ctx); err != nil {
+			return res, fmt.Errorf("refresh embeddings: blog '%s': %w", b.Path, err)
+		}
+		res.Reembedded++
+	}
+	// Pick up chunks written by other processes, such as cmd/migrate.
+	resetChunkIndex()
+	if res.Reembedded > 0 {
+		logging.FromContext(ctx).Info("refresh embeddings", "model", res.Model, "reembedded", res.Reembedded)
+	}
+	return res, nil
+}
+
+// RunEmbeddingRefresh calls RefreshEmbeddings every interval until ctx is
+// done, so that the corpus statistics follow new and edited posts. Failures
+// are logged and retried at the next tick.
+func RunEmbeddingRefresh(ctx context.Context, client *ent.Client, cfg config.Config, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			if _, err := RefreshEmbeddings(ctx, client, cfg); err != nil {
+				logging.FromContext(ctx).Error("embedding refresh failed", logging.Err(err))
+			}
+		}
+	}
+}
+
+func blogTexts(items []*ent.Blog) []string {
+	texts := make([]string, len(items))
+	for i, b := range items {
+		texts[i] = b.Text
+	}
+	return texts
+}
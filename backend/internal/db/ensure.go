@@ -2,11 +2,11 @@ package db
 
 import (
     "context"
-    "log"
     "strings"
     
     "landing/backend/ent"
     "landing/backend/internal/config"
+    "landing/backend/internal/logging"
 )
 
 // EnsureClient returns a live Ent client. If the global client is nil, it will
@@ -19,7 +19,7 @@ func EnsureClient(ctx context.Context, cfg config.Config) (*ent.Client, error) {
             // Only reopen on indicative errors to avoid reopening on transient query errors.
             msg := err.Error()
             if strings.Contains(msg, "database is closed") || strings.Contains(msg, "driver: bad connection") {
-                log.Printf("db.EnsureClient: existing client seems closed, reopening: %v", err)
+                logging.FromContext(ctx).Warn("db.EnsureClient: existing client seems closed, reopening", logging.Err(err))
             } else {
                 return cli, nil
             }
@@ -33,6 +33,6 @@ func EnsureClient(ctx context.Context, cfg config.Config) (*ent.Client, error) {
         return nil, err
     }
     SetGlobalClient(newCli)
-    log.Printf("db.EnsureClient: initialized Ent client")
+    logging.FromContext(ctx).Info("db.EnsureClient: initialized Ent client")
     return newCli, nil
 }
@@ -0,0 +1,15 @@
+package db
+
+import (
+	"errors"
+
+	"github.com/lib/pq"
+)
+
+// IsSerializationFailure reports whether err is a Postgres serialization
+// failure (SQLSTATE 40001): a serializable transaction conflicted with a
+// concurrent one and was rolled back, so the request may be retried.
+func IsSerializationFailure(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "40001"
+}
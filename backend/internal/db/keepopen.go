@@ -1,6 +1,8 @@
 package db
 
 import (
+	"context"
+	"database/sql"
 	"sync/atomic"
 
 	"entgo.io/ent/dialect"
@@ -22,6 +24,11 @@ func (d *keepOpenDriver) Close() error {
 	return nil
 }
 
+// BeginTx forwards to the wrapped driver so ent.Client.BeginTx keeps working.
+func (d *keepOpenDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	return beginTx(ctx, d.Driver, opts)
+}
+
 var currentKeepOpen *keepOpenDriver
 
 func wrapKeepOpen(d dialect.Driver) dialect.Driver {
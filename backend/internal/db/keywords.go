@@ -0,0 +1,58 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"landing/backend/ent"
+	"landing/backend/ent/blog"
+	"landing/backend/internal/ai/nlp"
+	"landing/backend/internal/content"
+	"landing/backend/internal/cta"
+	"landing/backend/internal/logging"
+)
+
+// KeywordIDF builds keyword document frequencies from all published posts.
+func KeywordIDF(ctx context.Context, client *ent.Client) (*nlp.IDF, error) {
+	texts, err := client.Blog.Query().Select(blog.FieldText).Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	docs := make([]string, len(texts))
+	for i, t := range texts {
+		docs[i] = content.PlainText(t)
+	}
+	return nlp.NewIDF(docs), nil
+}
+
+// BackfillKeywords extracts the keywords of blogs saved before they were
+// stored. It is idempotent and safe to run on every migration.
+func BackfillKeywords(ctx context.Context, client *ent.Client) error {
+	items, err := client.Blog.Query().
+		Where(blog.KeywordsIsNil()).
+		Select(blog.FieldText, blog.FieldUpdatedAt).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("backfill keywords: list blogs: %w", err)
+	}
+	if len(items) == 0 {
+		return nil
+	}
+	idf, err := KeywordIDF(ctx, client)
+	if err != nil {
+		return fmt.Errorf("backfill keywords: %w", err)
+	}
+	for _, b := range items {
+		// On save keywords come from the body before the CTA block is added.
+		text := content.PlainText(cta.Remove(b.Text))
+		if err := client.Blog.UpdateOneID(b.ID).
+			SetKeywords(nlp.KeywordTerms(text, idf, nlp.PostKeywords)).
+			// Keep the modification time: the content itself did not change.
+			SetUpdatedAt(b.UpdatedAt).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("backfill keywords: blog %d: %w", b.ID, err)
+		}
+	}
+	logging.FromContext(ctx).Info("backfill keywords", "extracted", len(items))
+	return nil
+}
@@ -0,0 +1,37 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"landing/backend/ent"
+	"landing/backend/ent/blog"
+	"landing/backend/internal/content"
+	"landing/backend/internal/logging"
+)
+
+// BackfillReadingStats fills word count, character count and reading time for
+// blogs saved before those fields existed. It is idempotent and safe to run on
+// every migration.
+func BackfillReadingStats(ctx context.Context, client *ent.Client) error {
+	items, err := client.Blog.Query().Where(blog.ReadingMinutesEQ(0)).All(ctx)
+	if err != nil {
+		return fmt.Errorf("backfill reading stats: list blogs: %w", err)
+	}
+	for _, b := range items {
+		st := content.Measure(b.Text)
+		if err := client.Blog.UpdateOneID(b.ID).
+			SetWordCount(st.Words).
+			SetCharCount(st.Chars).
+			SetReadingMinutes(st.Minutes).
+			// Keep the modification time: the content itself did not change.
+			SetUpdatedAt(b.UpdatedAt).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("backfill reading stats: blog '%s': %w", b.Path, err)
+		}
+	}
+	if len(items) > 0 {
+		logging.FromContext(ctx).Info("backfill reading stats", "measured", len(items))
+	}
+	return nil
+}
@@ -2,12 +2,13 @@ package db
 
 import (
 	"context"
-	"log"
 	"strings"
 	"time"
 
 	"landing/backend/ent"
+	"landing/backend/ent/user"
 	"landing/backend/internal/config"
+	"landing/backend/internal/logging"
 	"landing/backend/internal/sanitize"
 )
 
@@ -60,12 +61,33 @@ func SeedDev(ctx context.Context, client *ent.Client, cfg config.Config) {
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
+	// Sample author profile the seeded posts are attributed to.
+	author, err := client.User.Query().Where(user.EmailEQ("team@tehranbot.me")).Only(ctx)
+	if ent.IsNotFound(err) {
+		author, err = client.User.Create().
+			SetEmail("team@tehranbot.me").
+			SetDisplayName("تهران‌بات").
+			SetBio("تیم هوش مصنوعی و نرم‌افزار تهران‌بات").
+			Save(ctx)
+	}
+	if err != nil {
+		logging.FromContext(ctx).Error("seed: ensure author failed", logging.Err(err))
+		return
+	}
+
 	inserted := 0
 	for _, b := range blogs {
 		// Sanitize/normalize HTML before storing
 		safe := sanitize.SanitizeBlogHTML(b.Text)
-		_, err := client.Blog.Create().
-			SetCategory(b.Category).
+		cat, err := EnsureCategory(ctx, client, b.Category)
+		if err != nil {
+			logging.FromContext(ctx).Error("seed: ensure category failed", "category", b.Category, logging.Err(err))
+			continue
+		}
+		_, err = client.Blog.Create().
+			SetCategory(cat.Slug).
+			SetPrimaryCategory(cat).
+			SetAuthor(author).
 			SetPath(b.Path).
 			SetText(safe).
 			Save(ctx)
@@ -74,12 +96,12 @@ func SeedDev(ctx context.Context, client *ent.Client, cfg config.Config) {
 				// Already exists; skip
 				continue
 			}
-			log.Printf("seed: create blog '%s' failed: %v", b.Path, err)
+			logging.FromContext(ctx).Error("seed: create blog failed", "path", b.Path, logging.Err(err))
 			continue
 		}
 		inserted++
 	}
 	if inserted > 0 {
-		log.Printf("seed: inserted %d blogs", inserted)
+		logging.FromContext(ctx).Info("seed", "inserted", inserted)
 	}
 }
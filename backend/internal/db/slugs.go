@@ -0,0 +1,123 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"landing/backend/ent"
+	"landing/backend/ent/blog"
+	"landing/backend/ent/category"
+	"landing/backend/ent/tag"
+	"landing/backend/internal/logging"
+	"landing/backend/internal/slug"
+)
+
+// BackfillSlugs rewrites category and tag slugs stored before slug.Make
+// normalized Persian text (Arabic letter forms, Persian digits), so lookups,
+// which always go through slug.Make, find them again. A tag whose new slug is
+// taken is merged into the existing tag; a category in that situation is left
+// alone and reported, since merging categories needs an editor's decision.
+// It is idempotent and safe to run on every migration.
+func BackfillSlugs(ctx context.Context, client *ent.Client) error {
+	if err := reslugCategories(ctx, client); err != nil {
+		return fmt.Errorf("backfill slugs: %w", err)
+	}
+	if err := reslugTags(ctx, client); err != nil {
+		return fmt.Errorf("backfill slugs: %w", err)
+	}
+	return nil
+}
+
+func reslugCategories(ctx context.Context, client *ent.Client) error {
+	cats, err := client.Category.Query().All(ctx)
+	if err != nil {
+		return fmt.Errorf("list categories: %w", err)
+	}
+	renamed := 0
+	for _, cat := range cats {
+		s := slug.Make(cat.Slug)
+		if s == cat.Slug || s == "" {
+			continue
+		}
+		taken, err := client.Category.Query().Where(category.SlugEQ(s)).Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("category '%s': %w", cat.Slug, err)
+		}
+		if taken {
+			logging.FromContext(ctx).Warn("backfill slugs: category slug already taken; merge the categories by hand",
+				"slug", cat.Slug, "normalized", s)
+			continue
+		}
+		if err := withTx(ctx, client, func(tx *ent.Tx) error {
+			if err := tx.Category.UpdateOneID(cat.ID).SetSlug(s).Exec(ctx); err != nil {
+				return err
+			}
+			return tx.Blog.Update().Where(blog.CategoryIDEQ(cat.ID)).SetCategory(s).Exec(ctx)
+		}); err != nil {
+			return fmt.Errorf("category '%s': %w", cat.Slug, err)
+		}
+		renamed++
+	}
+	if renamed > 0 {
+		logging.FromContext(ctx).Info("backfill slugs: categories", "renamed", renamed)
+	}
+	return nil
+}
+
+func reslugTags(ctx context.Context, client *ent.Client) error {
+	tags, err := client.Tag.Query().All(ctx)
+	if err != nil {
+		return fmt.Errorf("list tags: %w", err)
+	}
+	renamed, merged := 0, 0
+	for _, t := range tags {
+		s := slug.Make(t.Slug)
+		if s == t.Slug || s == "" {
+			continue
+		}
+		existing, err := client.Tag.Query().Where(tag.SlugEQ(s)).Only(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return fmt.Errorf("tag '%s': %w", t.Slug, err)
+		}
+		if existing == nil {
+			if err := client.Tag.UpdateOneID(t.ID).SetSlug(s).Exec(ctx); err != nil {
+				return fmt.Errorf("tag '%s': %w", t.Slug, err)
+			}
+			renamed++
+			continue
+		}
+		// Both spellings name the same tag: move the posts over and drop the old one.
+		if err := withTx(ctx, client, func(tx *ent.Tx) error {
+			ids, err := tx.Blog.Query().
+				Where(blog.HasTagsWith(tag.ID(t.ID)), blog.Not(blog.HasTagsWith(tag.ID(existing.ID)))).
+				IDs(ctx)
+			if err != nil {
+				return err
+			}
+			if err := tx.Tag.UpdateOneID(existing.ID).AddBlogIDs(ids...).Exec(ctx); err != nil {
+				return err
+			}
+			return tx.Tag.DeleteOneID(t.ID).Exec(ctx)
+		}); err != nil {
+			return fmt.Errorf("merge tag '%s' into '%s': %w", t.Slug, s, err)
+		}
+		merged++
+	}
+	if renamed+merged > 0 {
+		logging.FromContext(ctx).Info("backfill slugs: tags", "renamed", renamed, "merged", merged)
+	}
+	return nil
+}
+
+// withTx runs fn in a transaction, committing when it returns nil.
+func withTx(ctx context.Context, client *ent.Client, fn func(*ent.Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
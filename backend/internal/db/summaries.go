@@ -0,0 +1,39 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"landing/backend/ent"
+	"landing/backend/ent/blog"
+	"landing/backend/internal/content"
+	"landing/backend/internal/logging"
+	"landing/backend/internal/seo"
+)
+
+// BackfillSummaries generates the excerpt and meta description of blogs saved
+// before those fields existed. It is idempotent and safe to run on every migration.
+func BackfillSummaries(ctx context.Context, client *ent.Client) error {
+	items, err := client.Blog.Query().
+		Where(blog.Or(blog.ExcerptIsNil(), blog.ExcerptEQ("")), blog.ExcerptCustom(false)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("backfill summaries: list blogs: %w", err)
+	}
+	for _, b := range items {
+		upd := client.Blog.UpdateOneID(b.ID).
+			SetExcerpt(content.Summarize(b.Text, content.ExcerptMin, content.ExcerptMax)).
+			// Keep the modification time: the content itself did not change.
+			SetUpdatedAt(b.UpdatedAt)
+		if !b.MetaDescriptionCustom {
+			upd = upd.SetMetaDescription(content.Summarize(b.Text, seo.MetaDescriptionMin, seo.MetaDescriptionMax))
+		}
+		if err := upd.Exec(ctx); err != nil {
+			return fmt.Errorf("backfill summaries: blog '%s': %w", b.Path, err)
+		}
+	}
+	if len(items) > 0 {
+		logging.FromContext(ctx).Info("backfill summaries", "summarized", len(items))
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package db
+
+import (
+	"context"
+	"strings"
+
+	"landing/backend/ent"
+	"landing/backend/ent/tag"
+	"landing/backend/internal/slug"
+)
+
+// EnsureTags resolves the given tag names to Tag entities, creating missing ones.
+// Names are de-duplicated by slug; blank names are ignored. The result preserves
+// the order of first appearance.
+func EnsureTags(ctx context.Context, client *ent.Client, names []string) ([]*ent.Tag, error) {
+	out := make([]*ent.Tag, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		s := slug.Make(name)
+		if s == "" || seen[s] {
+			continue
+		}
+		seen[s] = true
+		t, err := client.Tag.Query().Where(tag.SlugEQ(s)).Only(ctx)
+		if ent.IsNotFound(err) {
+			t, err = client.Tag.Create().SetSlug(s).SetName(name).Save(ctx)
+			if ent.IsConstraintError(err) {
+				// Lost a race with a concurrent insert; read the winner.
+				t, err = client.Tag.Query().Where(tag.SlugEQ(s)).Only(ctx)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, t)
+	}
+	return out, nil
+}
@@ -0,0 +1,129 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"landing/backend/internal/tracing"
+)
+
+// tracedDriver wraps a dialect.Driver and records a client span for every
+// statement Ent runs, as a child of the span in the query's context (the
+// request span, see tracing.Middleware). Statements are recorded with their
+// placeholders only; argument values never reach the trace.
+type tracedDriver struct {
+	dialect.Driver
+}
+
+func wrapTraced(d dialect.Driver) dialect.Driver {
+	return &tracedDriver{Driver: d}
+}
+
+func (d *tracedDriver) Exec(ctx context.Context, query string, args, v any) error {
+	ctx, span := startQuerySpan(ctx, query)
+	defer span.End()
+	return endQuerySpan(span, d.Driver.Exec(ctx, query, args, v))
+}
+
+func (d *tracedDriver) Query(ctx context.Context, query string, args, v any) error {
+	ctx, span := startQuerySpan(ctx, query)
+	defer span.End()
+	return endQuerySpan(span, d.Driver.Query(ctx, query, args, v))
+}
+
+// Tx traces the transaction as a span whose children are its statements.
+func (d *tracedDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	return d.BeginTx(ctx, nil)
+}
+
+// BeginTx is Tx with transaction options such as the isolation level.
+func (d *tracedDriver) BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "db.tx", trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemPostgreSQL))
+	tx, err := beginTx(ctx, d.Driver, opts)
+	if err != nil {
+		endQuerySpan(span, err)
+		span.End()
+		return nil, err
+	}
+	return &tracedTx{Tx: tx, span: span}, nil
+}
+
+type tracedTx struct {
+	dialect.Tx
+	span trace.Span
+}
+
+func (t *tracedTx) Exec(ctx context.Context, query string, args, v any) error {
+	ctx, span := startQuerySpan(t.parent(ctx), query)
+	defer span.End()
+	return endQuerySpan(span, t.Tx.Exec(ctx, query, args, v))
+}
+
+func (t *tracedTx) Query(ctx context.Context, query string, args, v any) error {
+	ctx, span := startQuerySpan(t.parent(ctx), query)
+	defer span.End()
+	return endQuerySpan(span, t.Tx.Query(ctx, query, args, v))
+}
+
+func (t *tracedTx) Commit() error {
+	defer t.span.End()
+	t.span.SetAttributes(attribute.String("db.tx.outcome", "commit"))
+	return endQuerySpan(t.span, t.Tx.Commit())
+}
+
+func (t *tracedTx) Rollback() error {
+	defer t.span.End()
+	t.span.SetAttributes(attribute.String("db.tx.outcome", "rollback"))
+	return endQuerySpan(t.span, t.Tx.Rollback())
+}
+
+// parent nests statements under the transaction span.
+func (t *tracedTx) parent(ctx context.Context) context.Context {
+	return trace.ContextWithSpan(ctx, t.span)
+}
+
+func startQuerySpan(ctx context.Context, query string) (context.Context, trace.Span) {
+	op := strings.ToUpper(strings.SplitN(strings.TrimSpace(query), " ", 2)[0])
+	return tracing.Tracer().Start(ctx, "db."+strings.ToLower(op),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemPostgreSQL,
+			semconv.DBOperationName(op),
+			semconv.DBQueryText(query),
+		))
+}
+
+func endQuerySpan(span trace.Span, err error) error {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
+
+// txBeginner is implemented by drivers that accept transaction options, such
+// as *entsql.Driver.
+type txBeginner interface {
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (dialect.Tx, error)
+}
+
+// beginTx starts a transaction on d, with opts when given.
+func beginTx(ctx context.Context, d dialect.Driver, opts *sql.TxOptions) (dialect.Tx, error) {
+	if opts == nil {
+		return d.Tx(ctx)
+	}
+	b, ok := d.(txBeginner)
+	if !ok {
+		return nil, fmt.Errorf("db: driver %T does not support transaction options", d)
+	}
+	return b.BeginTx(ctx, opts)
+}
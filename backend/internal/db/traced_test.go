@@ -0,0 +1,129 @@
+package db
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"landing/backend/ent"
+	"landing/backend/ent/blog"
+	"landing/backend/internal/tracing"
+)
+
+// TestTracedRequest checks that a request yields a server span for the
+// matched route, continuing the caller's trace, with the Ent query recorded
+// as its child client span.
+func TestTracedRequest(t *testing.T) {
+	exp := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp))
+	prevTP, prevProp := otel.GetTracerProvider(), otel.GetTextMapPropagator()
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prevTP)
+		otel.SetTextMapPropagator(prevProp)
+	})
+
+	sqldb, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sqldb.Close()
+	mock.ExpectQuery(`SELECT .+ FROM "blogs" WHERE "blogs"\."path" = \$1`).
+		WithArgs("hello").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "path"}).AddRow(1, "hello"))
+	client := ent.NewClient(ent.Driver(wrapTraced(entsql.OpenDB(dialect.Postgres, sqldb))))
+
+	app := fiber.New()
+	app.Use(tracing.Middleware())
+	app.Get("/api/blogs/:path", func(c *fiber.Ctx) error {
+		b, err := client.Blog.Query().Where(blog.PathEQ(c.Params("path"))).Only(c.UserContext())
+		if err != nil {
+			return err
+		}
+		return c.SendString(b.Path)
+	})
+
+	req := httptest.NewRequest(fiber.MethodGet, "/api/blogs/hello", nil)
+	const traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	req.Header.Set("traceparent", "00-"+traceID+"-00f067aa0ba902b7-01")
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != fiber.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+
+	var server, query *tracetest.SpanStub
+	spans := exp.GetSpans()
+	for i := range spans {
+		switch s := &spans[i]; s.Name {
+		case "GET /api/blogs/:path":
+			server = s
+		case "db.select":
+			query = s
+		}
+	}
+	if server == nil || query == nil {
+		t.Fatalf("spans = %v, want the HTTP span and a db.select span", spanNames(spans))
+	}
+
+	if server.SpanKind != trace.SpanKindServer {
+		t.Errorf("HTTP span kind = %v, want server", server.SpanKind)
+	}
+	if got := server.SpanContext.TraceID().String(); got != traceID {
+		t.Errorf("HTTP span trace ID = %s, want the incoming %s", got, traceID)
+	}
+	wantAttr(t, server.Attributes, "http.route", "/api/blogs/:path")
+	wantAttr(t, server.Attributes, "http.response.status_code", "200")
+
+	if query.SpanKind != trace.SpanKindClient {
+		t.Errorf("query span kind = %v, want client", query.SpanKind)
+	}
+	if query.Parent.SpanID() != server.SpanContext.SpanID() {
+		t.Errorf("query span parent = %s, want the HTTP span %s", query.Parent.SpanID(), server.SpanContext.SpanID())
+	}
+	wantAttr(t, query.Attributes, "db.system", "postgresql")
+	wantAttr(t, query.Attributes, "db.operation.name", "SELECT")
+	for _, kv := range query.Attributes {
+		if kv.Key == "db.query.text" && strings.Contains(kv.Value.AsString(), "hello") {
+			t.Errorf("db.query.text = %q carries an argument value", kv.Value.AsString())
+		}
+	}
+}
+
+func wantAttr(t *testing.T, attrs []attribute.KeyValue, key, want string) {
+	t.Helper()
+	for _, kv := range attrs {
+		if string(kv.Key) == key {
+			if got := kv.Value.Emit(); got != want {
+				t.Errorf("%s = %q, want %q", key, got, want)
+			}
+			return
+		}
+	}
+	t.Errorf("attribute %s missing", key)
+}
+
+func spanNames(spans tracetest.SpanStubs) []string {
+	names := make([]string, len(spans))
+	for i, s := range spans {
+		names[i] = s.Name
+	}
+	return names
+}
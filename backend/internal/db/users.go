@@ -0,0 +1,35 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"landing/backend/ent"
+	"landing/backend/ent/user"
+	"landing/backend/internal/config"
+	"landing/backend/internal/logging"
+)
+
+// BackfillDisplayNames fills the display name of users created before the
+// field existed from their legacy name, or from cfg.AuthorName when that is
+// empty too. It is idempotent and safe to run on every migration.
+func BackfillDisplayNames(ctx context.Context, client *ent.Client, cfg config.Config) error {
+	items, err := client.User.Query().Where(user.DisplayNameEQ("")).All(ctx)
+	if err != nil {
+		return fmt.Errorf("backfill display names: list users: %w", err)
+	}
+	for _, u := range items {
+		name := strings.TrimSpace(u.Name)
+		if name == "" {
+			name = cfg.AuthorName
+		}
+		if err := client.User.UpdateOneID(u.ID).SetDisplayName(name).Exec(ctx); err != nil {
+			return fmt.Errorf("backfill display names: user %d: %w", u.ID, err)
+		}
+	}
+	if len(items) > 0 {
+		logging.FromContext(ctx).Info("backfill display names", "filled", len(items))
+	}
+	return nil
+}
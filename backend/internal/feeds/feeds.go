@@ -0,0 +1,258 @@
+package feeds
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"time"
+)
+
+// Site describes the publication a feed belongs to.
+type Site struct {
+	Title    string
+	URL      string // site home page
+	FeedURL  string // URL of the feed being rendered
+	Language string
+	Logo     string
+}
+
+// Entry is one post in a feed.
+type Entry struct {
+	ID         string
+	URL        string
+	Title      string
+	Summary    string
+	Content    string // HTML
+	Author     string
+	Categories []string
+	Image      string
+	Published  time.Time
+	Updated    time.Time
+}
+
+// RSS renders an RSS 2.0 document.
+func RSS(site Site, entries []Entry) ([]byte, error) {
+	type guid struct {
+		IsPermaLink bool   `xml:"isPermaLink,attr"`
+		Value       string `xml:",chardata"`
+	}
+	type cdata struct {
+		Value string `xml:",cdata"`
+	}
+	type item struct {
+		Title       string   `xml:"title"`
+		Link        string   `xml:"link"`
+		GUID        guid     `xml:"guid"`
+		Description string   `xml:"description,omitempty"`
+		Content     *cdata   `xml:"content:encoded,omitempty"`
+		Author      string   `xml:"dc:creator,omitempty"`
+		Categories  []string `xml:"category"`
+		PubDate     string   `xml:"pubDate"`
+	}
+	type atomLink struct {
+		Href string `xml:"href,attr"`
+		Rel  string `xml:"rel,attr"`
+		Type string `xml:"type,attr"`
+	}
+	type channel struct {
+		Title         string   `xml:"title"`
+		Link          string   `xml:"link"`
+		Self          atomLink `xml:"atom:link"`
+		Description   string   `xml:"description"`
+		Language      string   `xml:"language,omitempty"`
+		LastBuildDate string   `xml:"lastBuildDate,omitempty"`
+		Items         []item   `xml:"item"`
+	}
+	type rss struct {
+		XMLName   xml.Name `xml:"rss"`
+		Version   string   `xml:"version,attr"`
+		AtomNS    string   `xml:"xmlns:atom,attr"`
+		ContentNS string   `xml:"xmlns:content,attr"`
+		DCNS      string   `xml:"xmlns:dc,attr"`
+		Channel   channel  `xml:"channel"`
+	}
+
+	ch := channel{
+		Title:       site.Title,
+		Link:        site.URL,
+		Self:        atomLink{Href: site.FeedURL, Rel: "self", Type: "application/rss+xml"},
+		Description: site.Title,
+		Language:    site.Language,
+	}
+	if u := latest(entries); !u.IsZero() {
+		ch.LastBuildDate = u.Format(time.RFC1123Z)
+	}
+	for _, e := range entries {
+		it := item{
+			Title:       e.Title,
+			Link:        e.URL,
+			GUID:        guid{IsPermaLink: e.ID == e.URL, Value: e.ID},
+			Description: e.Summary,
+			Author:      e.Author,
+			Categories:  e.Categories,
+			PubDate:     e.Published.UTC().Format(time.RFC1123Z),
+		}
+		if e.Content != "" {
+			it.Content = &cdata{Value: e.Content}
+		}
+		ch.Items = append(ch.Items, it)
+	}
+	return marshalXML(rss{
+		Version:   "2.0",
+		AtomNS:    "http://www.w3.org/2005/Atom",
+		ContentNS: "http://purl.org/rss/1.0/modules/content/",
+		DCNS:      "http://purl.org/dc/elements/1.1/",
+		Channel:   ch,
+	})
+}
+
+// Atom renders an Atom 1.0 document.
+func Atom(site Site, entries []Entry) ([]byte, error) {
+	type link struct {
+		Href string `xml:"href,attr"`
+		Rel  string `xml:"rel,attr,omitempty"`
+		Type string `xml:"type,attr,omitempty"`
+	}
+	type text struct {
+		Type  string `xml:"type,attr,omitempty"`
+		Value string `xml:",chardata"`
+	}
+	type person struct {
+		Name string `xml:"name"`
+	}
+	type category struct {
+		Term string `xml:"term,attr"`
+	}
+	type entry struct {
+		ID         string     `xml:"id"`
+		Title      string     `xml:"title"`
+		Link       link       `xml:"link"`
+		Published  string     `xml:"published"`
+		Updated    string     `xml:"updated"`
+		Author     *person    `xml:"author,omitempty"`
+		Categories []category `xml:"category"`
+		Summary    *text      `xml:"summary,omitempty"`
+		Content    *text      `xml:"content,omitempty"`
+	}
+	type feed struct {
+		XMLName xml.Name `xml:"http://www.w3.org/2005/Atom feed"`
+		Lang    string   `xml:"xml:lang,attr,omitempty"`
+		ID      string   `xml:"id"`
+		Title   string   `xml:"title"`
+		Updated string   `xml:"updated"`
+		Links   []link   `xml:"link"`
+		Logo    string   `xml:"logo,omitempty"`
+		Entries []entry  `xml:"entry"`
+	}
+
+	f := feed{
+		Lang:    site.Language,
+		ID:      site.URL + "/",
+		Title:   site.Title,
+		Updated: latest(entries).UTC().Format(time.RFC3339),
+		Links: []link{
+			{Href: site.URL, Rel: "alternate", Type: "text/html"},
+			{Href: site.FeedURL, Rel: "self", Type: "application/atom+xml"},
+		},
+		Logo: site.Logo,
+	}
+	for _, e := range entries {
+		en := entry{
+			ID:        e.ID,
+			Title:     e.Title,
+			Link:      link{Href: e.URL, Rel: "alternate", Type: "text/html"},
+			Published: e.Published.UTC().Format(time.RFC3339),
+			Updated:   e.Updated.UTC().Format(time.RFC3339),
+		}
+		if e.Author != "" {
+			en.Author = &person{Name: e.Author}
+		}
+		for _, c := range e.Categories {
+			en.Categories = append(en.Categories, category{Term: c})
+		}
+		if e.Summary != "" {
+			en.Summary = &text{Type: "text", Value: e.Summary}
+		}
+		if e.Content != "" {
+			en.Content = &text{Type: "html", Value: e.Content}
+		}
+		f.Entries = append(f.Entries, en)
+	}
+	return marshalXML(f)
+}
+
+// JSONFeed renders a JSON Feed 1.1 document.
+func JSONFeed(site Site, entries []Entry) ([]byte, error) {
+	type author struct {
+		Name string `json:"name"`
+	}
+	type item struct {
+		ID            string   `json:"id"`
+		URL           string   `json:"url"`
+		Title         string   `json:"title"`
+		Summary       string   `json:"summary,omitempty"`
+		ContentHTML   string   `json:"content_html,omitempty"`
+		Image         string   `json:"image,omitempty"`
+		DatePublished string   `json:"date_published"`
+		DateModified  string   `json:"date_modified"`
+		Authors       []author `json:"authors,omitempty"`
+		Tags          []string `json:"tags,omitempty"`
+	}
+	type feed struct {
+		Version     string `json:"version"`
+		Title       string `json:"title"`
+		HomePageURL string `json:"home_page_url"`
+		FeedURL     string `json:"feed_url"`
+		Language    string `json:"language,omitempty"`
+		Icon        string `json:"icon,omitempty"`
+		Items       []item `json:"items"`
+	}
+
+	f := feed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       site.Title,
+		HomePageURL: site.URL,
+		FeedURL:     site.FeedURL,
+		Language:    site.Language,
+		Icon:        site.Logo,
+		Items:       make([]item, 0, len(entries)),
+	}
+	for _, e := range entries {
+		it := item{
+			ID:            e.ID,
+			URL:           e.URL,
+			Title:         e.Title,
+			Summary:       e.Summary,
+			ContentHTML:   e.Content,
+			Image:         e.Image,
+			DatePublished: e.Published.UTC().Format(time.RFC3339),
+			DateModified:  e.Updated.UTC().Format(time.RFC3339),
+			Tags:          e.Categories,
+		}
+		if e.Author != "" {
+			it.Authors = []author{{Name: e.Author}}
+		}
+		f.Items = append(f.Items, it)
+	}
+	return json.MarshalIndent(f, "", "  ")
+}
+
+func latest(entries []Entry) time.Time {
+	var t time.Time
+	for _, e := range entries {
+		if e.Updated.After(t) {
+			t = e.Updated
+		}
+	}
+	if t.IsZero() {
+		t = time.Now()
+	}
+	return t
+}
+
+func marshalXML(v any) ([]byte, error) {
+	out, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), out...), nil
+}
@@ -0,0 +1,78 @@
+package feeds
+
+import (
+	"encoding/xml"
+	"strconv"
+	"time"
+)
+
+// MaxSitemapURLs is the per-file URL limit from the sitemaps.org protocol.
+const MaxSitemapURLs = 50000
+
+// SitemapURL is one <url> entry of a sitemap.
+type SitemapURL struct {
+	Loc        string
+	LastMod    time.Time
+	ChangeFreq string
+	Priority   float64
+}
+
+// URLSet renders a sitemap <urlset> document.
+func URLSet(urls []SitemapURL) ([]byte, error) {
+	type url struct {
+		Loc        string `xml:"loc"`
+		LastMod    string `xml:"lastmod,omitempty"`
+		ChangeFreq string `xml:"changefreq,omitempty"`
+		Priority   string `xml:"priority,omitempty"`
+	}
+	type urlset struct {
+		XMLName xml.Name `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 urlset"`
+		URLs    []url    `xml:"url"`
+	}
+	set := urlset{URLs: make([]url, 0, len(urls))}
+	for _, u := range urls {
+		e := url{Loc: u.Loc, ChangeFreq: u.ChangeFreq}
+		if !u.LastMod.IsZero() {
+			e.LastMod = u.LastMod.UTC().Format(time.RFC3339)
+		}
+		if u.Priority > 0 {
+			e.Priority = formatPriority(u.Priority)
+		}
+		set.URLs = append(set.URLs, e)
+	}
+	return marshalXML(set)
+}
+
+// SitemapRef is one <sitemap> entry of a sitemap index.
+type SitemapRef struct {
+	Loc     string
+	LastMod time.Time
+}
+
+// SitemapIndex renders a <sitemapindex> document.
+func SitemapIndex(refs []SitemapRef) ([]byte, error) {
+	type sitemap struct {
+		Loc     string `xml:"loc"`
+		LastMod string `xml:"lastmod,omitempty"`
+	}
+	type index struct {
+		XMLName  xml.Name  `xml:"http://www.sitemaps.org/schemas/sitemap/0.9 sitemapindex"`
+		Sitemaps []sitemap `xml:"sitemap"`
+	}
+	idx := index{Sitemaps: make([]sitemap, 0, len(refs))}
+	for _, r := range refs {
+		s := sitemap{Loc: r.Loc}
+		if !r.LastMod.IsZero() {
+			s.LastMod = r.LastMod.UTC().Format(time.RFC3339)
+		}
+		idx.Sitemaps = append(idx.Sitemaps, s)
+	}
+	return marshalXML(idx)
+}
+
+func formatPriority(p float64) string {
+	if p > 1 {
+		p = 1
+	}
+	return strconv.FormatFloat(p, 'f', 1, 64)
+}
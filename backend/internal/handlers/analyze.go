@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"landing/backend/ent/blog"
+	"landing/backend/internal/ai/nlp"
+	"landing/backend/internal/apperr"
+	"landing/backend/internal/content"
+	"landing/backend/internal/db"
+	"landing/backend/internal/validate"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Defaults for AnalyzeRequest.
+const (
+	defaultAnalyzeKeywords  = 10
+	defaultAnalyzeSentences = 3
+)
+
+// AnalyzeRequest is the payload for analyzing a draft.
+// swagger:model
+type AnalyzeRequest struct {
+	Text string `json:"text" validate:"required" maxLength:"200000"`
+	// Format is "html" (default) or "markdown".
+	Format string `json:"format" validate:"trim" enums:"html,markdown"`
+	// Keywords is the number of keywords to return (default 10).
+	Keywords int `json:"keywords" minimum:"0" maximum:"50"`
+	// Sentences is the length of the extractive summary (default 3).
+	Sentences int `json:"sentences" minimum:"0" maximum:"50"`
+}
+
+// AnalyzeResponse holds keywords, an extractive summary and length statistics.
+// swagger:model
+type AnalyzeResponse struct {
+	Keywords []nlp.Keyword        `json:"keywords"`
+	Summary  []string             `json:"summary"`
+	Stats    content.ReadingStats `json:"stats"`
+}
+
+// AnalyzeBlogHandler extracts keywords (TF-IDF against the published posts) and a
+// TextRank summary from draft text, offline.
+// @Summary Extract keywords and a summary from draft text
+// @Tags blogs
+// @Accept json
+// @Produce json
+// @Param data body AnalyzeRequest true "Draft payload"
+// @Success 200 {object} AnalyzeResponse
+// @Failure 400 {object} apperr.Problem
+// @Failure 401 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /blogs/analyze [post]
+func AnalyzeBlogHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+
+	var req AnalyzeRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.InvalidBody(err)
+	}
+	if err := validate.Request(&req); err != nil {
+		return err
+	}
+	if req.Keywords == 0 {
+		req.Keywords = defaultAnalyzeKeywords
+	}
+	if req.Sentences == 0 {
+		req.Sentences = defaultAnalyzeSentences
+	}
+	format := parseBlogFormat(req.Format, blog.DefaultFormat)
+	doc, err := blogSourceHTML(format, req.Text)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	idf, err := db.KeywordIDF(c.UserContext(), client)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+
+	body := sanitizeAndExtractBody(doc)
+	text := content.PlainText(body)
+	return c.JSON(AnalyzeResponse{
+		Keywords: nlp.Keywords(text, idf, req.Keywords),
+		Summary:  nlp.Summarize(text, req.Sentences),
+		Stats:    content.Measure(body),
+	})
+}
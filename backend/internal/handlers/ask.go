@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"landing/backend/internal/ai/embeddings"
+	"landing/backend/internal/ai/rag"
+	"landing/backend/internal/apperr"
+	"landing/backend/internal/config"
+	"landing/backend/internal/db"
+	"landing/backend/internal/logging"
+	"landing/backend/internal/metrics"
+	"landing/backend/internal/validate"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/valyala/fasthttp"
+)
+
+// askTimeout bounds answer generation, which outlives the handler when streaming.
+const askTimeout = 2 * time.Minute
+
+// AskRequest is the payload for asking a question about the blog posts.
+// swagger:model
+type AskRequest struct {
+	Question string `json:"question" validate:"required,trim" maxLength:"1000"`
+	// K is the number of passages to retrieve (default ASK_TOP_K).
+	K int `json:"k" minimum:"0" maximum:"20"`
+}
+
+// AskResponse is the complete answer with the passages it was drawn from.
+// Citations in the answer are blog URL paths in square brackets, e.g. [/blog/rag].
+// swagger:model
+type AskResponse struct {
+	Answer  string       `json:"answer"`
+	Sources []rag.Source `json:"sources"`
+}
+
+// AskHandler answers a question from the blog posts: it retrieves the stored
+// passages (blog chunks) most similar to the question and has the configured
+// LLM (or, without one, the extractive fallback) answer from them with citations.
+// With `Accept: text/event-stream` the answer is streamed as server-sent
+// events: one `sources` event (JSON array), `token` events (JSON strings),
+// then `done`, or `error` if generation fails midway.
+// @Summary Ask a question about the blog posts
+// @Tags ask
+// @Accept json
+// @Produce json
+// @Produce text/event-stream
+// @Param data body AskRequest true "Question"
+// @Success 200 {object} AskResponse
+// @Failure 400 {object} apperr.Problem
+// @Failure 401 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /ask [post]
+func AskHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+
+	var req AskRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.InvalidBody(err)
+	}
+	if err := validate.Request(&req); err != nil {
+		return err
+	}
+	cfg := config.Load()
+	if req.K == 0 {
+		req.K = cfg.AskTopK
+	}
+
+	index, err := db.SharedChunkIndex(c.UserContext(), client)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	chunks := index.Chunks()
+	query, err := embeddings.Embed(c.UserContext(), cfg, req.Question)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	sources := rag.Retrieve(query, chunks, req.K)
+	metrics.ObserveSimilarity("ask", len(chunks))
+	llm := askLLM(cfg)
+
+	if !strings.Contains(c.Get(fiber.HeaderAccept), "text/event-stream") {
+		ctx, cancel := context.WithTimeout(c.UserContext(), askTimeout)
+		defer cancel()
+		var answer strings.Builder
+		if err := llm.Answer(ctx, req.Question, sources, func(tok string) error {
+			answer.WriteString(tok)
+			return nil
+		}); err != nil {
+			return apperr.Internal(err)
+		}
+		return c.JSON(AskResponse{Answer: answer.String(), Sources: sources})
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set("X-Accel-Buffering", "no")
+	logger := logging.FromCtx(c)
+	c.Context().SetBodyStreamWriter(fasthttp.StreamWriter(func(w *bufio.Writer) {
+		// The request context ends when the handler returns; the stream runs after it.
+		ctx, cancel := context.WithTimeout(logging.NewContext(context.Background(), logger), askTimeout)
+		defer cancel()
+		send := func(event string, v any) error {
+			data, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, data)
+			// A failed flush means the client disconnected: stop generating.
+			return w.Flush()
+		}
+		if send("sources", sources) != nil {
+			return
+		}
+		if err := llm.Answer(ctx, req.Question, sources, func(tok string) error {
+			return send("token", tok)
+		}); err != nil {
+			logger.Error("ask: streaming answer failed", logging.Err(err))
+			_ = send("error", fiber.Map{"error": err.Error()})
+			return
+		}
+		_ = send("done", fiber.Map{})
+	}))
+	return nil
+}
+
+// askLLM returns the configured model with the extractive answerer as
+// fallback, or the extractive answerer alone when no model is configured.
+func askLLM(cfg config.Config) rag.LLM {
+	extractive := rag.Extractive{}
+	if cfg.LLMBaseURL == "" {
+		return extractive
+	}
+	return rag.WithFallback(rag.OpenAI{BaseURL: cfg.LLMBaseURL, APIKey: cfg.LLMAPIKey, Model: cfg.LLMModel}, extractive)
+}
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gofiber/fiber/v2"
+
+	"landing/backend/ent"
+	"landing/backend/internal/ai/embeddings"
+	"landing/backend/internal/apperr"
+	"landing/backend/internal/config"
+)
+
+// sseEvents splits a server-sent event stream into "event: data" lines.
+func sseEvents(body string) []string {
+	var out []string
+	for _, block := range strings.Split(strings.TrimSpace(body), "\n\n") {
+		var event, data string
+		for _, line := range strings.Split(block, "\n") {
+			if v, ok := strings.CutPrefix(line, "event: "); ok {
+				event = v
+			} else if v, ok := strings.CutPrefix(line, "data: "); ok {
+				data = v
+			}
+		}
+		out = append(out, event+": "+data)
+	}
+	return out
+}
+
+// TestAskHandlerStream checks the event sequence of a streamed answer: the
+// retrieved sources, one token per model delta, then done, or error when the
+// model fails midway.
+func TestAskHandlerStream(t *testing.T) {
+	const question = "بازیابی افزوده چیست"
+	var failMidway bool
+	llm := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		for _, d := range []string{"بازیابی", " افزوده"} {
+			data, _ := json.Marshal(map[string]any{"choices": []any{map[string]any{"delta": map[string]string{"content": d}}}})
+			fmt.Fprintf(w, "data: %s\n\n", data)
+		}
+		if failMidway {
+			fmt.Fprint(w, "data: {broken\n\n")
+			return
+		}
+		fmt.Fprint(w, "data: [DONE]\n\n")
+	}))
+	defer llm.Close()
+	t.Setenv("LLM_BASE_URL", llm.URL)
+	t.Setenv("ASK_TOP_K", "3")
+
+	// One stored passage embedded like the question, so it is retrieved.
+	vec, err := embeddings.Embed(t.Context(), config.Load(), question)
+	if err != nil {
+		t.Fatal(err)
+	}
+	emb, _ := json.Marshal(vec)
+	sqldb, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sqldb.Close()
+	mock.ExpectQuery(`SELECT .+ FROM "blog_chunks"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "blog_id", "position", "heading", "text", "embedding", "embedding_hash"}).
+			AddRow(1, 7, 0, "", question+".", emb, ""))
+	mock.ExpectQuery(`SELECT .+ FROM "blogs" WHERE "blogs"\."id" IN \(\$1\)`).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "path", "text"}).AddRow(7, "rag", "<h1>RAG</h1><p>"+question+".</p>"))
+	client := ent.NewClient(ent.Driver(entsql.OpenDB(dialect.Postgres, sqldb)))
+
+	app := fiber.New(fiber.Config{ErrorHandler: apperr.Handler})
+	app.Post("/ask", func(c *fiber.Ctx) error {
+		c.Locals("ent", client)
+		return c.Next()
+	}, AskHandler)
+	ask := func() []string {
+		t.Helper()
+		req := httptest.NewRequest(fiber.MethodPost, "/ask", strings.NewReader(`{"question":"`+question+`"}`))
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+		req.Header.Set(fiber.HeaderAccept, "text/event-stream")
+		resp, err := app.Test(req, -1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if ct := resp.Header.Get(fiber.HeaderContentType); resp.StatusCode != fiber.StatusOK || ct != "text/event-stream" {
+			t.Fatalf("status = %d, Content-Type = %q, want an event stream", resp.StatusCode, ct)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		return sseEvents(string(body))
+	}
+
+	got := ask()
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) == 0 || !strings.HasPrefix(got[0], `sources: [{"path":"rag","title":"RAG",`) {
+		t.Fatalf("first event = %q, want the retrieved sources", got)
+	}
+	want := []string{`token: "بازیابی"`, `token: " افزوده"`, `done: {}`}
+	if !reflect.DeepEqual(got[1:], want) {
+		t.Errorf("events = %q, want %q", got[1:], want)
+	}
+
+	failMidway = true
+	got = ask()
+	if len(got) != 4 || !reflect.DeepEqual(got[1:3], want[:2]) || !strings.HasPrefix(got[3], `error: {"error":"llm stream:`) {
+		t.Errorf("failing model: events = %q, want sources, both tokens and an error", got)
+	}
+}
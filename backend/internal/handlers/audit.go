@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+
+	"landing/backend/ent"
+	"landing/backend/ent/blog"
+	"landing/backend/internal/apperr"
+	"landing/backend/internal/config"
+	"landing/backend/internal/content"
+	"landing/backend/internal/db"
+	"landing/backend/internal/seo"
+	"landing/backend/internal/validate"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// AuditRequest is the payload for auditing a draft before it is published.
+// swagger:model
+type AuditRequest struct {
+	Text string `json:"text" validate:"required" maxLength:"200000"`
+	// Keyword is the focus keyword used for the density check.
+	Keyword string `json:"keyword" maxLength:"100"`
+	// MetaDescription is used when the text has no <meta name="description">.
+	MetaDescription string `json:"meta_description" maxLength:"1000"`
+	// Format is "html" (default) or "markdown".
+	Format string `json:"format" validate:"trim" enums:"html,markdown"`
+}
+
+// AuditBlogHandler audits draft HTML without storing it.
+// @Summary Audit draft blog HTML for SEO issues
+// @Tags blogs
+// @Accept json
+// @Produce json
+// @Param data body AuditRequest true "Draft payload"
+// @Success 200 {object} seo.Report
+// @Failure 400 {object} apperr.Problem
+// @Failure 401 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /blogs/audit [post]
+func AuditBlogHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+
+	var req AuditRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.InvalidBody(err)
+	}
+	if err := validate.Request(&req); err != nil {
+		return err
+	}
+	format := parseBlogFormat(req.Format, blog.DefaultFormat)
+	doc, err := blogSourceHTML(format, req.Text)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+
+	exists, err := blogPathSet(c.UserContext(), client)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	known, err := knownPlaceholderTokens(c.UserContext(), client)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	meta := req.MetaDescription
+	if strings.TrimSpace(meta) == "" {
+		meta = content.MetaDescription(doc)
+	}
+	if strings.TrimSpace(meta) == "" {
+		meta = content.Summarize(sanitizeAndExtractBody(doc), seo.MetaDescriptionMin, seo.MetaDescriptionMax)
+	}
+	return c.JSON(seo.Audit(doc, seo.Options{
+		SiteBaseURL:       config.Load().SiteBaseURL,
+		Keyword:           req.Keyword,
+		MetaDescription:   meta,
+		BlogExists:        exists,
+		KnownPlaceholders: known,
+	}))
+}
+
+// GetBlogAuditHandler audits a published blog post.
+// @Summary Audit a blog post for SEO issues
+// @Tags blogs
+// @Produce json
+// @Param path path string true "Blog path"
+// @Param keyword query string false "Focus keyword (defaults to the first tag)"
+// @Success 200 {object} seo.Report
+// @Failure 401 {object} apperr.Problem
+// @Failure 404 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /blogs/{path}/audit [get]
+func GetBlogAuditHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+
+	item, err := client.Blog.Query().Where(blog.PathEQ(c.Params("path"))).WithTags().Only(c.UserContext())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperr.NotFound("blog not found")
+		}
+		return apperr.Internal(err)
+	}
+	exists, err := blogPathSet(c.UserContext(), client)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+
+	description := item.MetaDescription
+	if description == "" {
+		description = content.Summarize(item.Text, seo.MetaDescriptionMin, seo.MetaDescriptionMax)
+	}
+	keyword := strings.TrimSpace(c.Query("keyword"))
+	if keyword == "" && len(item.Edges.Tags) > 0 {
+		keyword = item.Edges.Tags[0].Name
+	}
+	return c.JSON(seo.Audit(item.Text, seo.Options{
+		SiteBaseURL:     config.Load().SiteBaseURL,
+		Keyword:         keyword,
+		MetaDescription: description,
+		BlogExists:      exists,
+	}))
+}
+
+// blogPathSet loads all blog paths and returns a membership test over them.
+func blogPathSet(ctx context.Context, client *ent.Client) (func(string) bool, error) {
+	paths, err := client.Blog.Query().Select(blog.FieldPath).Strings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	set := make(map[string]bool, len(paths))
+	for _, p := range paths {
+		set[p] = true
+	}
+	return func(p string) bool { return set[p] }, nil
+}
@@ -0,0 +1,117 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"landing/backend/ent"
+	"landing/backend/ent/blog"
+	"landing/backend/internal/apperr"
+	"landing/backend/internal/db"
+	"landing/backend/internal/validate"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// CreateAuthorRequest is the payload for creating an author profile.
+// swagger:model
+type CreateAuthorRequest struct {
+	// Email is an address such as jane@example.com; it is stored lower-cased.
+	Email       string            `json:"email" validate:"required,trim" maxLength:"254" pattern:"^[^@\\s]+@[^@\\s]+\\.[^@\\s]+$"`
+	DisplayName string            `json:"display_name" validate:"required,trim" maxLength:"100"`
+	Bio         string            `json:"bio" validate:"trim" maxLength:"2000"`
+	Avatar      string            `json:"avatar" validate:"trim" maxLength:"2048"`
+	SocialLinks map[string]string `json:"social_links" validate:"max=20"`
+}
+
+// CreateAuthorHandler creates a new author profile.
+// @Summary Create an author
+// @Tags authors
+// @Accept json
+// @Produce json
+// @Param data body CreateAuthorRequest true "Author payload"
+// @Success 201 {object} ent.User
+// @Failure 400 {object} apperr.Problem
+// @Failure 401 {object} apperr.Problem
+// @Failure 409 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /authors [post]
+func CreateAuthorHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+
+	var req CreateAuthorRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.InvalidBody(err)
+	}
+	if err := validate.Request(&req); err != nil {
+		return err
+	}
+	req.Email = strings.ToLower(req.Email)
+
+	created, err := client.User.Create().
+		SetEmail(req.Email).
+		SetDisplayName(req.DisplayName).
+		SetBio(req.Bio).
+		SetAvatar(req.Avatar).
+		SetSocialLinks(req.SocialLinks).
+		Save(c.UserContext())
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return apperr.Conflict("author with this email already exists")
+		}
+		return apperr.Internal(err)
+	}
+	return c.Status(http.StatusCreated).JSON(created)
+}
+
+// GetAuthorHandler returns an author profile together with the author's posts (newest first).
+// @Summary Get author with posts
+// @Tags authors
+// @Produce json
+// @Param id path int true "Author ID"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} apperr.Problem
+// @Failure 401 {object} apperr.Problem
+// @Failure 404 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /authors/{id} [get]
+func GetAuthorHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return apperr.Validation("invalid author id")
+	}
+	author, err := client.User.Get(c.UserContext(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperr.NotFound("author not found")
+		}
+		return apperr.Internal(err)
+	}
+	posts, err := author.QueryPosts().
+		WithTags().
+		Order(ent.Desc(blog.FieldCreatedAt)).
+		All(c.UserContext())
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	return c.JSON(fiber.Map{"author": author, "posts": posts})
+}
+
+// resolveAuthor loads the author referenced by a request, if any. A nil id yields a nil author.
+func resolveAuthor(ctx context.Context, client *ent.Client, id *int) (*ent.User, error) {
+	if id == nil {
+		return nil, nil
+	}
+	return client.User.Get(ctx, *id)
+}
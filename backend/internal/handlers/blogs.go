@@ -2,6 +2,10 @@ package handlers
 
 import (
 	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"math"
 	"net/http"
 	"regexp"
@@ -13,9 +17,20 @@ import (
 	"landing/backend/ent"
 	"landing/backend/ent/blog"
 	"landing/backend/internal/ai/embeddings"
+	"landing/backend/internal/ai/nlp"
+	"landing/backend/internal/apperr"
 	"landing/backend/internal/config"
+	"landing/backend/internal/content"
+	"landing/backend/internal/cta"
 	"landing/backend/internal/db"
+	"landing/backend/internal/markdown"
+	"landing/backend/internal/metrics"
+	"landing/backend/internal/placeholders"
 	"landing/backend/internal/sanitize"
+	"landing/backend/internal/seo"
+	"landing/backend/internal/slug"
+	"landing/backend/internal/tracing"
+	"landing/backend/internal/validate"
 
 	"github.com/gofiber/fiber/v2"
 	"golang.org/x/net/html"
@@ -24,9 +39,67 @@ import (
 // CreateBlogRequest is the payload for creating a blog.
 // swagger:model
 type CreateBlogRequest struct {
-	Category string `json:"category"`
-	Text     string `json:"text"`
-	Path     string `json:"path"`
+	Category string `json:"category" validate:"required,trim" maxLength:"100"`
+	Text     string `json:"text" maxLength:"200000"`
+	// Path is the post's URL segment: no whitespace, "/", "?", "#" or "%".
+	Path     string   `json:"path" validate:"required,trim" maxLength:"200" pattern:"^[^\\s/?#%]+$"`
+	Tags     []string `json:"tags" validate:"max=20"`
+	AuthorID *int     `json:"author_id"`
+	// FeaturedImageID references an uploaded Media item used for {FEATURED_IMAGE}.
+	FeaturedImageID *int `json:"featured_image_id"`
+	// DisableCTA opts the post out of the automatic call-to-action block.
+	DisableCTA bool `json:"disable_cta"`
+	// Format is "html" (default) or "markdown"; Markdown is rendered to HTML on publish.
+	Format string `json:"format" validate:"trim" enums:"html,markdown"`
+	// Excerpt and MetaDescription override the summaries generated from the text.
+	Excerpt         string `json:"excerpt" validate:"trim" maxLength:"1000"`
+	MetaDescription string `json:"meta_description" validate:"trim" maxLength:"320"`
+}
+
+// UpdateBlogRequest is the payload for updating a blog.
+// swagger:model
+type UpdateBlogRequest struct {
+	Category string `json:"category" validate:"trim" maxLength:"100"`
+	Text     string `json:"text" maxLength:"200000"`
+	// Tags replaces the post's tags when present; omit it to keep the current tags.
+	Tags []string `json:"tags" validate:"max=20"`
+	// AuthorID reassigns the post when present; null or 0 removes the author.
+	AuthorID nullableID `json:"author_id" swaggertype:"integer" extensions:"x-nullable"`
+	// FeaturedImageID replaces the featured image when present; null or 0
+	// removes it.
+	FeaturedImageID nullableID `json:"featured_image_id" swaggertype:"integer" extensions:"x-nullable"`
+	// DisableCTA changes the call-to-action opt-out when present.
+	DisableCTA *bool `json:"disable_cta"`
+	// Format changes the authoring format when present; text is then required.
+	Format string `json:"format" validate:"trim" enums:"html,markdown"`
+	// Excerpt and MetaDescription set author overrides when present; an empty
+	// string switches back to generating them from the text.
+	Excerpt         *string `json:"excerpt" validate:"trim" maxLength:"1000"`
+	MetaDescription *string `json:"meta_description" validate:"trim" maxLength:"320"`
+}
+
+// nullableID is an optional reference in an update: Set tells a present key
+// from an absent one, and null decodes as 0, meaning "clear the reference".
+type nullableID struct {
+	Set bool
+	ID  int
+}
+
+func (n *nullableID) UnmarshalJSON(b []byte) error {
+	n.Set = true
+	if string(b) == "null" {
+		n.ID = 0
+		return nil
+	}
+	return json.Unmarshal(b, &n.ID)
+}
+
+// ref returns the referenced ID, or nil when the reference is cleared.
+func (n nullableID) ref() *int {
+	if n.ID == 0 {
+		return nil
+	}
+	return &n.ID
 }
 
 // sanitizeAndExtractBody takes an incoming HTML string, extracts the inner HTML of the <body>
@@ -72,40 +145,6 @@ func sanitizeAndExtractBody(in string) string {
 	return sanitize.SanitizeBlogHTML(content)
 }
 
-// replacePlaceholders replaces known placeholders of the form {TOKEN} using the provided map.
-func replacePlaceholders(in string, values map[string]string) string {
-	if len(values) == 0 || strings.TrimSpace(in) == "" {
-		return in
-	}
-	// Build a replacer for all keys in one pass.
-	parts := make([]string, 0, len(values)*2)
-	for k, v := range values {
-		parts = append(parts, k, v)
-	}
-	r := strings.NewReplacer(parts...)
-	return r.Replace(in)
-}
-
-var tagRe = regexp.MustCompile(`<[^>]+>`) // naive HTML tag stripper
-
-func wordCountFromHTML(htmlStr string) int {
-	text := tagRe.ReplaceAllString(htmlStr, " ")
-	fields := strings.Fields(text)
-	return len(fields)
-}
-
-func computeReadingTimeMinutes(htmlStr string) int {
-	words := wordCountFromHTML(htmlStr)
-	if words == 0 {
-		return 1
-	}
-	m := int(math.Ceil(float64(words) / 200.0))
-	if m < 1 {
-		m = 1
-	}
-	return m
-}
-
 func buildCanonicalURL(base, path string) string {
 	b := strings.TrimRight(base, "/ ")
 	p := path
@@ -118,94 +157,99 @@ func buildCanonicalURL(base, path string) string {
 	return b + p
 }
 
-func ensureCTA(htmlStr string) string {
-	// If CTA already present (by class or by contact link), do nothing.
-	if strings.Contains(htmlStr, "cta-section") || strings.Contains(htmlStr, "href=\"/contact\"") {
-		return htmlStr
-	}
-	cta := `
-<section class="cta-section"><div class="cta-container"><h3>آماده شروع هستید؟</h3><p>برای مشاوره و شروع همکاری با ما در ارتباط باشید.</p><div class="cta-buttons"><a href="/contact" class="cta-button primary">تماس با ما</a></div></div></section>`
-	return htmlStr + cta
-}
-
 // ListBlogsHandler returns blogs, optionally filtered by category via query param `category`.
+// The category is matched by normalized slug and includes its sub-categories.
 // @Summary List blogs
 // @Tags blogs
 // @Produce json
-// @Param category query string false "Filter by category"
+// @Param category query string false "Filter by category slug"
 // @Success 200 {array} ent.Blog
-// @Failure 500 {object} map[string]string
+// @Failure 401 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
 // @Security ApiKeyAuth
 // @Router /blogs [get]
 func ListBlogsHandler(c *fiber.Ctx) error {
 	client := db.ClientFromCtx(c)
 	if client == nil {
-		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": "database client missing"})
+		return errNoClient
 	}
 
-	category := c.Query("category")
 	q := client.Blog.Query()
-	if category != "" {
-		q = q.Where(blog.CategoryEQ(category))
+	if name := c.Query("category"); name != "" {
+		cat, err := resolveCategory(c.UserContext(), client, name)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return c.JSON([]*ent.Blog{})
+			}
+			return apperr.Internal(err)
+		}
+		// Include posts filed under sub-categories.
+		ids, err := db.CategorySubtreeIDs(c.UserContext(), client, cat)
+		if err != nil {
+			return apperr.Internal(err)
+		}
+		q = q.Where(blog.CategoryIDIn(ids...))
 	}
-	items, err := q.Order(ent.Asc(blog.FieldPath)).All(c.UserContext())
+	items, err := q.WithTags().Order(ent.Asc(blog.FieldPath)).All(c.UserContext())
 	if err != nil {
-		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		return apperr.Internal(err)
 	}
 	return c.JSON(items)
 }
 
-// GetBlogByPathHandler returns a single blog by its path param.
+// GetBlogByPathHandler returns a single blog by its path param, together with its
+// table of contents and similar posts.
 // @Summary Get blog by path
 // @Tags blogs
 // @Produce json
 // @Param path path string true "Blog path"
 // @Success 200 {object} ent.Blog
-// @Failure 404 {object} map[string]string
-// @Failure 400 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 401 {object} apperr.Problem
+// @Failure 404 {object} apperr.Problem
+// @Failure 400 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
 // @Security ApiKeyAuth
 // @Router /blogs/{path} [get]
 func GetBlogByPathHandler(c *fiber.Ctx) error {
 	client := db.ClientFromCtx(c)
 	if client == nil {
-		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": "database client missing"})
+		return errNoClient
 	}
 
 	p := c.Params("path")
 	if p == "" {
-		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "missing path"})
+		return apperr.Validation("missing path")
 	}
-	item, err := client.Blog.Query().Where(blog.PathEQ(p)).Only(c.UserContext())
+	item, err := client.Blog.Query().Where(blog.PathEQ(p)).WithTags().WithAuthor().WithFeaturedImage().Only(c.UserContext())
 	if err != nil {
 		if ent.IsNotFound(err) {
-			return c.Status(http.StatusNotFound).JSON(fiber.Map{"error": "blog not found"})
+			return apperr.NotFound("blog not found")
 		}
-		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		return apperr.Internal(err)
 	}
 
-	// Generate offline embedding for the requested blog and persist (handles legacy mismatched dims)
+	// Compare passage (chunk) embeddings, combined per post with
+	// cfg.SimilarityAggregate; posts without chunks fall back to their
+	// whole-post embedding.
 	cfg := config.Load()
-	genA, genErr := embeddings.GenerateEmbedding(c.UserContext(), cfg, item.Text)
-	var a []float32
-	if genErr == nil && genA != nil && len(genA) > 0 {
-		// Best-effort persist latest embedding
-		if _, uerr := client.Blog.UpdateOneID(item.ID).SetEmbedding(genA).Save(c.UserContext()); uerr == nil {
-			item.Embedding = genA
-		}
-		a = genA
-	} else if len(item.Embedding) > 0 {
-		// Fallback to stored embedding if generation failed for some reason
-		a = item.Embedding
+	chunks, err := db.SharedChunkIndex(c.UserContext(), client)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	a := chunks.Vectors(item.ID)
+	if len(a) == 0 {
+		if e := postEmbedding(c.UserContext(), client, cfg, item); len(e) > 0 {
+			a = [][]float32{e}
+		}
 	}
 	if len(a) == 0 {
-		return c.JSON(fiber.Map{"blog": item, "similar": []any{}})
+		return c.JSON(fiber.Map{"blog": item, "toc": content.TOC(item.Text), "similar": []any{}})
 	}
 
-	// Fetch candidates and compute cosine similarity in-app.
+	// Fetch candidates and compute similarity in-app.
 	others, err := client.Blog.Query().Where(blog.PathNEQ(p)).All(c.UserContext())
 	if err != nil {
-		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		return apperr.Internal(err)
 	}
 
 	type scored struct {
@@ -213,25 +257,21 @@ func GetBlogByPathHandler(c *fiber.Ctx) error {
 		sim float64
 	}
 	scores := make([]scored, 0, len(others))
+	compared := 0
 	for _, b := range others {
-		// Prefer stored embedding; generate and persist if missing
-		var be []float32
-		if b.Embedding != nil && len(b.Embedding) == len(a) {
-			be = b.Embedding
-		} else {
-			gen, berr := embeddings.GenerateEmbedding(c.UserContext(), cfg, b.Text)
-			if berr != nil || gen == nil || len(gen) != len(a) {
-				continue
+		bv := chunks.Vectors(b.ID)
+		if len(bv) == 0 {
+			if e := postEmbedding(c.UserContext(), client, cfg, b); len(e) > 0 {
+				bv = [][]float32{e}
 			}
-			// Best-effort persist, ignore errors
-			_, _ = client.Blog.UpdateOneID(b.ID).SetEmbedding(gen).Save(c.UserContext())
-			be = gen
 		}
-		s := cosine(a, be)
-		if !math.IsNaN(s) && !math.IsInf(s, 0) {
+		compared += len(a) * len(bv)
+		s := embeddings.ChunkSimilarity(a, bv, cfg.SimilarityAggregate)
+		if s > 0 && !math.IsNaN(s) && !math.IsInf(s, 0) {
 			scores = append(scores, scored{b: b, sim: s})
 		}
 	}
+	metrics.ObserveSimilarity("related", compared)
 	sort.Slice(scores, func(i, j int) bool { return scores[i].sim > scores[j].sim })
 	// Take top 5
 	n := 5
@@ -242,7 +282,7 @@ func GetBlogByPathHandler(c *fiber.Ctx) error {
 	for i := 0; i < n; i++ {
 		similar = append(similar, scores[i].b)
 	}
-	return c.JSON(fiber.Map{"blog": item, "similar": similar})
+	return c.JSON(fiber.Map{"blog": item, "toc": content.TOC(item.Text), "similar": similar})
 }
 
 // CreateBlogHandler creates a new blog post.
@@ -252,111 +292,693 @@ func GetBlogByPathHandler(c *fiber.Ctx) error {
 // @Produce json
 // @Param data body CreateBlogRequest true "Blog payload"
 // @Success 201 {object} ent.Blog
-// @Failure 400 {object} map[string]string
-// @Failure 409 {object} map[string]string
-// @Failure 500 {object} map[string]string
+// @Failure 400 {object} apperr.Problem
+// @Failure 401 {object} apperr.Problem
+// @Failure 409 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
 // @Security ApiKeyAuth
 // @Router /blogs [post]
 func CreateBlogHandler(c *fiber.Ctx) error {
 	client := db.ClientFromCtx(c)
 	if client == nil {
-		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": "database client missing"})
+		return errNoClient
 	}
 
 	var req CreateBlogRequest
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "invalid JSON body"})
+		return apperr.InvalidBody(err)
+	}
+	// Check the payload and the rows it references together so every invalid
+	// field is reported at once.
+	errs := validate.Struct(&req)
+	format := parseBlogFormat(req.Format, blog.DefaultFormat)
+	var cat *ent.Category
+	var err error
+	if req.Category != "" {
+		if cat, err = resolveCategory(c.UserContext(), client, req.Category); err != nil {
+			if !ent.IsNotFound(err) {
+				return apperr.Internal(err)
+			}
+			errs = append(errs, apperr.Field("category", apperr.FieldUnknown, "unknown category"))
+		}
 	}
-	// Normalize inputs.
-	req.Category = strings.TrimSpace(req.Category)
-	req.Path = strings.TrimSpace(req.Path)
-	if req.Category == "" {
-		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "category is required"})
+	author, err := resolveAuthor(c.UserContext(), client, req.AuthorID)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return apperr.Internal(err)
+		}
+		errs = append(errs, apperr.Field("author_id", apperr.FieldUnknown, "unknown author"))
 	}
-	if req.Path == "" {
-		return c.Status(http.StatusBadRequest).JSON(fiber.Map{"error": "path is required"})
+	featured, err := resolveFeaturedImage(c.UserContext(), client, req.FeaturedImageID)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return apperr.Internal(err)
+		}
+		errs = append(errs, apperr.Field("featured_image_id", apperr.FieldUnknown, "unknown featured image"))
+	}
+	if len(errs) > 0 {
+		return apperr.Validation(validate.Summary(errs), errs...)
+	}
+	tags, err := db.EnsureTags(c.UserContext(), client, req.Tags)
+	if err != nil {
+		return apperr.Internal(err)
 	}
 
-	cfg := config.Load()
-
-	// First, get a sanitized body (without replacements) to estimate reading time accurately.
-	sanitizedForRT := sanitizeAndExtractBody(req.Text)
-	readingMinutes := computeReadingTimeMinutes(sanitizedForRT)
+	vars, err := customPlaceholderValues(c.UserContext(), client)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	block, err := selectCTABlock(c.UserContext(), client, cat, req.Path)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	idf, err := db.KeywordIDF(c.UserContext(), client)
+	if err != nil {
+		return apperr.Internal(err)
+	}
 
-	// Build placeholder map using config and computed values.
+	cfg := config.Load()
 	now := time.Now().UTC()
-	isoNow := now.Format(time.RFC3339)
-	dateFmt := now.Format("2006-01-02")
-	canonical := buildCanonicalURL(cfg.SiteBaseURL, req.Path)
-
-	placeholders := map[string]string{
-		"{SITE_NAME}":               cfg.SiteName,
-		"{KEYWORDS}":                "",
-		"{AUTHOR}":                  cfg.AuthorName,
-		"{FEATURED_IMAGE}":          cfg.DefaultFeaturedImage,
-		"{CANONICAL_URL}":           canonical,
-		"{SCHEMA_JSON}":             "", // if present in body, leave empty
-		"{PUBLISH_DATE}":            isoNow,
-		"{MODIFIED_DATE}":           isoNow,
-		"{CATEGORY}":                req.Category,
-		"{TAGS}":                    "",
-		"{PUBLISH_DATE_FORMATTED}":  dateFmt,
-		"{MODIFIED_DATE_FORMATTED}": dateFmt,
-		"{READING_TIME}":            strconv.Itoa(readingMinutes),
-		"{AUTHOR_BIO}":              cfg.AuthorBio,
-		"{SITE_LOGO}":               cfg.SiteLogo,
-	}
-
-	// Perform replacements on the raw input to preserve attributes like datetime.
-	replacedRaw := replacePlaceholders(req.Text, placeholders)
-
-	// Sanitize and keep only body-safe content.
-	processed := sanitize.SanitizeBlogHTML(replacedRaw)
-
-	// Ensure CTA exists at the end of the HTML content.
-	processed = ensureCTA(processed)
+	rendered, err := renderBlogHTML(c.UserContext(), cfg, req.Text, blogMeta{
+		Path:            req.Path,
+		Category:        cat,
+		Tags:            tags,
+		Author:          author,
+		FeaturedImage:   featured,
+		Published:       now,
+		Modified:        now,
+		ResolveImage:    mediaImageResolver(c.UserContext(), client, cfg.MediaImageSizes),
+		Variables:       vars,
+		CTA:             block,
+		DisableCTA:      req.DisableCTA,
+		Format:          format,
+		Excerpt:         strings.TrimSpace(req.Excerpt),
+		MetaDescription: strings.TrimSpace(req.MetaDescription),
+		KeywordIDF:      idf,
+	})
+	if err != nil {
+		return renderError(err)
+	}
 
 	// Generate offline embedding for the content (best-effort)
 	var emb []float32
-	if e, err := embeddings.GenerateEmbedding(c.UserContext(), cfg, processed); err == nil && e != nil {
+	embHash := embeddings.ContentHash(cfg, rendered.HTML)
+	if e, err := embeddings.Embed(c.UserContext(), cfg, rendered.HTML); err == nil && e != nil {
 		emb = e
 	}
 
 	builder := client.Blog.Create().
-		SetCategory(req.Category).
-		SetText(processed).
-		SetPath(req.Path)
+		SetCategory(cat.Slug).
+		SetPrimaryCategory(cat).
+		AddTags(tags...).
+		SetText(rendered.HTML).
+		SetSchemaJSON(rendered.SchemaJSON).
+		SetPath(req.Path).
+		SetDisableCta(req.DisableCTA).
+		SetFormat(format).
+		SetWordCount(rendered.Stats.Words).
+		SetCharCount(rendered.Stats.Chars).
+		SetReadingMinutes(rendered.Stats.Minutes).
+		SetExcerpt(rendered.Excerpt).
+		SetExcerptCustom(strings.TrimSpace(req.Excerpt) != "").
+		SetMetaDescription(rendered.MetaDescription).
+		SetMetaDescriptionCustom(strings.TrimSpace(req.MetaDescription) != "").
+		SetKeywords(rendered.Keywords).
+		SetCreatedAt(now).
+		SetUpdatedAt(now)
+	if author != nil {
+		builder = builder.SetAuthor(author)
+	}
+	if featured != nil {
+		builder = builder.SetFeaturedImage(featured)
+	}
+	if format == blog.FormatMarkdown {
+		builder = builder.SetSource(req.Text)
+	}
 	if len(emb) > 0 {
-		builder = builder.SetEmbedding(emb)
+		builder = builder.SetEmbedding(emb).SetEmbeddingHash(embHash)
 	}
 	created, err := builder.Save(c.UserContext())
 	if err != nil {
 		if ent.IsConstraintError(err) {
-			return c.Status(http.StatusConflict).JSON(fiber.Map{"error": "blog with this path already exists"})
+			return apperr.Conflict("blog with this path already exists")
 		}
-		return c.Status(http.StatusInternalServerError).JSON(fiber.Map{"error": err.Error()})
+		return apperr.Internal(err)
 	}
+	created.Edges.Tags = tags
+	created.Edges.Author = author
+	created.Edges.FeaturedImage = featured
 	return c.Status(http.StatusCreated).JSON(created)
 }
 
-// cosine computes cosine similarity between two equal-length float32 vectors.
-func cosine(a, b []float32) float64 {
-	if len(a) == 0 || len(a) != len(b) {
-		return 0
-	}
-	var dot float64
-	var na float64
-	var nb float64
-	for i := 0; i < len(a); i++ {
-		va := float64(a[i])
-		vb := float64(b[i])
-		dot += va * vb
-		na += va * va
-		nb += vb * vb
-	}
-	denom := math.Sqrt(na) * math.Sqrt(nb)
-	if denom == 0 {
-		return 0
-	}
-	return dot / denom
+// UpdateBlogHandler updates an existing blog post identified by path.
+// Empty fields keep their current value; `tags` replaces the tag set when present,
+// and `author_id` or `featured_image_id` set to null or 0 removes the reference.
+// A new `text` is run through the same placeholder/sanitize/CTA pipeline as on create.
+// Changing the category, tags, author or featured image, which the text and
+// structured data render, re-renders Markdown posts from their stored source;
+// HTML posts keep no source, so `text` must be resent with such a change.
+// @Summary Update a blog post
+// @Tags blogs
+// @Accept json
+// @Produce json
+// @Param path path string true "Blog path"
+// @Param data body UpdateBlogRequest true "Blog payload"
+// @Success 200 {object} ent.Blog
+// @Failure 400 {object} apperr.Problem
+// @Failure 401 {object} apperr.Problem
+// @Failure 404 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /blogs/{path} [put]
+func UpdateBlogHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+
+	var req UpdateBlogRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.InvalidBody(err)
+	}
+	errs := validate.Struct(&req)
+	ctx := c.UserContext()
+	item, err := client.Blog.Query().
+		Where(blog.PathEQ(c.Params("path"))).
+		WithPrimaryCategory().
+		WithTags().
+		WithAuthor().
+		WithFeaturedImage().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperr.NotFound("blog not found")
+		}
+		return apperr.Internal(err)
+	}
+
+	cat := item.Edges.PrimaryCategory
+	if req.Category != "" {
+		if cat, err = resolveCategory(ctx, client, req.Category); err != nil {
+			if !ent.IsNotFound(err) {
+				return apperr.Internal(err)
+			}
+			errs = append(errs, apperr.Field("category", apperr.FieldUnknown, "unknown category"))
+		}
+	} else if cat == nil {
+		errs = append(errs, apperr.Field("category", apperr.FieldRequired, "category is required"))
+	}
+	author := item.Edges.Author
+	if req.AuthorID.Set {
+		if author, err = resolveAuthor(ctx, client, req.AuthorID.ref()); err != nil {
+			if !ent.IsNotFound(err) {
+				return apperr.Internal(err)
+			}
+			errs = append(errs, apperr.Field("author_id", apperr.FieldUnknown, "unknown author"))
+		}
+	}
+	featured := item.Edges.FeaturedImage
+	if req.FeaturedImageID.Set {
+		if featured, err = resolveFeaturedImage(ctx, client, req.FeaturedImageID.ref()); err != nil {
+			if !ent.IsNotFound(err) {
+				return apperr.Internal(err)
+			}
+			errs = append(errs, apperr.Field("featured_image_id", apperr.FieldUnknown, "unknown featured image"))
+		}
+	}
+	format := parseBlogFormat(req.Format, item.Format)
+	if format != item.Format && strings.TrimSpace(req.Text) == "" {
+		errs = append(errs, apperr.Field("text", apperr.FieldRequired, "text is required when changing format"))
+	}
+	raw := req.Text
+	if strings.TrimSpace(raw) == "" && relinked(item, cat, req.Tags, author, featured) {
+		if item.Format == blog.FormatMarkdown && item.Source != "" {
+			raw = item.Source
+		} else {
+			errs = append(errs, apperr.Field("text", apperr.FieldRequired,
+				"text is required when changing the category, tags, author or featured image of an HTML post"))
+		}
+	}
+	if len(errs) > 0 {
+		return apperr.Validation(validate.Summary(errs), errs...)
+	}
+	tags := item.Edges.Tags
+	if req.Tags != nil {
+		if tags, err = db.EnsureTags(ctx, client, req.Tags); err != nil {
+			return apperr.Internal(err)
+		}
+	}
+
+	cfg := config.Load()
+	now := time.Now().UTC()
+	upd := client.Blog.UpdateOneID(item.ID).
+		SetCategory(cat.Slug).
+		SetPrimaryCategory(cat).
+		SetUpdatedAt(now)
+	if req.Tags != nil {
+		upd = upd.ClearTags().AddTags(tags...)
+	}
+	if author != nil {
+		upd = upd.SetAuthor(author)
+	} else {
+		upd = upd.ClearAuthor()
+	}
+	if featured != nil {
+		upd = upd.SetFeaturedImage(featured)
+	} else {
+		upd = upd.ClearFeaturedImage()
+	}
+	disableCTA := item.DisableCta
+	if req.DisableCTA != nil {
+		disableCTA = *req.DisableCTA
+		upd = upd.SetDisableCta(disableCTA)
+	}
+	block, err := selectCTABlock(ctx, client, cat, item.Path)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	if strings.TrimSpace(raw) != "" {
+		vars, err := customPlaceholderValues(ctx, client)
+		if err != nil {
+			return apperr.Internal(err)
+		}
+		idf, err := db.KeywordIDF(ctx, client)
+		if err != nil {
+			return apperr.Internal(err)
+		}
+		excerpt := summaryOverride(req.Excerpt, item.Excerpt, item.ExcerptCustom)
+		description := summaryOverride(req.MetaDescription, item.MetaDescription, item.MetaDescriptionCustom)
+		rendered, err := renderBlogHTML(c.UserContext(), cfg, raw, blogMeta{
+			Path:            item.Path,
+			Category:        cat,
+			Tags:            tags,
+			Author:          author,
+			FeaturedImage:   featured,
+			Published:       item.CreatedAt,
+			Modified:        now,
+			ResolveImage:    mediaImageResolver(ctx, client, cfg.MediaImageSizes),
+			Variables:       vars,
+			CTA:             block,
+			DisableCTA:      disableCTA,
+			Format:          format,
+			Excerpt:         excerpt,
+			MetaDescription: description,
+			KeywordIDF:      idf,
+		})
+		if err != nil {
+			return renderError(err)
+		}
+		upd = upd.SetText(rendered.HTML).
+			SetSchemaJSON(rendered.SchemaJSON).
+			SetFormat(format).
+			SetWordCount(rendered.Stats.Words).
+			SetCharCount(rendered.Stats.Chars).
+			SetReadingMinutes(rendered.Stats.Minutes).
+			SetExcerpt(rendered.Excerpt).
+			SetExcerptCustom(excerpt != "").
+			SetMetaDescription(rendered.MetaDescription).
+			SetMetaDescriptionCustom(description != "").
+			SetKeywords(rendered.Keywords)
+		if format == blog.FormatMarkdown {
+			upd = upd.SetSource(raw)
+		} else {
+			upd = upd.ClearSource()
+		}
+		embHash := embeddings.ContentHash(cfg, rendered.HTML)
+		if e, err := embeddings.Embed(ctx, cfg, rendered.HTML); err == nil && e != nil {
+			upd = upd.SetEmbedding(e).SetEmbeddingHash(embHash)
+		}
+	} else {
+		// The opt-out or the category's CTA variant may have changed: swap the
+		// automatically inserted block, leaving CTAs written by the author alone.
+		if text := applyCTA(cta.Remove(item.Text), block, disableCTA); text != item.Text {
+			upd = upd.SetText(text)
+		}
+		description := item.MetaDescription
+		if req.Excerpt != nil {
+			excerpt := strings.TrimSpace(*req.Excerpt)
+			if excerpt == "" {
+				excerpt = content.Summarize(item.Text, content.ExcerptMin, content.ExcerptMax)
+			}
+			upd = upd.SetExcerpt(excerpt).SetExcerptCustom(strings.TrimSpace(*req.Excerpt) != "")
+		}
+		if req.MetaDescription != nil {
+			description = strings.TrimSpace(*req.MetaDescription)
+			if description == "" {
+				description = content.Summarize(item.Text, seo.MetaDescriptionMin, seo.MetaDescriptionMax)
+			}
+			upd = upd.SetMetaDescription(description).SetMetaDescriptionCustom(strings.TrimSpace(*req.MetaDescription) != "")
+		}
+		// The meta description may have changed: refresh the structured data.
+		upd = upd.SetSchemaJSON(buildBlogSchema(c.UserContext(), cfg, item.Text, blogMeta{
+			Path:            item.Path,
+			Category:        cat,
+			Tags:            tags,
+			Author:          author,
+			FeaturedImage:   featured,
+			Published:       item.CreatedAt,
+			Modified:        now,
+			MetaDescription: description,
+		}, content.Measure(item.Text)))
+	}
+	updated, err := upd.Save(ctx)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	updated.Edges.Tags = tags
+	updated.Edges.Author = author
+	updated.Edges.FeaturedImage = featured
+	return c.JSON(updated)
+}
+
+// rerenderBlogs re-runs the publishing pipeline for stored posts (edges
+// loaded) after something they render changed outside the post, such as the
+// name or parent of their category. Markdown posts are rendered again from
+// their source. HTML posts keep no source: their call to action and
+// structured data are refreshed, but their text keeps the old output of
+// placeholders like {CATEGORY} until it is resent. Modification times are kept.
+func rerenderBlogs(ctx context.Context, client *ent.Client, cfg config.Config, posts []*ent.Blog) error {
+	var vars map[string]string
+	var idf *nlp.IDF
+	for _, b := range posts {
+		e := b.Edges
+		block, err := selectCTABlock(ctx, client, e.PrimaryCategory, b.Path)
+		if err != nil {
+			return err
+		}
+		meta := blogMeta{
+			Path:            b.Path,
+			Category:        e.PrimaryCategory,
+			Tags:            e.Tags,
+			Author:          e.Author,
+			FeaturedImage:   e.FeaturedImage,
+			Published:       b.CreatedAt,
+			Modified:        b.UpdatedAt,
+			CTA:             block,
+			DisableCTA:      b.DisableCta,
+			Format:          b.Format,
+			Excerpt:         summaryOverride(nil, b.Excerpt, b.ExcerptCustom),
+			MetaDescription: summaryOverride(nil, b.MetaDescription, b.MetaDescriptionCustom),
+		}
+		upd := client.Blog.UpdateOneID(b.ID).SetUpdatedAt(b.UpdatedAt)
+		if b.Format != blog.FormatMarkdown || b.Source == "" {
+			meta.MetaDescription = b.MetaDescription
+			upd = upd.SetText(applyCTA(cta.Remove(b.Text), block, b.DisableCta)).
+				SetSchemaJSON(buildBlogSchema(ctx, cfg, b.Text, meta, content.Measure(b.Text)))
+		} else {
+			if vars == nil {
+				if vars, err = customPlaceholderValues(ctx, client); err != nil {
+					return err
+				}
+			}
+			if idf == nil {
+				if idf, err = db.KeywordIDF(ctx, client); err != nil {
+					return err
+				}
+			}
+			meta.ResolveImage = mediaImageResolver(ctx, client, cfg.MediaImageSizes)
+			meta.Variables = vars
+			meta.KeywordIDF = idf
+			rendered, err := renderBlogHTML(ctx, cfg, b.Source, meta)
+			if err != nil {
+				return fmt.Errorf("re-render blog '%s': %w", b.Path, err)
+			}
+			upd = upd.SetText(rendered.HTML).
+				SetSchemaJSON(rendered.SchemaJSON).
+				SetWordCount(rendered.Stats.Words).
+				SetCharCount(rendered.Stats.Chars).
+				SetReadingMinutes(rendered.Stats.Minutes).
+				SetExcerpt(rendered.Excerpt).
+				SetMetaDescription(rendered.MetaDescription).
+				SetKeywords(rendered.Keywords)
+		}
+		if err := upd.Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// relinked reports whether an update changes the category, tags, author or
+// featured image of item (edges loaded), all of which its output renders.
+// Tags are compared as a set of slugs; nil tags keep the current ones.
+func relinked(item *ent.Blog, cat *ent.Category, tags []string, author *ent.User, featured *ent.Media) bool {
+	e := item.Edges
+	switch {
+	case cat != nil && (e.PrimaryCategory == nil || cat.ID != e.PrimaryCategory.ID):
+		return true
+	case (author == nil) != (e.Author == nil) || author != nil && author.ID != e.Author.ID:
+		return true
+	case (featured == nil) != (e.FeaturedImage == nil) || featured != nil && featured.ID != e.FeaturedImage.ID:
+		return true
+	case tags == nil:
+		return false
+	}
+	want := make(map[string]bool, len(tags))
+	for _, name := range tags {
+		if s := slug.Make(name); s != "" {
+			want[s] = true
+		}
+	}
+	if len(want) != len(e.Tags) {
+		return true
+	}
+	for _, t := range e.Tags {
+		if !want[t.Slug] {
+			return true
+		}
+	}
+	return false
+}
+
+// tocPlaceholderRe matches {TOC}, including the paragraph Markdown wraps it in.
+var tocPlaceholderRe = regexp.MustCompile(`(?:<p[^>]*>\s*)?\{TOC\}(?:\s*</p>)?`)
+
+// blogMeta carries the per-post values used to fill placeholders.
+type blogMeta struct {
+	Path          string
+	Category      *ent.Category
+	Tags          []*ent.Tag
+	Author        *ent.User
+	FeaturedImage *ent.Media
+	Published     time.Time
+	Modified      time.Time
+	// ResolveImage maps <img src> values to media library entries; nil disables rewriting.
+	ResolveImage sanitize.ImageResolver
+	// Variables are the site-defined placeholder values keyed by name.
+	Variables map[string]string
+	// CTA is inserted unless the post already has a call to action or DisableCTA is set.
+	CTA        cta.Block
+	DisableCTA bool
+	// Format of the raw input; Markdown is converted to HTML before anything else.
+	Format blog.Format
+	// Excerpt and MetaDescription are author overrides; empty means generate.
+	Excerpt         string
+	MetaDescription string
+	// KeywordIDF weighs the keywords extracted from the body; nil uses term frequency only.
+	KeywordIDF *nlp.IDF
+}
+
+// renderedBlog is the output of the publishing pipeline.
+type renderedBlog struct {
+	HTML            string
+	SchemaJSON      string
+	Stats           content.ReadingStats
+	Excerpt         string
+	MetaDescription string
+	Keywords        []string
+}
+
+// renderBlogHTML runs raw author HTML through the publishing pipeline:
+// placeholder replacement, JSON-LD generation, media image rewriting, sanitization
+// and CTA insertion. The pipeline and its sanitization passes are traced as
+// spans under ctx.
+func renderBlogHTML(ctx context.Context, cfg config.Config, raw string, meta blogMeta) (renderedBlog, error) {
+	ctx, span := tracing.Tracer().Start(ctx, "blog.render")
+	defer span.End()
+
+	raw, err := blogSourceHTML(meta.Format, raw)
+	if err != nil {
+		return renderedBlog{}, err
+	}
+
+	// Measure the sanitized body before replacements so placeholder values do not count.
+	var draftBody string
+	inSpan(ctx, "sanitize.draft", func() { draftBody = sanitizeAndExtractBody(raw) })
+	stats := content.Measure(draftBody)
+
+	// Posts without a linked author are attributed to the site itself.
+	authorName, authorBio := cfg.SiteName, ""
+	if meta.Author != nil {
+		authorName, authorBio = meta.Author.DisplayName, meta.Author.Bio
+	}
+
+	featuredImage := cfg.DefaultFeaturedImage
+	if meta.FeaturedImage != nil {
+		featuredImage = meta.FeaturedImage.URL
+	}
+
+	tagNames := make([]string, 0, len(meta.Tags))
+	for _, t := range meta.Tags {
+		tagNames = append(tagNames, t.Name)
+	}
+	// Without tags, {KEYWORDS} falls back to the keywords extracted from the text.
+	extracted := nlp.KeywordTerms(content.PlainText(draftBody), meta.KeywordIDF, nlp.PostKeywords)
+	keywords := strings.Join(tagNames, ", ")
+	if keywords == "" {
+		keywords = strings.Join(extracted, ", ")
+	}
+
+	// Site-defined placeholders first so built-in names always win.
+	values := make(map[string]string, len(meta.Variables)+len(placeholders.Builtins))
+	for k, v := range meta.Variables {
+		values[k] = v
+	}
+	for k, v := range map[string]string{
+		"SITE_NAME":               cfg.SiteName,
+		"KEYWORDS":                keywords,
+		"AUTHOR":                  authorName,
+		"FEATURED_IMAGE":          featuredImage,
+		"CANONICAL_URL":           blogURL(cfg.SiteBaseURL, meta.Path),
+		"PUBLISH_DATE":            meta.Published.UTC().Format(time.RFC3339),
+		"MODIFIED_DATE":           meta.Modified.UTC().Format(time.RFC3339),
+		"CATEGORY":                categoryDisplayName(meta.Category),
+		"TAGS":                    strings.Join(tagNames, "، "),
+		"PUBLISH_DATE_FORMATTED":  meta.Published.UTC().Format("2006-01-02"),
+		"MODIFIED_DATE_FORMATTED": meta.Modified.UTC().Format("2006-01-02"),
+		"READING_TIME":            strconv.Itoa(stats.Minutes),
+		"AUTHOR_BIO":              authorBio,
+		"SITE_LOGO":               cfg.SiteLogo,
+		// The structured data is served only as schema_json, which the frontend
+		// emits once; the token is kept so existing posts still render.
+		"SCHEMA_JSON": "",
+	} {
+		values[k] = v
+	}
+	// {TOC} becomes an empty <nav class="toc"> that is filled once headings have ids.
+	raw = tocPlaceholderRe.ReplaceAllString(raw, content.TOCMarker)
+	values["TOC"] = ""
+
+	// Replace on the raw input, escaping values for the text or attribute they land in.
+	replacedRaw, err := placeholders.Render(raw, values, cfg.PlaceholderStrict)
+	if err != nil {
+		return renderedBlog{}, err
+	}
+
+	// Summaries and structured data are derived from the replaced content. A
+	// <meta name="description"> in the author's HTML counts as an override.
+	var body string
+	inSpan(ctx, "sanitize.body", func() { body = sanitizeAndExtractBody(replacedRaw) })
+	if meta.MetaDescription == "" {
+		meta.MetaDescription = content.MetaDescription(replacedRaw)
+	}
+	if meta.MetaDescription == "" {
+		meta.MetaDescription = content.Summarize(body, seo.MetaDescriptionMin, seo.MetaDescriptionMax)
+	}
+	if meta.Excerpt == "" {
+		meta.Excerpt = content.Summarize(body, content.ExcerptMin, content.ExcerptMax)
+	}
+	schemaJSON := buildBlogSchema(ctx, cfg, body, meta, stats)
+
+	// Point images at the media library (and fill alt/dimensions) before sanitizing.
+	inSpan(ctx, "sanitize.rewrite_images", func() { replacedRaw = sanitize.RewriteImages(replacedRaw, meta.ResolveImage) })
+
+	// Sanitize and keep only body-safe content.
+	var processed string
+	inSpan(ctx, "sanitize.html", func() { processed = sanitize.SanitizeBlogHTML(replacedRaw) })
+
+	// Give h2–h4 stable ids for deep links and fill the table of contents.
+	processed = content.AnchorHeadings(processed)
+
+	processed = applyCTA(processed, meta.CTA, meta.DisableCTA)
+
+	return renderedBlog{
+		HTML:            processed,
+		SchemaJSON:      schemaJSON,
+		Stats:           stats,
+		Excerpt:         meta.Excerpt,
+		MetaDescription: meta.MetaDescription,
+		Keywords:        extracted,
+	}, nil
+}
+
+// inSpan runs fn inside a child span of ctx named name.
+func inSpan(ctx context.Context, name string, fn func()) {
+	_, span := tracing.Tracer().Start(ctx, name)
+	defer span.End()
+	fn()
+}
+
+// summaryOverride resolves the author override for an excerpt or meta description:
+// the request value when present, else the stored value if it was custom.
+func summaryOverride(req *string, stored string, custom bool) string {
+	if req != nil {
+		return strings.TrimSpace(*req)
+	}
+	if custom {
+		return stored
+	}
+	return ""
+}
+
+// parseBlogFormat normalizes a requested authoring format, already checked
+// against the request's enums tag; empty means fallback.
+func parseBlogFormat(s string, fallback blog.Format) blog.Format {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return fallback
+	}
+	return blog.Format(s)
+}
+
+// blogSourceHTML converts author input to HTML according to its format.
+func blogSourceHTML(format blog.Format, text string) (string, error) {
+	if format == blog.FormatMarkdown {
+		return markdown.Render(text)
+	}
+	return text, nil
+}
+
+// renderError reports a publishing pipeline failure; unknown placeholders in
+// strict mode are the author's mistake and are listed in the response, both as
+// field errors of "text" and in a "placeholders" member.
+func renderError(err error) error {
+	var unknown *placeholders.UnknownError
+	if errors.As(err, &unknown) {
+		fields := make([]apperr.FieldError, 0, len(unknown.Names))
+		for _, name := range unknown.Names {
+			fields = append(fields, apperr.Field("text", apperr.FieldUnknown, "unknown placeholder "+placeholders.Token(name)))
+		}
+		return apperr.Validation(err.Error(), fields...).With("placeholders", unknown.Names)
+	}
+	return apperr.Internal(err)
+}
+
+// categoryDisplayName returns the Persian display name of a category, falling back to its slug.
+func categoryDisplayName(cat *ent.Category) string {
+	if n := strings.TrimSpace(cat.Name["fa"]); n != "" {
+		return n
+	}
+	return cat.Slug
+}
+
+// postEmbedding returns the whole-post embedding of b. The stored one is used
+// while its content hash matches the text and model; otherwise a new one is
+// generated and persisted.
+func postEmbedding(ctx context.Context, client *ent.Client, cfg config.Config, b *ent.Blog) []float32 {
+	hash := embeddings.ContentHash(cfg, b.Text)
+	if b.EmbeddingHash == hash && len(b.Embedding) > 0 {
+		return b.Embedding
+	}
+	e, err := embeddings.Embed(ctx, cfg, b.Text)
+	if err != nil || len(e) == 0 {
+		return b.Embedding
+	}
+	// Best-effort persist, ignore errors. The post itself did not change.
+	_ = client.Blog.UpdateOneID(b.ID).
+		SetEmbedding(e).
+		SetEmbeddingHash(hash).
+		SetUpdatedAt(b.UpdatedAt).
+		Exec(ctx)
+	return e
 }
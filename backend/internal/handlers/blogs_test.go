@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"encoding/json"
+	"testing"
+
+	"landing/backend/ent"
+)
+
+func TestRelinked(t *testing.T) {
+	cat := &ent.Category{ID: 1, Slug: "ai"}
+	author := &ent.User{ID: 2}
+	image := &ent.Media{ID: 3}
+	item := &ent.Blog{Edges: ent.BlogEdges{
+		PrimaryCategory: cat,
+		Author:          author,
+		FeaturedImage:   image,
+		Tags:            []*ent.Tag{{Slug: "go"}, {Slug: "web"}},
+	}}
+	for _, tc := range []struct {
+		name     string
+		cat      *ent.Category
+		tags     []string
+		author   *ent.User
+		featured *ent.Media
+		want     bool
+	}{
+		{"unchanged", cat, nil, author, image, false},
+		{"same tags reordered", cat, []string{"Web", " go ", "GO"}, author, image, false},
+		{"tag added", cat, []string{"go", "web", "api"}, author, image, true},
+		{"tags cleared", cat, []string{}, author, image, true},
+		{"category", &ent.Category{ID: 4}, nil, author, image, true},
+		{"author replaced", cat, nil, &ent.User{ID: 5}, image, true},
+		{"author cleared", cat, nil, nil, image, true},
+		{"image cleared", cat, nil, author, nil, true},
+	} {
+		if got := relinked(item, tc.cat, tc.tags, tc.author, tc.featured); got != tc.want {
+			t.Errorf("%s: relinked = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestUpdateBlogRequestClearsReferences(t *testing.T) {
+	for _, tc := range []struct {
+		body         string
+		author, feat nullableID
+	}{
+		{`{}`, nullableID{}, nullableID{}},
+		{`{"author_id":7,"featured_image_id":3}`, nullableID{Set: true, ID: 7}, nullableID{Set: true, ID: 3}},
+		{`{"author_id":null,"featured_image_id":0}`, nullableID{Set: true}, nullableID{Set: true}},
+	} {
+		var req UpdateBlogRequest
+		if err := json.Unmarshal([]byte(tc.body), &req); err != nil {
+			t.Errorf("%s: %v", tc.body, err)
+			continue
+		}
+		if req.AuthorID != tc.author || req.FeaturedImageID != tc.feat {
+			t.Errorf("%s: author_id = %+v, featured_image_id = %+v, want %+v, %+v", tc.body, req.AuthorID, req.FeaturedImageID, tc.author, tc.feat)
+		}
+		if tc.author.Set && tc.author.ID == 0 && req.AuthorID.ref() != nil {
+			t.Errorf("%s: ref() = %v, want nil to clear the author", tc.body, *req.AuthorID.ref())
+		}
+	}
+	var req UpdateBlogRequest
+	if err := json.Unmarshal([]byte(`{"author_id":"x"}`), &req); err == nil {
+		t.Error(`author_id "x": want a decoding error`)
+	}
+}
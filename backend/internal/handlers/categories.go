@@ -0,0 +1,382 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"maps"
+	"net/http"
+
+	"landing/backend/ent"
+	"landing/backend/ent/blog"
+	"landing/backend/ent/category"
+	"landing/backend/internal/apperr"
+	"landing/backend/internal/config"
+	"landing/backend/internal/db"
+	"landing/backend/internal/slug"
+	"landing/backend/internal/validate"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// CategoryRequest is the payload for creating or updating a category.
+// swagger:model
+type CategoryRequest struct {
+	// Slug is required when creating; it is normalized, so "AI" becomes "ai".
+	Slug string `json:"slug" maxLength:"100"`
+	// Name maps language codes to display names, e.g. {"fa": "هوش مصنوعی"}.
+	Name        map[string]string `json:"name" validate:"max=10"`
+	Description string            `json:"description" validate:"trim" maxLength:"2000"`
+	// Parent is the slug of the parent category; empty means top-level.
+	Parent string `json:"parent" maxLength:"100"`
+}
+
+// CategoryWithCount is a category together with the number of posts in it and
+// its sub-categories, the posts a ?category= filter on it returns.
+// swagger:model
+type CategoryWithCount struct {
+	*ent.Category
+	PostCount int `json:"post_count"`
+}
+
+// ListCategoriesHandler returns all categories with their post counts (sub-categories included).
+// @Summary List categories
+// @Tags categories
+// @Produce json
+// @Success 200 {array} CategoryWithCount
+// @Failure 401 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /categories [get]
+func ListCategoriesHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+
+	cats, err := client.Category.Query().Order(ent.Asc(category.FieldSlug)).All(c.UserContext())
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	counts, err := categoryPostCounts(c.UserContext(), client, cats)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	out := make([]CategoryWithCount, 0, len(cats))
+	for _, cat := range cats {
+		out = append(out, CategoryWithCount{Category: cat, PostCount: counts[cat.ID]})
+	}
+	return c.JSON(out)
+}
+
+// GetCategoryHandler returns a single category by slug.
+// @Summary Get category by slug
+// @Tags categories
+// @Produce json
+// @Param slug path string true "Category slug"
+// @Success 200 {object} CategoryWithCount
+// @Failure 401 {object} apperr.Problem
+// @Failure 404 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /categories/{slug} [get]
+func GetCategoryHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+
+	cat, err := client.Category.Query().
+		Where(category.SlugEQ(slug.Make(c.Params("slug")))).
+		WithChildren().
+		Only(c.UserContext())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperr.NotFound("category not found")
+		}
+		return apperr.Internal(err)
+	}
+	ids, err := db.CategorySubtreeIDs(c.UserContext(), client, cat)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	n, err := client.Blog.Query().Where(blog.CategoryIDIn(ids...)).Count(c.UserContext())
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	return c.JSON(CategoryWithCount{Category: cat, PostCount: n})
+}
+
+// CreateCategoryHandler creates a new category.
+// @Summary Create a category
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param data body CategoryRequest true "Category payload"
+// @Success 201 {object} ent.Category
+// @Failure 400 {object} apperr.Problem
+// @Failure 401 {object} apperr.Problem
+// @Failure 409 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /categories [post]
+func CreateCategoryHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+
+	var req CategoryRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.InvalidBody(err)
+	}
+	if err := validate.Request(&req); err != nil {
+		return err
+	}
+	s := slug.Make(req.Slug)
+	if s == "" {
+		return apperr.InvalidField("slug", apperr.FieldRequired, "slug is required")
+	}
+
+	builder := client.Category.Create().
+		SetSlug(s).
+		SetName(req.Name).
+		SetDescription(req.Description)
+	if p := slug.Make(req.Parent); p != "" {
+		parent, err := client.Category.Query().Where(category.SlugEQ(p)).Only(c.UserContext())
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return apperr.InvalidField("parent", apperr.FieldUnknown, "parent category not found")
+			}
+			return apperr.Internal(err)
+		}
+		builder = builder.SetParent(parent)
+	}
+	created, err := builder.Save(c.UserContext())
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return apperr.Conflict("category with this slug already exists")
+		}
+		return apperr.Internal(err)
+	}
+	return c.Status(http.StatusCreated).JSON(created)
+}
+
+// UpdateCategoryHandler replaces a category's slug, names, description and parent.
+// Renaming the slug also rewrites the category string of every linked blog.
+// A new slug, name or parent, which posts render as {CATEGORY}, breadcrumbs
+// and the inherited call to action, re-renders the posts of the category and
+// its sub-categories in the same transaction. Markdown posts are rendered from
+// their source; HTML posts keep no source, so their text keeps the old
+// {CATEGORY} output until it is resent (their CTA and structured data are refreshed).
+// @Summary Update a category
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param slug path string true "Category slug"
+// @Param data body CategoryRequest true "Category payload"
+// @Success 200 {object} ent.Category
+// @Failure 400 {object} apperr.Problem
+// @Failure 401 {object} apperr.Problem
+// @Failure 404 {object} apperr.Problem
+// @Failure 409 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /categories/{slug} [put]
+func UpdateCategoryHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+
+	var req CategoryRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.InvalidBody(err)
+	}
+	if err := validate.Request(&req); err != nil {
+		return err
+	}
+	ctx := c.UserContext()
+	cat, err := client.Category.Query().Where(category.SlugEQ(slug.Make(c.Params("slug")))).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperr.NotFound("category not found")
+		}
+		return apperr.Internal(err)
+	}
+
+	// The cycle check and the update share a serializable transaction, so two
+	// concurrent reparent requests cannot combine into a cycle: Postgres
+	// aborts one of them instead.
+	tx, err := client.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelSerializable})
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	updated, err := updateCategory(ctx, tx, cat, req)
+	if err == nil {
+		err = tx.Commit()
+	} else {
+		_ = tx.Rollback()
+	}
+	if err != nil {
+		switch {
+		case ent.IsConstraintError(err):
+			return apperr.Conflict("category with this slug already exists")
+		case db.IsSerializationFailure(err):
+			return apperr.Conflict("categories were changed concurrently; retry the request")
+		}
+		return apperr.Internal(err)
+	}
+	return c.JSON(updated)
+}
+
+// updateCategory applies req to cat within tx, rejecting a parent that is the
+// category itself or one of its descendants.
+func updateCategory(ctx context.Context, tx *ent.Tx, cat *ent.Category, req CategoryRequest) (*ent.Category, error) {
+	newSlug := slug.Make(req.Slug)
+	if newSlug == "" {
+		newSlug = cat.Slug
+	}
+	var newParent *int
+	upd := tx.Category.UpdateOneID(cat.ID).
+		SetSlug(newSlug).
+		SetName(req.Name).
+		SetDescription(req.Description)
+	if p := slug.Make(req.Parent); p != "" {
+		parent, err := tx.Category.Query().Where(category.SlugEQ(p)).Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return nil, apperr.InvalidField("parent", apperr.FieldUnknown, "parent category not found")
+			}
+			return nil, err
+		}
+		subtree, err := db.CategorySubtreeIDs(ctx, tx.Client(), cat)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range subtree {
+			if id == parent.ID {
+				return nil, apperr.InvalidField("parent", apperr.FieldInvalid, "category cannot be its own ancestor")
+			}
+		}
+		upd = upd.SetParentID(parent.ID)
+		newParent = &parent.ID
+	} else {
+		upd = upd.ClearParent()
+	}
+	updated, err := upd.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if newSlug != cat.Slug {
+		if err := tx.Blog.Update().Where(blog.CategoryIDEQ(cat.ID)).SetCategory(newSlug).Exec(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if newSlug == cat.Slug && maps.Equal(req.Name, cat.Name) && equalIDs(newParent, cat.ParentID) {
+		return updated, nil
+	}
+	subtree, err := db.CategorySubtreeIDs(ctx, tx.Client(), updated)
+	if err != nil {
+		return nil, err
+	}
+	posts, err := tx.Blog.Query().
+		Where(blog.CategoryIDIn(subtree...)).
+		WithPrimaryCategory().
+		WithTags().
+		WithAuthor().
+		WithFeaturedImage().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return updated, rerenderBlogs(ctx, tx.Client(), config.Load(), posts)
+}
+
+// equalIDs reports whether two optional IDs are both unset or equal.
+func equalIDs(a, b *int) bool {
+	return a == nil && b == nil || a != nil && b != nil && *a == *b
+}
+
+// DeleteCategoryHandler deletes a category that has no posts and no sub-categories.
+// @Summary Delete a category
+// @Tags categories
+// @Param slug path string true "Category slug"
+// @Success 204
+// @Failure 401 {object} apperr.Problem
+// @Failure 404 {object} apperr.Problem
+// @Failure 409 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /categories/{slug} [delete]
+func DeleteCategoryHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+
+	ctx := c.UserContext()
+	cat, err := client.Category.Query().Where(category.SlugEQ(slug.Make(c.Params("slug")))).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperr.NotFound("category not found")
+		}
+		return apperr.Internal(err)
+	}
+	inUse, err := client.Blog.Query().Where(blog.CategoryIDEQ(cat.ID)).Exist(ctx)
+	if err == nil && !inUse {
+		inUse, err = cat.QueryChildren().Exist(ctx)
+	}
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	if inUse {
+		return apperr.Conflict("category still has posts or sub-categories")
+	}
+	if err := client.Category.DeleteOneID(cat.ID).Exec(ctx); err != nil {
+		return apperr.Internal(err)
+	}
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// resolveCategory looks up a category by a user-supplied name or slug, normalizing it
+// first so that "AI", " ai " and "ai" all resolve to the same entity.
+func resolveCategory(ctx context.Context, client *ent.Client, name string) (*ent.Category, error) {
+	return client.Category.Query().Where(category.SlugEQ(slug.Make(name))).Only(ctx)
+}
+
+// categoryPostCounts returns the number of blogs per category ID, adding the
+// posts of each category to all of its ancestors among cats.
+func categoryPostCounts(ctx context.Context, client *ent.Client, cats []*ent.Category) (map[int]int, error) {
+	var rows []struct {
+		CategoryID *int `json:"category_id"`
+		Count      int  `json:"count"`
+	}
+	if err := client.Blog.Query().
+		GroupBy(blog.FieldCategoryID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	parents := make(map[int]*int, len(cats))
+	for _, cat := range cats {
+		parents[cat.ID] = cat.ParentID
+	}
+	counts := make(map[int]int, len(cats))
+	for _, r := range rows {
+		if r.CategoryID == nil {
+			continue
+		}
+		// The seen set guards against a parent cycle in existing data.
+		id := *r.CategoryID
+		for seen := map[int]bool{}; !seen[id]; {
+			seen[id] = true
+			counts[id] += r.Count
+			p := parents[id]
+			if p == nil {
+				break
+			}
+			id = *p
+		}
+	}
+	return counts, nil
+}
@@ -0,0 +1,293 @@
+package handlers
+
+import (
+	"context"
+	"hash/fnv"
+	"net/http"
+	"strings"
+
+	"landing/backend/ent"
+	"landing/backend/ent/ctablock"
+	"landing/backend/internal/apperr"
+	"landing/backend/internal/cta"
+	"landing/backend/internal/db"
+	"landing/backend/internal/validate"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// CTABlockRequest is the payload for creating or replacing a CTA block.
+// swagger:model
+type CTABlockRequest struct {
+	Name string `json:"name" validate:"required,trim" maxLength:"100"`
+	// Category is a category slug; empty applies the block to categories without their own.
+	Category   string `json:"category" validate:"trim" maxLength:"100"`
+	Heading    string `json:"heading" maxLength:"200"`
+	Body       string `json:"body" maxLength:"2000"`
+	ButtonText string `json:"button_text" maxLength:"100"`
+	// ButtonURL is a site-relative path or an http(s) URL.
+	ButtonURL string `json:"button_url" validate:"trim" maxLength:"2048" pattern:"^(/([^/].*)?|https?://.+)$"`
+	// Placement is "end" (default) or "after_paragraph".
+	Placement string `json:"placement" validate:"trim" enums:"end,after_paragraph"`
+	// Paragraph is N for after_paragraph placement (default 3).
+	Paragraph *int  `json:"paragraph" minimum:"1" maximum:"100"`
+	Active    *bool `json:"active"`
+}
+
+// ListCTABlocksHandler returns CTA blocks, optionally filtered by category.
+// @Summary List CTA blocks
+// @Tags cta
+// @Produce json
+// @Param category query string false "Filter by category slug"
+// @Success 200 {array} ent.CTABlock
+// @Failure 401 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /cta-blocks [get]
+func ListCTABlocksHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+
+	q := client.CTABlock.Query()
+	if name := c.Query("category"); name != "" {
+		cat, err := resolveCategory(c.UserContext(), client, name)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return c.JSON([]*ent.CTABlock{})
+			}
+			return apperr.Internal(err)
+		}
+		q = q.Where(ctablock.CategoryIDEQ(cat.ID))
+	}
+	items, err := q.Order(ent.Asc(ctablock.FieldID)).All(c.UserContext())
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	return c.JSON(items)
+}
+
+// CreateCTABlockHandler creates a CTA block.
+// @Summary Create a CTA block
+// @Tags cta
+// @Accept json
+// @Produce json
+// @Param data body CTABlockRequest true "CTA block payload"
+// @Success 201 {object} ent.CTABlock
+// @Failure 400 {object} apperr.Problem
+// @Failure 401 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /cta-blocks [post]
+func CreateCTABlockHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+
+	var req CTABlockRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.InvalidBody(err)
+	}
+	if err := validateCTABlockRequest(&req); err != nil {
+		return err
+	}
+	ctx := c.UserContext()
+	builder := client.CTABlock.Create().
+		SetName(req.Name).
+		SetHeading(req.Heading).
+		SetBody(req.Body).
+		SetButtonText(req.ButtonText).
+		SetButtonURL(req.ButtonURL).
+		SetPlacement(ctablock.Placement(req.Placement)).
+		SetNillableParagraph(req.Paragraph).
+		SetNillableActive(req.Active)
+	if req.Category != "" {
+		cat, err := resolveCategory(ctx, client, req.Category)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return apperr.InvalidField("category", apperr.FieldUnknown, "unknown category")
+			}
+			return apperr.Internal(err)
+		}
+		builder = builder.SetCategory(cat)
+	}
+	item, err := builder.Save(ctx)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	return c.Status(http.StatusCreated).JSON(item)
+}
+
+// UpdateCTABlockHandler replaces a CTA block. Paragraph and active keep their values when omitted.
+// Posts pick up the change the next time they are saved.
+// @Summary Update a CTA block
+// @Tags cta
+// @Accept json
+// @Produce json
+// @Param id path int true "CTA block ID"
+// @Param data body CTABlockRequest true "CTA block payload"
+// @Success 200 {object} ent.CTABlock
+// @Failure 400 {object} apperr.Problem
+// @Failure 401 {object} apperr.Problem
+// @Failure 404 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /cta-blocks/{id} [put]
+func UpdateCTABlockHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return apperr.Validation("invalid CTA block id")
+	}
+	var req CTABlockRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.InvalidBody(err)
+	}
+	if err := validateCTABlockRequest(&req); err != nil {
+		return err
+	}
+	ctx := c.UserContext()
+	upd := client.CTABlock.UpdateOneID(id).
+		SetName(req.Name).
+		SetHeading(req.Heading).
+		SetBody(req.Body).
+		SetButtonText(req.ButtonText).
+		SetButtonURL(req.ButtonURL).
+		SetPlacement(ctablock.Placement(req.Placement)).
+		SetNillableParagraph(req.Paragraph).
+		SetNillableActive(req.Active)
+	if req.Category != "" {
+		cat, err := resolveCategory(ctx, client, req.Category)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return apperr.InvalidField("category", apperr.FieldUnknown, "unknown category")
+			}
+			return apperr.Internal(err)
+		}
+		upd = upd.SetCategory(cat)
+	} else {
+		upd = upd.ClearCategory()
+	}
+	item, err := upd.Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperr.NotFound("CTA block not found")
+		}
+		return apperr.Internal(err)
+	}
+	return c.JSON(item)
+}
+
+// DeleteCTABlockHandler deletes a CTA block.
+// @Summary Delete a CTA block
+// @Tags cta
+// @Param id path int true "CTA block ID"
+// @Success 204
+// @Failure 400 {object} apperr.Problem
+// @Failure 401 {object} apperr.Problem
+// @Failure 404 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /cta-blocks/{id} [delete]
+func DeleteCTABlockHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return apperr.Validation("invalid CTA block id")
+	}
+	if err := client.CTABlock.DeleteOneID(id).Exec(c.UserContext()); err != nil {
+		if ent.IsNotFound(err) {
+			return apperr.NotFound("CTA block not found")
+		}
+		return apperr.Internal(err)
+	}
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// validateCTABlockRequest normalizes req and checks its tags and the rules
+// between fields, reporting every invalid field at once.
+func validateCTABlockRequest(req *CTABlockRequest) error {
+	errs := validate.Struct(req)
+	req.Placement = strings.ToLower(req.Placement)
+	if req.Placement == "" {
+		req.Placement = cta.PlaceEnd
+	}
+	if req.Heading == "" && req.Body == "" && req.ButtonText == "" {
+		errs = append(errs, apperr.Field("heading", apperr.FieldRequired, "heading, body or button_text is required"))
+	}
+	if (req.ButtonText == "") != (req.ButtonURL == "") {
+		errs = append(errs, apperr.Field("button_url", apperr.FieldInvalid, "button_text and button_url go together"))
+	}
+	if len(errs) > 0 {
+		return apperr.Validation(validate.Summary(errs), errs...)
+	}
+	return nil
+}
+
+// selectCTABlock picks the CTA block for a post: active blocks of its category,
+// else of the nearest ancestor category, else uncategorized ones, else cta.Default.
+// Among several variants the choice is a stable hash of the post path, so a post
+// keeps its variant across edits while variants are spread over posts.
+func selectCTABlock(ctx context.Context, client *ent.Client, cat *ent.Category, path string) (cta.Block, error) {
+	var blocks []*ent.CTABlock
+	var err error
+	for seen := map[int]bool{}; cat != nil && !seen[cat.ID]; {
+		seen[cat.ID] = true
+		blocks, err = client.CTABlock.Query().
+			Where(ctablock.CategoryIDEQ(cat.ID), ctablock.Active(true)).
+			Order(ent.Asc(ctablock.FieldID)).
+			All(ctx)
+		if err != nil || len(blocks) > 0 || cat.ParentID == nil {
+			break
+		}
+		if cat, err = client.Category.Get(ctx, *cat.ParentID); err != nil {
+			break
+		}
+	}
+	if err != nil {
+		return cta.Block{}, err
+	}
+	if len(blocks) == 0 {
+		blocks, err = client.CTABlock.Query().
+			Where(ctablock.CategoryIDIsNil(), ctablock.Active(true)).
+			Order(ent.Asc(ctablock.FieldID)).
+			All(ctx)
+		if err != nil {
+			return cta.Block{}, err
+		}
+	}
+	if len(blocks) == 0 {
+		return cta.Default, nil
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(path))
+	b := blocks[h.Sum32()%uint32(len(blocks))]
+	return cta.Block{
+		ID:         b.ID,
+		Heading:    b.Heading,
+		Body:       b.Body,
+		ButtonText: b.ButtonText,
+		ButtonURL:  b.ButtonURL,
+		Placement:  string(b.Placement),
+		Paragraph:  b.Paragraph,
+	}, nil
+}
+
+// applyCTA inserts block into processed post HTML unless the post opted out or
+// already contains a call to action (detected on the parsed DOM).
+func applyCTA(processed string, block cta.Block, disabled bool) string {
+	if disabled || cta.Present(processed) {
+		return processed
+	}
+	return cta.Insert(processed, block)
+}
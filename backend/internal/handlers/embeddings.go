@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"landing/backend/internal/ai/embeddings"
+	"landing/backend/internal/apperr"
+	"landing/backend/internal/config"
+	"landing/backend/internal/db"
+)
+
+// EmbeddingStats reports the active embedding model and the cache hit rate.
+// swagger:model
+type EmbeddingStats struct {
+	// Model is the version embedded in content hashes, e.g. "v2/512" or, once
+	// corpus statistics are loaded, "v2/512/idf-9f86d081884c7d65".
+	Model string                `json:"model"`
+	Cache embeddings.CacheStats `json:"cache"`
+}
+
+// EmbeddingStatsHandler returns a handler reporting embedding cache hits and misses.
+// @Summary Embedding cache statistics
+// @Tags system
+// @Produce json
+// @Success 200 {object} EmbeddingStats
+// @Failure 401 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /embeddings/stats [get]
+func EmbeddingStatsHandler(cfg config.Config) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		return c.JSON(EmbeddingStats{
+			Model: embeddings.Version(cfg),
+			Cache: embeddings.DefaultCache.Stats(),
+		})
+	}
+}
+
+// RefreshEmbeddingsHandler returns a handler that recomputes the corpus IDF
+// and re-embeds the posts and passages it invalidates, as the scheduled
+// refresh (EMBEDDING_REFRESH_INTERVAL) does.
+// @Summary Refresh corpus statistics and re-embed
+// @Tags system
+// @Produce json
+// @Success 200 {object} db.EmbeddingRefresh
+// @Failure 401 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /embeddings/refresh [post]
+func RefreshEmbeddingsHandler(cfg config.Config) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		client := db.ClientFromCtx(c)
+		if client == nil {
+			return errNoClient
+		}
+		res, err := db.RefreshEmbeddings(c.UserContext(), client, cfg)
+		if err != nil {
+			return apperr.Internal(err)
+		}
+		return c.JSON(res)
+	}
+}
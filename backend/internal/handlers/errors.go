@@ -0,0 +1,10 @@
+package handlers
+
+import (
+	"errors"
+
+	"landing/backend/internal/apperr"
+)
+
+// errNoClient is returned when no Ent client is attached to the request.
+var errNoClient = apperr.Internal(errors.New("database client missing"))
@@ -0,0 +1,307 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"landing/backend/ent"
+	"landing/backend/ent/blog"
+	"landing/backend/internal/apperr"
+	"landing/backend/internal/config"
+	"landing/backend/internal/content"
+	"landing/backend/internal/db"
+	"landing/backend/internal/feeds"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// staticPages are the non-blog frontend pages listed in the sitemap.
+var staticPages = []feeds.SitemapURL{
+	{Loc: "/", ChangeFreq: "weekly", Priority: 1.0},
+	{Loc: "/services", ChangeFreq: "monthly", Priority: 0.8},
+	{Loc: "/contact", ChangeFreq: "monthly", Priority: 0.8},
+	{Loc: "/blog", ChangeFreq: "daily", Priority: 0.8},
+}
+
+// feedItemLimit caps the number of posts in RSS/Atom/JSON feeds.
+const feedItemLimit = 50
+
+// SitemapHandler serves /sitemap.xml: a single urlset while all URLs fit into one file,
+// otherwise the sitemap index.
+func SitemapHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+	count, lastMod, err := blogFeedState(c.UserContext(), client)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	if count+len(staticPages) > feeds.MaxSitemapURLs {
+		return SitemapIndexHandler(c)
+	}
+	if notModified(c, stateETag("sitemap", count, lastMod), lastMod) {
+		return c.SendStatus(http.StatusNotModified)
+	}
+	return sendSitemapPage(c, client, 1)
+}
+
+// SitemapIndexHandler serves /sitemap-index.xml listing every sitemap page.
+func SitemapIndexHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+	count, lastMod, err := blogFeedState(c.UserContext(), client)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	if notModified(c, stateETag("sitemap-index", count, lastMod), lastMod) {
+		return c.SendStatus(http.StatusNotModified)
+	}
+
+	cfg := config.Load()
+	pages := sitemapPageCount(count)
+	refs := make([]feeds.SitemapRef, 0, pages)
+	for p := 1; p <= pages; p++ {
+		refs = append(refs, feeds.SitemapRef{
+			Loc:     buildCanonicalURL(cfg.SiteBaseURL, fmt.Sprintf("/sitemap-%d.xml", p)),
+			LastMod: lastMod,
+		})
+	}
+	body, err := feeds.SitemapIndex(refs)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	c.Set(fiber.HeaderContentType, "application/xml; charset=utf-8")
+	return c.Send(body)
+}
+
+// SitemapPageHandler serves /sitemap-:page.xml, one page of at most 50,000 URLs.
+func SitemapPageHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+	page, err := c.ParamsInt("page")
+	if err != nil || page < 1 {
+		return apperr.NotFound("sitemap not found")
+	}
+	count, lastMod, err := blogFeedState(c.UserContext(), client)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	if page > sitemapPageCount(count) {
+		return apperr.NotFound("sitemap not found")
+	}
+	if notModified(c, stateETag(fmt.Sprintf("sitemap-%d", page), count, lastMod), lastMod) {
+		return c.SendStatus(http.StatusNotModified)
+	}
+	return sendSitemapPage(c, client, page)
+}
+
+// RSSFeedHandler serves /feed.xml (RSS 2.0).
+func RSSFeedHandler(c *fiber.Ctx) error {
+	return sendFeed(c, "rss", "/feed.xml", "application/rss+xml; charset=utf-8", feeds.RSS)
+}
+
+// AtomFeedHandler serves /atom.xml (Atom 1.0).
+func AtomFeedHandler(c *fiber.Ctx) error {
+	return sendFeed(c, "atom", "/atom.xml", "application/atom+xml; charset=utf-8", feeds.Atom)
+}
+
+// JSONFeedHandler serves /feed.json (JSON Feed 1.1).
+func JSONFeedHandler(c *fiber.Ctx) error {
+	return sendFeed(c, "jsonfeed", "/feed.json", "application/feed+json; charset=utf-8", feeds.JSONFeed)
+}
+
+func sendFeed(c *fiber.Ctx, kind, feedPath, contentType string, render func(feeds.Site, []feeds.Entry) ([]byte, error)) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+	ctx := c.UserContext()
+	items, err := client.Blog.Query().
+		WithPrimaryCategory().
+		WithTags().
+		WithAuthor().
+		WithFeaturedImage().
+		Order(ent.Desc(blog.FieldCreatedAt)).
+		Limit(feedItemLimit).
+		All(ctx)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+
+	cfg := config.Load()
+	site := feeds.Site{
+		Title:    cfg.SiteName,
+		URL:      buildCanonicalURL(cfg.SiteBaseURL, "/"),
+		FeedURL:  buildCanonicalURL(cfg.SiteBaseURL, feedPath),
+		Language: "fa",
+		Logo:     absoluteURL(cfg.SiteBaseURL, cfg.SiteLogo),
+	}
+	entries := make([]feeds.Entry, 0, len(items))
+	for _, b := range items {
+		entries = append(entries, feedEntry(cfg, b))
+	}
+	body, err := render(site, entries)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	// Entries also show category and author names, which change without
+	// touching any post, so the validator is a hash of the rendered feed and
+	// there is no Last-Modified.
+	sum := sha256.Sum256(body)
+	if notModified(c, fmt.Sprintf(`"%s-%x"`, kind, sum[:12]), time.Time{}) {
+		return c.SendStatus(http.StatusNotModified)
+	}
+	c.Set(fiber.HeaderContentType, contentType)
+	return c.Send(body)
+}
+
+// feedEntry converts a blog (with category, tags, author and featured image loaded) to a feed entry.
+func feedEntry(cfg config.Config, b *ent.Blog) feeds.Entry {
+	link := blogURL(cfg.SiteBaseURL, b.Path)
+	e := feeds.Entry{
+		ID:        link,
+		URL:       link,
+		Title:     content.Title(b.Text, b.Path),
+		Summary:   b.Excerpt,
+		Content:   b.Text,
+		Published: b.CreatedAt,
+		Updated:   b.UpdatedAt,
+	}
+	if e.Summary == "" {
+		e.Summary = content.Summarize(b.Text, content.ExcerptMin, content.ExcerptMax)
+	}
+	if cat := b.Edges.PrimaryCategory; cat != nil {
+		e.Categories = append(e.Categories, categoryDisplayName(cat))
+	}
+	for _, t := range b.Edges.Tags {
+		e.Categories = append(e.Categories, t.Name)
+	}
+	if a := b.Edges.Author; a != nil {
+		e.Author = a.DisplayName
+	}
+	if img := b.Edges.FeaturedImage; img != nil {
+		e.Image = absoluteURL(cfg.SiteBaseURL, img.URL)
+	}
+	return e
+}
+
+// sendSitemapPage renders page (1-based) of the sequence [static pages..., blogs by ID...].
+func sendSitemapPage(c *fiber.Ctx, client *ent.Client, page int) error {
+	cfg := config.Load()
+	start := (page - 1) * feeds.MaxSitemapURLs
+	end := start + feeds.MaxSitemapURLs
+
+	urls := make([]feeds.SitemapURL, 0)
+	for i := start; i < end && i < len(staticPages); i++ {
+		u := staticPages[i]
+		u.Loc = buildCanonicalURL(cfg.SiteBaseURL, u.Loc)
+		urls = append(urls, u)
+	}
+	offset := start - len(staticPages)
+	if offset < 0 {
+		offset = 0
+	}
+	if limit := end - len(staticPages) - offset; limit > 0 {
+		items, err := client.Blog.Query().
+			Select(blog.FieldPath, blog.FieldUpdatedAt).
+			Order(ent.Asc(blog.FieldID)).
+			Offset(offset).
+			Limit(limit).
+			All(c.UserContext())
+		if err != nil {
+			return apperr.Internal(err)
+		}
+		for _, b := range items {
+			urls = append(urls, feeds.SitemapURL{
+				Loc:        blogURL(cfg.SiteBaseURL, b.Path),
+				LastMod:    b.UpdatedAt,
+				ChangeFreq: "monthly",
+				Priority:   0.6,
+			})
+		}
+	}
+	body, err := feeds.URLSet(urls)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	c.Set(fiber.HeaderContentType, "application/xml; charset=utf-8")
+	return c.Send(body)
+}
+
+func sitemapPageCount(blogCount int) int {
+	total := blogCount + len(staticPages)
+	return (total + feeds.MaxSitemapURLs - 1) / feeds.MaxSitemapURLs
+}
+
+// blogFeedState returns the number of blogs and the most recent modification time.
+// Together they identify a version of the sitemaps, which list only post paths and
+// modification times, cheaply without rendering them.
+func blogFeedState(ctx context.Context, client *ent.Client) (int, time.Time, error) {
+	count, err := client.Blog.Query().Count(ctx)
+	if err != nil || count == 0 {
+		return count, time.Time{}, err
+	}
+	latest, err := client.Blog.Query().
+		Select(blog.FieldUpdatedAt).
+		Order(ent.Desc(blog.FieldUpdatedAt)).
+		First(ctx)
+	if err != nil {
+		return 0, time.Time{}, err
+	}
+	return count, latest.UpdatedAt, nil
+}
+
+// stateETag is the weak ETag of a sitemap document derived from blogFeedState.
+func stateETag(kind string, count int, lastMod time.Time) string {
+	return fmt.Sprintf(`W/"%s-%d-%d"`, kind, count, lastMod.UnixNano())
+}
+
+// notModified sets ETag/Last-Modified/Cache-Control validators for a feed and reports
+// whether the client's conditional headers show it already has this version. A zero
+// lastMod omits Last-Modified and ignores If-Modified-Since.
+func notModified(c *fiber.Ctx, etag string, lastMod time.Time) bool {
+	c.Set(fiber.HeaderETag, etag)
+	c.Set(fiber.HeaderCacheControl, "public, max-age=3600")
+	if !lastMod.IsZero() {
+		c.Set(fiber.HeaderLastModified, lastMod.UTC().Format(http.TimeFormat))
+	}
+
+	// If-None-Match takes precedence over If-Modified-Since (RFC 9110 §13.2.2).
+	if inm := c.Get(fiber.HeaderIfNoneMatch); inm != "" {
+		for _, tag := range strings.Split(inm, ",") {
+			if t := strings.TrimSpace(tag); t == etag || t == "*" {
+				return true
+			}
+		}
+		return false
+	}
+	if ims := c.Get(fiber.HeaderIfModifiedSince); ims != "" && !lastMod.IsZero() {
+		if t, err := http.ParseTime(ims); err == nil && !lastMod.Truncate(time.Second).After(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// blogURL returns the public URL of a blog post on the frontend (/blog/<path>).
+func blogURL(base, path string) string {
+	return buildCanonicalURL(base, "/blog/"+url.PathEscape(path))
+}
+
+// absoluteURL resolves site-relative URLs (e.g. "/favicon.ico") against base.
+func absoluteURL(base, u string) string {
+	if u == "" || strings.HasPrefix(u, "http://") || strings.HasPrefix(u, "https://") {
+		return u
+	}
+	return buildCanonicalURL(base, u)
+}
@@ -0,0 +1,405 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"image"
+	_ "image/gif"  // register GIF decoder for image.DecodeConfig
+	_ "image/jpeg" // register JPEG decoder for image.DecodeConfig
+	_ "image/png"  // register PNG decoder for image.DecodeConfig
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	_ "golang.org/x/image/webp" // register WebP decoder for image.DecodeConfig
+
+	"landing/backend/ent"
+	"landing/backend/ent/blog"
+	"landing/backend/ent/media"
+	"landing/backend/internal/apperr"
+	"landing/backend/internal/config"
+	"landing/backend/internal/db"
+	"landing/backend/internal/imaging"
+	"landing/backend/internal/logging"
+	"landing/backend/internal/sanitize"
+	"landing/backend/internal/storage"
+	"landing/backend/internal/validate"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// allowedImageTypes maps sniffed content types to the file extension used for storage.
+// SVG is deliberately excluded: it can carry scripts.
+var allowedImageTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+	"image/webp": ".webp",
+}
+
+// UpdateMediaRequest is the payload for updating media metadata.
+// swagger:model
+type UpdateMediaRequest struct {
+	Alt string `json:"alt" validate:"trim" maxLength:"500"`
+}
+
+// UploadMediaHandler returns a handler that accepts a multipart image upload (field `file`,
+// optional `alt`), validates it by content sniffing, size and pixel count, stores it and records a Media row.
+// @Summary Upload an image
+// @Tags media
+// @Accept multipart/form-data
+// @Produce json
+// @Param file formData file true "Image file (JPEG, PNG, GIF or WebP)"
+// @Param alt formData string false "Alternative text" maxlength(500)
+// @Success 201 {object} ent.Media
+// @Failure 400 {object} apperr.Problem
+// @Failure 401 {object} apperr.Problem
+// @Failure 413 {object} apperr.Problem
+// @Failure 415 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /media [post]
+func UploadMediaHandler(store storage.Storage) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		client := db.ClientFromCtx(c)
+		if client == nil {
+			return errNoClient
+		}
+		cfg := config.Load()
+
+		meta := UpdateMediaRequest{Alt: c.FormValue("alt")}
+		if err := validate.Request(&meta); err != nil {
+			return err
+		}
+		fh, err := c.FormFile("file")
+		if err != nil {
+			return apperr.InvalidField("file", apperr.FieldRequired, "missing file")
+		}
+		if fh.Size > int64(cfg.MediaMaxBytes) {
+			return apperr.New(http.StatusRequestEntityTooLarge, apperr.CodePayloadTooLarge, "file too large")
+		}
+		f, err := fh.Open()
+		if err != nil {
+			return apperr.Validation("cannot read file")
+		}
+		defer f.Close()
+		// Read at most one byte past the limit so oversized bodies are detected
+		// even if the declared part size was wrong.
+		data, err := io.ReadAll(io.LimitReader(f, int64(cfg.MediaMaxBytes)+1))
+		if err != nil {
+			return apperr.Validation("cannot read file")
+		}
+		if len(data) > cfg.MediaMaxBytes {
+			return apperr.New(http.StatusRequestEntityTooLarge, apperr.CodePayloadTooLarge, "file too large")
+		}
+
+		// Trust the bytes, not the client-supplied filename or Content-Type.
+		contentType := http.DetectContentType(data)
+		ext, ok := allowedImageTypes[contentType]
+		if !ok {
+			return apperr.New(http.StatusUnsupportedMediaType, apperr.CodeUnsupportedMediaType, "unsupported file type "+contentType)
+		}
+		imgCfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+		if err != nil {
+			return apperr.Validation("invalid image")
+		}
+		// Reject decompression bombs: a small file can declare huge dimensions.
+		if err := imaging.CheckPixels(imgCfg, cfg.MediaMaxPixels); err != nil {
+			return apperr.New(http.StatusRequestEntityTooLarge, apperr.CodePayloadTooLarge, err.Error())
+		}
+
+		key, err := newMediaKey(ext)
+		if err != nil {
+			return apperr.Internal(err)
+		}
+		ctx := c.UserContext()
+		if err := store.Put(ctx, key, bytes.NewReader(data), int64(len(data)), contentType); err != nil {
+			return apperr.Internal(fmt.Errorf("storing file %s: %w", key, err))
+		}
+		// Responsive renditions are best-effort: the original is always usable on its own.
+		variants, err := storeVariants(ctx, store, data, key, cfg.MediaVariantWidths, cfg.MediaMaxPixels)
+		if err != nil {
+			logging.FromCtx(c).Warn("media: generating variants failed", "key", key, logging.Err(err))
+		}
+		created, err := client.Media.Create().
+			SetKey(key).
+			SetURL(store.URL(key)).
+			SetContentType(contentType).
+			SetSize(int64(len(data))).
+			SetWidth(imgCfg.Width).
+			SetHeight(imgCfg.Height).
+			SetAlt(meta.Alt).
+			SetVariants(variants).
+			Save(ctx)
+		if err != nil {
+			// Do not leave orphaned objects behind.
+			_ = store.Delete(context.Background(), key)
+			for _, v := range variants {
+				_ = store.Delete(context.Background(), v.Key)
+			}
+			return apperr.Internal(err)
+		}
+		return c.Status(http.StatusCreated).JSON(created)
+	}
+}
+
+// ListMediaHandler returns uploaded media, newest first.
+// @Summary List media
+// @Tags media
+// @Produce json
+// @Success 200 {array} ent.Media
+// @Failure 401 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /media [get]
+func ListMediaHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+
+	items, err := client.Media.Query().Order(ent.Desc(media.FieldCreatedAt)).All(c.UserContext())
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	return c.JSON(items)
+}
+
+// GetMediaHandler returns a single media item.
+// @Summary Get media by ID
+// @Tags media
+// @Produce json
+// @Param id path int true "Media ID"
+// @Success 200 {object} ent.Media
+// @Failure 400 {object} apperr.Problem
+// @Failure 401 {object} apperr.Problem
+// @Failure 404 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /media/{id} [get]
+func GetMediaHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return apperr.Validation("invalid media id")
+	}
+	item, err := client.Media.Get(c.UserContext(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperr.NotFound("media not found")
+		}
+		return apperr.Internal(err)
+	}
+	return c.JSON(item)
+}
+
+// UpdateMediaHandler updates the alt text of a media item.
+// @Summary Update media alt text
+// @Tags media
+// @Accept json
+// @Produce json
+// @Param id path int true "Media ID"
+// @Param data body UpdateMediaRequest true "Media metadata"
+// @Success 200 {object} ent.Media
+// @Failure 400 {object} apperr.Problem
+// @Failure 401 {object} apperr.Problem
+// @Failure 404 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /media/{id} [put]
+func UpdateMediaHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+
+	id, err := c.ParamsInt("id")
+	if err != nil {
+		return apperr.Validation("invalid media id")
+	}
+	var req UpdateMediaRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.InvalidBody(err)
+	}
+	if err := validate.Request(&req); err != nil {
+		return err
+	}
+	updated, err := client.Media.UpdateOneID(id).SetAlt(req.Alt).Save(c.UserContext())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperr.NotFound("media not found")
+		}
+		return apperr.Internal(err)
+	}
+	return c.JSON(updated)
+}
+
+// DeleteMediaHandler returns a handler that deletes a media item and its stored object.
+// Media still used as a featured image or embedded in a post is kept (409).
+// @Summary Delete media
+// @Tags media
+// @Param id path int true "Media ID"
+// @Success 204
+// @Failure 400 {object} apperr.Problem
+// @Failure 401 {object} apperr.Problem
+// @Failure 404 {object} apperr.Problem
+// @Failure 409 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /media/{id} [delete]
+func DeleteMediaHandler(store storage.Storage) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		client := db.ClientFromCtx(c)
+		if client == nil {
+			return errNoClient
+		}
+
+		id, err := c.ParamsInt("id")
+		if err != nil {
+			return apperr.Validation("invalid media id")
+		}
+		ctx := c.UserContext()
+		item, err := client.Media.Get(ctx, id)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return apperr.NotFound("media not found")
+			}
+			return apperr.Internal(err)
+		}
+		inUse, err := item.QueryFeaturedIn().Exist(ctx)
+		if err != nil {
+			return apperr.Internal(err)
+		}
+		if inUse {
+			return apperr.Conflict("media is used as a featured image")
+		}
+		if inUse, err = mediaReferenced(ctx, client, item); err != nil {
+			return apperr.Internal(err)
+		}
+		if inUse {
+			return apperr.Conflict("media is referenced in a blog post")
+		}
+		if err := client.Media.DeleteOneID(id).Exec(ctx); err != nil {
+			return apperr.Internal(err)
+		}
+		if err := store.Delete(ctx, item.Key); err != nil {
+			return apperr.Internal(fmt.Errorf("deleting stored file %s: %w", item.Key, err))
+		}
+		for _, v := range item.Variants {
+			if err := store.Delete(ctx, v.Key); err != nil {
+				logging.FromCtx(c).Warn("media: deleting variant failed", "key", v.Key, logging.Err(err))
+			}
+		}
+		return c.SendStatus(http.StatusNoContent)
+	}
+}
+
+// storeVariants generates the responsive renditions of an upload and stores them.
+// On failure, variants stored so far are removed again.
+func storeVariants(ctx context.Context, store storage.Storage, data []byte, key string, widths []int, maxPixels int) ([]imaging.Variant, error) {
+	encoded, err := imaging.GenerateVariants(data, key, widths, maxPixels)
+	if err != nil {
+		return nil, err
+	}
+	variants := make([]imaging.Variant, 0, len(encoded))
+	for _, e := range encoded {
+		if err := store.Put(ctx, e.Key, bytes.NewReader(e.Data), e.Size, e.ContentType); err != nil {
+			for _, v := range variants {
+				_ = store.Delete(context.Background(), v.Key)
+			}
+			return nil, err
+		}
+		v := e.Variant
+		v.URL = store.URL(v.Key)
+		variants = append(variants, v)
+	}
+	return variants, nil
+}
+
+// mediaImageResolver resolves <img src> values to media library entries. Authors can
+// reference uploads as "media:<id>"; full media URLs are matched as well so that alt
+// text, dimensions and responsive srcsets get filled in for pasted links.
+func mediaImageResolver(ctx context.Context, client *ent.Client, sizes string) sanitize.ImageResolver {
+	return func(src string) (sanitize.Image, bool) {
+		if src == "" {
+			return sanitize.Image{}, false
+		}
+		var (
+			item *ent.Media
+			err  error
+		)
+		if rest, ok := strings.CutPrefix(src, "media:"); ok {
+			id, convErr := strconv.Atoi(rest)
+			if convErr != nil {
+				return sanitize.Image{}, false
+			}
+			item, err = client.Media.Get(ctx, id)
+		} else {
+			item, err = client.Media.Query().Where(media.URLEQ(src)).First(ctx)
+		}
+		if err != nil {
+			return sanitize.Image{}, false
+		}
+		return sanitize.Image{
+			URL:        item.URL,
+			Alt:        item.Alt,
+			Width:      item.Width,
+			Height:     item.Height,
+			SrcSet:     imaging.SrcSet(item.Variants, "jpeg", item.URL, item.Width),
+			WebPSrcSet: imaging.SrcSet(item.Variants, "webp", "", 0),
+			Sizes:      sizes,
+		}, true
+	}
+}
+
+// mediaReferenced reports whether a blog post embeds item, as "media:<id>" or by
+// its URL, in its stored HTML or markdown source.
+func mediaReferenced(ctx context.Context, client *ent.Client, item *ent.Media) (bool, error) {
+	ref := "media:" + strconv.Itoa(item.ID)
+	posts, err := client.Blog.Query().
+		Where(blog.Or(
+			blog.TextContains(ref), blog.SourceContains(ref),
+			blog.TextContains(item.URL), blog.SourceContains(item.URL),
+		)).
+		Select(blog.FieldText, blog.FieldSource).
+		All(ctx)
+	if err != nil {
+		return false, err
+	}
+	// "media:4" is a prefix of "media:42": confirm the reference ends there.
+	refRe := regexp.MustCompile(regexp.QuoteMeta(ref) + `\b`)
+	for _, p := range posts {
+		if strings.Contains(p.Text, item.URL) || strings.Contains(p.Source, item.URL) ||
+			refRe.MatchString(p.Text) || refRe.MatchString(p.Source) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// resolveFeaturedImage loads the media referenced by a request, if any.
+func resolveFeaturedImage(ctx context.Context, client *ent.Client, id *int) (*ent.Media, error) {
+	if id == nil {
+		return nil, nil
+	}
+	return client.Media.Get(ctx, *id)
+}
+
+// newMediaKey returns a random, date-prefixed storage key such as "2025/08/<hex>.jpg".
+func newMediaKey(ext string) (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return time.Now().UTC().Format("2006/01/") + hex.EncodeToString(b[:]) + ext, nil
+}
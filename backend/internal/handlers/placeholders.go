@@ -0,0 +1,224 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"landing/backend/ent"
+	"landing/backend/ent/placeholder"
+	"landing/backend/internal/apperr"
+	"landing/backend/internal/db"
+	"landing/backend/internal/placeholders"
+	"landing/backend/internal/validate"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// PlaceholderInfo describes a placeholder authors can use in post HTML.
+// swagger:model
+type PlaceholderInfo struct {
+	placeholders.Var
+	Token string `json:"token"`
+	// Value is set for site-defined placeholders only; built-in values depend on the post.
+	Value string `json:"value,omitempty"`
+}
+
+// PlaceholderRequest is the payload for creating or updating a site-defined placeholder.
+// swagger:model
+type PlaceholderRequest struct {
+	// Name is upper-case letters, digits and underscores, e.g. SUPPORT_PHONE;
+	// "support_phone" and "{SUPPORT_PHONE}" are accepted too.
+	Name        string `json:"name" validate:"required" maxLength:"64" pattern:"^[A-Z][A-Z0-9_]*$"`
+	Value       string `json:"value" maxLength:"2000"`
+	Description string `json:"description" validate:"trim" maxLength:"500"`
+}
+
+// ListPlaceholdersHandler returns the built-in and site-defined placeholders.
+// @Summary List placeholders
+// @Tags placeholders
+// @Produce json
+// @Success 200 {array} PlaceholderInfo
+// @Failure 401 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /placeholders [get]
+func ListPlaceholdersHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+
+	custom, err := client.Placeholder.Query().Order(ent.Asc(placeholder.FieldName)).All(c.UserContext())
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	out := make([]PlaceholderInfo, 0, len(placeholders.Builtins)+len(custom))
+	for _, v := range placeholders.Builtins {
+		out = append(out, PlaceholderInfo{Var: v, Token: placeholders.Token(v.Name)})
+	}
+	for _, p := range custom {
+		out = append(out, PlaceholderInfo{
+			Var:   placeholders.Var{Name: p.Name, Description: p.Description},
+			Token: placeholders.Token(p.Name),
+			Value: p.Value,
+		})
+	}
+	return c.JSON(out)
+}
+
+// CreatePlaceholderHandler defines a site-wide placeholder.
+// @Summary Create a placeholder
+// @Tags placeholders
+// @Accept json
+// @Produce json
+// @Param data body PlaceholderRequest true "Placeholder payload"
+// @Success 201 {object} ent.Placeholder
+// @Failure 400 {object} apperr.Problem
+// @Failure 401 {object} apperr.Problem
+// @Failure 409 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /placeholders [post]
+func CreatePlaceholderHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+
+	var req PlaceholderRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.InvalidBody(err)
+	}
+	req.Name = normalizePlaceholderName(req.Name)
+	if err := validate.Request(&req); err != nil {
+		return err
+	}
+	if placeholders.IsBuiltin(req.Name) {
+		return apperr.Conflict("name is reserved for a built-in placeholder")
+	}
+
+	p, err := client.Placeholder.Create().
+		SetName(req.Name).
+		SetValue(req.Value).
+		SetDescription(req.Description).
+		Save(c.UserContext())
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return apperr.Conflict("placeholder with this name already exists")
+		}
+		return apperr.Internal(err)
+	}
+	return c.Status(http.StatusCreated).JSON(p)
+}
+
+// UpdatePlaceholderHandler changes the value or description of a site-defined placeholder.
+// Posts pick up the new value the next time they are saved.
+// @Summary Update a placeholder
+// @Tags placeholders
+// @Accept json
+// @Produce json
+// @Param name path string true "Placeholder name"
+// @Param data body PlaceholderRequest true "Placeholder payload (name is ignored)"
+// @Success 200 {object} ent.Placeholder
+// @Failure 400 {object} apperr.Problem
+// @Failure 401 {object} apperr.Problem
+// @Failure 404 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /placeholders/{name} [put]
+func UpdatePlaceholderHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+
+	var req PlaceholderRequest
+	if err := c.BodyParser(&req); err != nil {
+		return apperr.InvalidBody(err)
+	}
+	req.Name = normalizePlaceholderName(c.Params("name"))
+	if err := validate.Request(&req); err != nil {
+		return err
+	}
+	ctx := c.UserContext()
+	p, err := client.Placeholder.Query().Where(placeholder.NameEQ(req.Name)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return apperr.NotFound("placeholder not found")
+		}
+		return apperr.Internal(err)
+	}
+	p, err = p.Update().
+		SetValue(req.Value).
+		SetDescription(req.Description).
+		Save(ctx)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	return c.JSON(p)
+}
+
+// DeletePlaceholderHandler removes a site-defined placeholder.
+// @Summary Delete a placeholder
+// @Tags placeholders
+// @Param name path string true "Placeholder name"
+// @Success 204
+// @Failure 401 {object} apperr.Problem
+// @Failure 404 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /placeholders/{name} [delete]
+func DeletePlaceholderHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+
+	n, err := client.Placeholder.Delete().
+		Where(placeholder.NameEQ(normalizePlaceholderName(c.Params("name")))).
+		Exec(c.UserContext())
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	if n == 0 {
+		return apperr.NotFound("placeholder not found")
+	}
+	return c.SendStatus(http.StatusNoContent)
+}
+
+// normalizePlaceholderName accepts "support_phone" or "{SUPPORT_PHONE}" for SUPPORT_PHONE.
+func normalizePlaceholderName(name string) string {
+	name = strings.TrimSpace(name)
+	name = strings.TrimSuffix(strings.TrimPrefix(name, "{"), "}")
+	return strings.ToUpper(name)
+}
+
+// customPlaceholderValues returns the site-defined placeholder values keyed by name.
+func customPlaceholderValues(ctx context.Context, client *ent.Client) (map[string]string, error) {
+	items, err := client.Placeholder.Query().All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]string, len(items))
+	for _, p := range items {
+		values[p.Name] = p.Value
+	}
+	return values, nil
+}
+
+// knownPlaceholderTokens lists every {NAME} token the publishing pipeline fills.
+func knownPlaceholderTokens(ctx context.Context, client *ent.Client) ([]string, error) {
+	custom, err := customPlaceholderValues(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]string, 0, len(placeholders.Builtins)+len(custom))
+	for _, v := range placeholders.Builtins {
+		out = append(out, placeholders.Token(v.Name))
+	}
+	for name := range custom {
+		out = append(out, placeholders.Token(name))
+	}
+	return out, nil
+}
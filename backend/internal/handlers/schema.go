@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"context"
+	"net/url"
+
+	"landing/backend/internal/config"
+	"landing/backend/internal/content"
+	"landing/backend/internal/jsonld"
+	"landing/backend/internal/logging"
+	"landing/backend/internal/seo"
+)
+
+// buildBlogSchema generates the JSON-LD for a post from its rendered body and metadata.
+// Missing schema.org required properties are logged; the JSON is returned regardless so
+// that partial structured data still reaches the page.
+func buildBlogSchema(ctx context.Context, cfg config.Config, bodyHTML string, meta blogMeta, stats content.ReadingStats) string {
+	post := jsonld.Post{
+		URL:            blogURL(cfg.SiteBaseURL, meta.Path),
+		Headline:       content.Title(bodyHTML, meta.Path),
+		Description:    meta.MetaDescription,
+		Image:          absoluteURL(cfg.SiteBaseURL, cfg.DefaultFeaturedImage),
+		Published:      meta.Published,
+		Modified:       meta.Modified,
+		ReadingMinutes: stats.Minutes,
+		WordCount:      stats.Words,
+	}
+	if post.Description == "" {
+		post.Description = content.Summarize(bodyHTML, seo.MetaDescriptionMin, seo.MetaDescriptionMax)
+	}
+	if meta.FeaturedImage != nil {
+		post.Image = absoluteURL(cfg.SiteBaseURL, meta.FeaturedImage.URL)
+	}
+	if meta.Author != nil {
+		post.AuthorName = meta.Author.DisplayName
+	}
+	if meta.Category != nil {
+		post.Section = categoryDisplayName(meta.Category)
+		post.SectionURL = buildCanonicalURL(cfg.SiteBaseURL, "/blog?category="+url.QueryEscape(meta.Category.Slug))
+	}
+	for _, t := range meta.Tags {
+		post.Keywords = append(post.Keywords, t.Name)
+	}
+
+	graph := jsonld.Build(jsonld.Site{
+		Name:    cfg.SiteName,
+		URL:     buildCanonicalURL(cfg.SiteBaseURL, "/"),
+		LogoURL: absoluteURL(cfg.SiteBaseURL, cfg.SiteLogo),
+	}, post)
+	for _, p := range jsonld.Validate(graph) {
+		logging.FromContext(ctx).Warn("jsonld: validation problem", "path", meta.Path, "problem", p)
+	}
+	out, err := jsonld.Marshal(graph)
+	if err != nil {
+		logging.FromContext(ctx).Error("jsonld: marshal failed", "path", meta.Path, logging.Err(err))
+		return ""
+	}
+	return out
+}
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"strings"
+
+	"landing/backend/internal/ai/embeddings"
+	"landing/backend/internal/ai/rag"
+	"landing/backend/internal/apperr"
+	"landing/backend/internal/config"
+	"landing/backend/internal/db"
+	"landing/backend/internal/metrics"
+	"landing/backend/internal/validate"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// defaultSearchLimit is the number of posts SearchHandler returns by default.
+const defaultSearchLimit = 10
+
+// searchParams are the query parameters of SearchHandler, validated like
+// request bodies; the @Param annotations mirror these rules.
+type searchParams struct {
+	Q     string `query:"q" validate:"required,trim" maxLength:"1000"`
+	Limit int    `query:"limit" minimum:"1" maximum:"50"`
+	Agg   string `query:"agg" validate:"trim" enums:"max,mean"`
+}
+
+// SearchHandler ranks posts by semantic similarity to a query. Each post is
+// scored by its passages (blog chunks), combined with `agg` (max or mean,
+// default SIMILARITY_AGGREGATE), and returned with its best passage.
+// @Summary Search blog posts by meaning
+// @Tags blogs
+// @Produce json
+// @Param q query string true "Search query" maxlength(1000)
+// @Param limit query int false "Maximum number of posts (default 10)" minimum(1) maximum(50)
+// @Param agg query string false "Chunk score aggregation" Enums(max, mean)
+// @Success 200 {array} rag.PostHit
+// @Failure 400 {object} apperr.Problem
+// @Failure 401 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /search [get]
+func SearchHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+
+	cfg := config.Load()
+	params := searchParams{Limit: defaultSearchLimit, Agg: cfg.SimilarityAggregate}
+	if err := c.QueryParser(&params); err != nil {
+		return apperr.Validation(err.Error())
+	}
+	if err := validate.Request(&params); err != nil {
+		return err
+	}
+	agg := strings.ToLower(params.Agg)
+
+	index, err := db.SharedChunkIndex(c.UserContext(), client)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	chunks := index.Chunks()
+	query, err := embeddings.Embed(c.UserContext(), cfg, params.Q)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	metrics.ObserveSimilarity("search", len(chunks))
+	return c.JSON(rag.Search(query, chunks, agg, params.Limit))
+}
@@ -0,0 +1,121 @@
+package handlers
+
+import (
+	"context"
+	"sort"
+
+	"landing/backend/ent"
+	"landing/backend/ent/blog"
+	"landing/backend/ent/tag"
+	"landing/backend/internal/apperr"
+	"landing/backend/internal/db"
+	"landing/backend/internal/slug"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/gofiber/fiber/v2"
+)
+
+// TagWithCount is a tag together with the number of posts carrying it.
+// swagger:model
+type TagWithCount struct {
+	*ent.Tag
+	PostCount int `json:"post_count"`
+}
+
+// ListTagsHandler returns all tags with their post counts, most used first.
+// @Summary List tags
+// @Tags tags
+// @Produce json
+// @Success 200 {array} TagWithCount
+// @Failure 401 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /tags [get]
+func ListTagsHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+
+	tags, err := client.Tag.Query().
+		Order(ent.Asc(tag.FieldSlug)).
+		All(c.UserContext())
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	counts, err := tagPostCounts(c.UserContext(), client)
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	out := make([]TagWithCount, 0, len(tags))
+	for _, t := range tags {
+		out = append(out, TagWithCount{Tag: t, PostCount: counts[t.ID]})
+	}
+	sortByCountDesc(out)
+	return c.JSON(out)
+}
+
+// ListBlogsByTagHandler returns the blogs carrying the given tag.
+// @Summary List blogs by tag
+// @Tags tags
+// @Produce json
+// @Param slug path string true "Tag slug"
+// @Success 200 {array} ent.Blog
+// @Failure 401 {object} apperr.Problem
+// @Failure 404 {object} apperr.Problem
+// @Failure 500 {object} apperr.Problem
+// @Security ApiKeyAuth
+// @Router /tags/{slug}/blogs [get]
+func ListBlogsByTagHandler(c *fiber.Ctx) error {
+	client := db.ClientFromCtx(c)
+	if client == nil {
+		return errNoClient
+	}
+
+	s := slug.Make(c.Params("slug"))
+	exists, err := client.Tag.Query().Where(tag.SlugEQ(s)).Exist(c.UserContext())
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	if !exists {
+		return apperr.NotFound("tag not found")
+	}
+	items, err := client.Blog.Query().
+		Where(blog.HasTagsWith(tag.SlugEQ(s))).
+		WithTags().
+		Order(ent.Asc(blog.FieldPath)).
+		All(c.UserContext())
+	if err != nil {
+		return apperr.Internal(err)
+	}
+	return c.JSON(items)
+}
+
+// tagPostCounts returns the number of posts carrying each tag, counted in the
+// database over the blog_tags join table. Unused tags are absent (zero).
+func tagPostCounts(ctx context.Context, client *ent.Client) (map[int]int, error) {
+	var rows []struct {
+		ID    int `json:"id"`
+		Count int `json:"count"`
+	}
+	if err := client.Tag.Query().
+		GroupBy(tag.FieldID).
+		Aggregate(func(s *sql.Selector) string {
+			t := sql.Table(tag.BlogsTable)
+			s.Join(t).On(s.C(tag.FieldID), t.C(tag.BlogsPrimaryKey[1]))
+			return sql.As(sql.Count("*"), "count")
+		}).
+		Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	counts := make(map[int]int, len(rows))
+	for _, r := range rows {
+		counts[r.ID] = r.Count
+	}
+	return counts, nil
+}
+
+// sortByCountDesc orders tags by post count (descending), keeping slug order for ties.
+func sortByCountDesc(items []TagWithCount) {
+	sort.SliceStable(items, func(i, j int) bool { return items[i].PostCount > items[j].PostCount })
+}
@@ -0,0 +1,31 @@
+package imaging
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SrcSet builds a srcset attribute value ("url 320w, url 640w") from the variants of
+// the given format, ordered by width. The original can be appended as the widest
+// candidate via originalURL/originalWidth; pass "" to omit it.
+func SrcSet(variants []Variant, format, originalURL string, originalWidth int) string {
+	picked := make([]Variant, 0, len(variants))
+	for _, v := range variants {
+		if v.Format == format && v.URL != "" {
+			picked = append(picked, v)
+		}
+	}
+	if len(picked) == 0 {
+		return ""
+	}
+	sort.Slice(picked, func(i, j int) bool { return picked[i].Width < picked[j].Width })
+	parts := make([]string, 0, len(picked)+1)
+	for _, v := range picked {
+		parts = append(parts, v.URL+" "+strconv.Itoa(v.Width)+"w")
+	}
+	if originalURL != "" && originalWidth > picked[len(picked)-1].Width {
+		parts = append(parts, originalURL+" "+strconv.Itoa(originalWidth)+"w")
+	}
+	return strings.Join(parts, ", ")
+}
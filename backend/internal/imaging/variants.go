@@ -0,0 +1,130 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"path"
+	"strings"
+
+	"github.com/HugoSmits86/nativewebp"
+	"golang.org/x/image/draw"
+)
+
+// Variant is a resized rendition of an uploaded image, stored next to the original.
+type Variant struct {
+	Width       int    `json:"width"`
+	Height      int    `json:"height"`
+	Format      string `json:"format"` // "webp" or "jpeg"
+	ContentType string `json:"content_type"`
+	Key         string `json:"key"`
+	URL         string `json:"url"`
+	Size        int64  `json:"size"`
+}
+
+// Encoded is a generated variant that still has to be stored.
+type Encoded struct {
+	Variant
+	Data []byte
+}
+
+// JPEGQuality is the quality used for JPEG variants.
+const JPEGQuality = 82
+
+// ErrTooManyPixels reports an image whose declared dimensions exceed the pixel limit.
+var ErrTooManyPixels = errors.New("image dimensions exceed the pixel limit")
+
+// CheckPixels returns ErrTooManyPixels when cfg declares more than maxPixels
+// pixels; maxPixels <= 0 disables the check. Decoding allocates memory for
+// the declared size however small the file is, so untrusted images must pass
+// this check before image.Decode.
+func CheckPixels(cfg image.Config, maxPixels int) error {
+	if maxPixels > 0 && int64(cfg.Width)*int64(cfg.Height) > int64(maxPixels) {
+		return fmt.Errorf("%w: %dx%d is more than %d pixels", ErrTooManyPixels, cfg.Width, cfg.Height, maxPixels)
+	}
+	return nil
+}
+
+// GenerateVariants decodes src and produces a JPEG and a WebP rendition for every
+// width in widths that is smaller than the original (images are never upscaled).
+// Keys are derived from baseKey, e.g. "2025/08/abc.png" -> "2025/08/abc-w640.webp".
+// The WebP encoder is lossless, so a WebP variant is dropped when it is not smaller
+// than its JPEG sibling; browsers then fall back to the JPEG candidate. Images
+// larger than maxPixels are refused with ErrTooManyPixels before decoding.
+func GenerateVariants(src []byte, baseKey string, widths []int, maxPixels int) ([]Encoded, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(src))
+	if err != nil {
+		return nil, fmt.Errorf("decode image: %w", err)
+	}
+	if err := CheckPixels(cfg, maxPixels); err != nil {
+		return nil, err
+	}
+	img, format, err := image.Decode(bytes.NewReader(src))
+	if err != nil {
+		return nil, fmt.Errorf("decode image: %w", err)
+	}
+	// Animated GIFs would lose their frames; serve them as uploaded.
+	if format == "gif" {
+		return nil, nil
+	}
+	b := img.Bounds()
+	stem := strings.TrimSuffix(baseKey, path.Ext(baseKey))
+
+	var out []Encoded
+	for _, w := range widths {
+		if w <= 0 || w >= b.Dx() {
+			continue
+		}
+		h := b.Dy() * w / b.Dx()
+		if h < 1 {
+			h = 1
+		}
+		resized := resize(img, w, h)
+
+		var jpg bytes.Buffer
+		if err := jpeg.Encode(&jpg, flatten(resized), &jpeg.Options{Quality: JPEGQuality}); err != nil {
+			return nil, fmt.Errorf("encode jpeg %dw: %w", w, err)
+		}
+		var webp bytes.Buffer
+		if err := nativewebp.Encode(&webp, resized, nil); err == nil && webp.Len() < jpg.Len() {
+			out = append(out, encoded(stem, w, h, "webp", "image/webp", webp.Bytes()))
+		}
+		out = append(out, encoded(stem, w, h, "jpeg", "image/jpeg", jpg.Bytes()))
+	}
+	return out, nil
+}
+
+func encoded(stem string, w, h int, format, contentType string, data []byte) Encoded {
+	ext := ".jpg"
+	if format == "webp" {
+		ext = ".webp"
+	}
+	return Encoded{
+		Variant: Variant{
+			Width:       w,
+			Height:      h,
+			Format:      format,
+			ContentType: contentType,
+			Key:         fmt.Sprintf("%s-w%d%s", stem, w, ext),
+			Size:        int64(len(data)),
+		},
+		Data: data,
+	}
+}
+
+// resize scales img to w×h with a high-quality kernel.
+func resize(img image.Image, w, h int) *image.NRGBA {
+	dst := image.NewNRGBA(image.Rect(0, 0, w, h))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, img.Bounds(), draw.Over, nil)
+	return dst
+}
+
+// flatten composites img onto white, since JPEG has no alpha channel.
+func flatten(img image.Image) image.Image {
+	dst := image.NewRGBA(img.Bounds())
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), img, img.Bounds().Min, draw.Over)
+	return dst
+}
@@ -0,0 +1,55 @@
+package imaging
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/png"
+	"testing"
+)
+
+func testPNG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewNRGBA(image.Rect(0, 0, w, h))); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestCheckPixels(t *testing.T) {
+	cases := []struct {
+		w, h, max int
+		wantErr   bool
+	}{
+		{100, 100, 10_000, false},
+		{100, 101, 10_000, true},
+		{50_000, 50_000, 40_000_000, true},
+		{50_000, 50_000, 0, false}, // disabled
+	}
+	for _, tc := range cases {
+		err := CheckPixels(image.Config{Width: tc.w, Height: tc.h}, tc.max)
+		if (err != nil) != tc.wantErr || (err != nil && !errors.Is(err, ErrTooManyPixels)) {
+			t.Errorf("CheckPixels(%dx%d, %d) = %v, want error %v", tc.w, tc.h, tc.max, err, tc.wantErr)
+		}
+	}
+}
+
+func TestGenerateVariantsRefusesOversizedImages(t *testing.T) {
+	src := testPNG(t, 400, 300)
+	if _, err := GenerateVariants(src, "a.png", []int{200}, 100_000); !errors.Is(err, ErrTooManyPixels) {
+		t.Fatalf("GenerateVariants over the limit = %v, want ErrTooManyPixels", err)
+	}
+	out, err := GenerateVariants(src, "a.png", []int{200, 800}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out) == 0 {
+		t.Fatal("no variants generated")
+	}
+	for _, v := range out {
+		if v.Width != 200 || v.Height != 150 {
+			t.Errorf("variant %s is %dx%d, want 200x150", v.Key, v.Width, v.Height)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package jsonld
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Post holds the inputs for a blog post's structured data.
+type Post struct {
+	URL            string
+	Headline       string
+	Description    string
+	Image          string
+	AuthorName     string
+	AuthorURL      string
+	Published      time.Time
+	Modified       time.Time
+	ReadingMinutes int
+	WordCount      int
+	Keywords       []string
+	Section        string // category display name
+	SectionURL     string
+}
+
+// Site holds the publisher information.
+type Site struct {
+	Name    string
+	URL     string
+	LogoURL string
+}
+
+// Node is one JSON-LD object.
+type Node map[string]any
+
+// Build returns a JSON-LD @graph containing Organization, BlogPosting and BreadcrumbList nodes.
+func Build(site Site, post Post) Node {
+	orgID := strings.TrimRight(site.URL, "/") + "/#organization"
+	org := Node{
+		"@type": "Organization",
+		"@id":   orgID,
+		"name":  site.Name,
+		"url":   site.URL,
+	}
+	if site.LogoURL != "" {
+		org["logo"] = Node{"@type": "ImageObject", "url": site.LogoURL}
+	}
+
+	posting := Node{
+		"@type":            "BlogPosting",
+		"@id":              post.URL + "#article",
+		"mainEntityOfPage": Node{"@type": "WebPage", "@id": post.URL},
+		"headline":         post.Headline,
+		"datePublished":    post.Published.UTC().Format(time.RFC3339),
+		"dateModified":     post.Modified.UTC().Format(time.RFC3339),
+		"publisher":        Node{"@id": orgID},
+		"inLanguage":       "fa",
+	}
+	if post.AuthorName != "" {
+		author := Node{"@type": "Person", "name": post.AuthorName}
+		if post.AuthorURL != "" {
+			author["url"] = post.AuthorURL
+		}
+		posting["author"] = author
+	} else {
+		// Posts without a named author are attributed to the publisher.
+		posting["author"] = Node{"@id": orgID}
+	}
+	if post.Description != "" {
+		posting["description"] = post.Description
+	}
+	if post.Image != "" {
+		posting["image"] = []string{post.Image}
+	}
+	if post.ReadingMinutes > 0 {
+		posting["timeRequired"] = fmt.Sprintf("PT%dM", post.ReadingMinutes)
+	}
+	if post.WordCount > 0 {
+		posting["wordCount"] = post.WordCount
+	}
+	if len(post.Keywords) > 0 {
+		posting["keywords"] = strings.Join(post.Keywords, ", ")
+	}
+	if post.Section != "" {
+		posting["articleSection"] = post.Section
+	}
+
+	crumbs := []Node{{"@type": "ListItem", "position": 1, "name": site.Name, "item": site.URL}}
+	if post.Section != "" && post.SectionURL != "" {
+		crumbs = append(crumbs, Node{"@type": "ListItem", "position": len(crumbs) + 1, "name": post.Section, "item": post.SectionURL})
+	}
+	crumbs = append(crumbs, Node{"@type": "ListItem", "position": len(crumbs) + 1, "name": post.Headline, "item": post.URL})
+	breadcrumb := Node{"@type": "BreadcrumbList", "itemListElement": crumbs}
+
+	return Node{
+		"@context": "https://schema.org",
+		"@graph":   []Node{org, posting, breadcrumb},
+	}
+}
+
+// Marshal encodes a node for embedding in <script type="application/ld+json">.
+// encoding/json escapes <, > and & so the output cannot close the script element.
+func Marshal(n Node) (string, error) {
+	b, err := json.Marshal(n)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// required lists the properties each type must carry to be eligible for rich results.
+var required = map[string][]string{
+	"Organization":   {"name", "url"},
+	"BlogPosting":    {"headline", "datePublished", "author", "publisher", "mainEntityOfPage", "image"},
+	"BreadcrumbList": {"itemListElement"},
+	"ListItem":       {"position", "name", "item"},
+}
+
+// Validate checks every node of a graph built by Build for missing or empty required
+// properties and returns human-readable problems (nil when valid).
+func Validate(n Node) []string {
+	var problems []string
+	graph, _ := n["@graph"].([]Node)
+	for _, node := range graph {
+		problems = append(problems, validateNode(node)...)
+		if items, ok := node["itemListElement"].([]Node); ok {
+			for _, item := range items {
+				problems = append(problems, validateNode(item)...)
+			}
+		}
+	}
+	return problems
+}
+
+func validateNode(n Node) []string {
+	typ, _ := n["@type"].(string)
+	var problems []string
+	for _, prop := range required[typ] {
+		if isEmpty(n[prop]) {
+			problems = append(problems, fmt.Sprintf("%s: missing required property %q", typ, prop))
+		}
+	}
+	return problems
+}
+
+func isEmpty(v any) bool {
+	switch x := v.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(x) == ""
+	case []string:
+		return len(x) == 0
+	case []Node:
+		return len(x) == 0
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+// Package logging configures the process-wide structured logger (log/slog)
+// and provides the request-scoped loggers handlers log through.
+package logging
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"landing/backend/internal/config"
+)
+
+// Log formats accepted by config.LogFormat.
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// New returns a logger writing to w at cfg.LogLevel in cfg.LogFormat (text in
+// development and JSON elsewhere when unset). Secrets are redacted from every
+// record, see Redact.
+func New(cfg config.Config, w io.Writer) *slog.Logger {
+	opts := &slog.HandlerOptions{
+		Level:       ParseLevel(cfg.LogLevel),
+		ReplaceAttr: replaceAttr,
+	}
+	format := cfg.LogFormat
+	if format == "" {
+		format = FormatJSON
+		if cfg.IsDevelopment() {
+			format = FormatText
+		}
+	}
+	if format == FormatText {
+		return slog.New(slog.NewTextHandler(w, opts))
+	}
+	return slog.New(slog.NewJSONHandler(w, opts))
+}
+
+// Setup makes the logger for cfg the default, so slog's package functions and
+// the standard log package write through it, registers the secrets of cfg for
+// redaction and returns the logger.
+func Setup(cfg config.Config) *slog.Logger {
+	RegisterSecrets(configSecrets(cfg)...)
+	l := New(cfg, os.Stderr)
+	slog.SetDefault(l)
+	return l
+}
+
+// ParseLevel maps "debug", "info", "warn" and "error" to slog levels; anything
+// else is info.
+func ParseLevel(s string) slog.Level {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// Err is the attribute errors are logged under.
+func Err(err error) slog.Attr {
+	return slog.Any("error", err)
+}
+
+// configSecrets returns the credentials in cfg that must never be logged.
+func configSecrets(cfg config.Config) []string {
+	secrets := []string{cfg.APIKey, cfg.LLMAPIKey, cfg.S3AccessKey, cfg.S3SecretKey}
+	if pw := dsnPassword(cfg.DatabaseURL); pw != "" {
+		secrets = append(secrets, pw)
+	}
+	return secrets
+}
@@ -0,0 +1,100 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// UserKey is the fiber.Ctx local holding the identity of the caller (set by
+// the API key middleware); it is logged as "user".
+const UserKey = "user"
+
+// localsKey holds the request logger in fiber.Ctx locals.
+const localsKey = "logger"
+
+type ctxKey struct{}
+
+// NewContext returns a copy of ctx carrying l.
+func NewContext(ctx context.Context, l *slog.Logger) context.Context {
+	return context.WithValue(ctx, ctxKey{}, l)
+}
+
+// FromContext returns the logger carried by ctx (the request logger inside a
+// request, including Ent hooks), or the default logger.
+func FromContext(ctx context.Context) *slog.Logger {
+	if l, ok := ctx.Value(ctxKey{}).(*slog.Logger); ok {
+		return l
+	}
+	return slog.Default()
+}
+
+// FromCtx returns the logger of the request: the default logger with the
+// request ID, method, path and trace ID, plus the matched route and the user
+// known at the time of the call.
+func FromCtx(c *fiber.Ctx) *slog.Logger {
+	l, ok := c.Locals(localsKey).(*slog.Logger)
+	if !ok {
+		l = slog.Default()
+	}
+	if r := c.Route(); r != nil && r.Path != "" {
+		l = l.With("route", r.Path)
+	}
+	if user, ok := c.Locals(UserKey).(string); ok && user != "" {
+		l = l.With("user", user)
+	}
+	return l
+}
+
+// Middleware attaches the request logger to the request (see FromCtx and
+// FromContext) and writes one access log record per request: info for
+// success, warn for client errors and error for server errors, with the
+// returned error if any. Errors are rendered by the app's error handler here.
+// It must run after the request ID and tracing middleware.
+func Middleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		start := time.Now()
+		l := slog.Default().With(
+			"request_id", c.Locals("requestid"),
+			"method", c.Method(),
+			"path", c.Path(),
+		)
+		if sc := trace.SpanContextFromContext(c.UserContext()); sc.IsValid() {
+			l = l.With("trace_id", sc.TraceID().String())
+		}
+		c.Locals(localsKey, l)
+		c.SetUserContext(NewContext(c.UserContext(), l))
+
+		err := c.Next()
+		if err != nil {
+			// Render the error here rather than after the middleware chain, so
+			// this record, the trace and the metrics see the final status.
+			if herr := c.App().ErrorHandler(c, err); herr != nil {
+				_ = c.SendStatus(fiber.StatusInternalServerError)
+			}
+		}
+
+		status := c.Response().StatusCode()
+		level := slog.LevelInfo
+		switch {
+		case status >= fiber.StatusInternalServerError:
+			level = slog.LevelError
+		case status >= fiber.StatusBadRequest:
+			level = slog.LevelWarn
+		}
+		attrs := []slog.Attr{
+			slog.Int("status", status),
+			slog.Duration("latency", time.Since(start)),
+			slog.Int("bytes", len(c.Response().Body())),
+			slog.String("ip", c.IP()),
+		}
+		if err != nil {
+			attrs = append(attrs, Err(err))
+		}
+		FromCtx(c).LogAttrs(c.UserContext(), level, "request", attrs...)
+		return nil
+	}
+}
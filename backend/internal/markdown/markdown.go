@@ -0,0 +1,70 @@
+// Package markdown renders CommonMark with GitHub Flavored Markdown extensions
+// (tables, strikethrough, autolinks, task lists) and footnotes to HTML.
+//
+// Raw HTML in the source is passed through: the output is expected to go
+// through the same placeholder and sanitization pipeline as HTML posts.
+package markdown
+
+import (
+	"bytes"
+	"regexp"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	extast "github.com/yuin/goldmark/extension/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer/html"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+var md = goldmark.New(
+	goldmark.WithExtensions(
+		// GFM, with table alignment as align attributes since the sanitizer drops style.
+		extension.NewTable(extension.WithTableCellAlignMethod(extension.TableCellAlignAttribute)),
+		extension.Strikethrough,
+		extension.Linkify,
+		extension.TaskList,
+		extension.Footnote,
+	),
+	goldmark.WithParserOptions(
+		parser.WithASTTransformers(util.Prioritized(dirAuto{}, 100)),
+	),
+	goldmark.WithRendererOptions(html.WithUnsafe()),
+)
+
+// escapedPlaceholderRe matches a {PLACEHOLDER} whose braces were percent-encoded
+// in a link or image destination.
+var escapedPlaceholderRe = regexp.MustCompile(`%7B([A-Z][A-Z0-9_]*)%7D`)
+
+// Render converts Markdown source to HTML. Code fences keep their info string
+// as a language-<lang> class for client-side highlighting.
+func Render(src string) (string, error) {
+	var buf bytes.Buffer
+	if err := md.Convert([]byte(src), &buf); err != nil {
+		return "", err
+	}
+	// Link destinations are URL-escaped; restore placeholders such as
+	// [link]({CANONICAL_URL}) so they are filled like in HTML posts.
+	return escapedPlaceholderRe.ReplaceAllString(buf.String(), "{$1}"), nil
+}
+
+// dirAuto sets dir="auto" on block elements so each paragraph, heading, list
+// item or table cell picks its direction from its own text: Persian paragraphs
+// render right-to-left while English ones and code stay left-to-right.
+type dirAuto struct{}
+
+func (dirAuto) Transform(doc *ast.Document, _ text.Reader, _ parser.Context) {
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch n.(type) {
+		case *ast.Paragraph, *ast.Heading, *ast.Blockquote, *ast.List, *ast.ListItem,
+			*extast.Table, *extast.TableCell:
+			n.SetAttributeString("dir", []byte("auto"))
+		}
+		return ast.WalkContinue, nil
+	})
+}
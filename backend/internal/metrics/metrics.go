@@ -0,0 +1,130 @@
+// Package metrics defines the Prometheus metrics of the API and serves them
+// in the exposition format.
+package metrics
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "landing"
+
+// Registry holds every metric of the process, including Go runtime and
+// process statistics.
+var Registry = prometheus.NewRegistry()
+
+var (
+	httpRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests by method, route pattern and status code.",
+	}, []string{"method", "route", "status"})
+	httpDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency by method, route pattern and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+	httpInFlight = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "http_requests_in_flight",
+		Help:      "HTTP requests currently being served.",
+	})
+
+	embeddingDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "embedding_generation_duration_seconds",
+		Help:      "Time to generate one embedding, by model version.",
+		Buckets:   prometheus.ExponentialBuckets(0.0001, 4, 8), // 0.1ms .. 1.6s
+	}, []string{"model"})
+	embeddingCache = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "embedding_cache_requests_total",
+		Help:      "Embedding cache lookups by result (hit or miss).",
+	}, []string{"result"})
+
+	similarityCandidates = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "similarity_candidates",
+		Help:      "Vectors compared per similarity computation, by operation (related, search, ask).",
+		Buckets:   prometheus.ExponentialBuckets(1, 4, 8), // 1 .. 16384
+	}, []string{"op"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequests, httpDuration, httpInFlight,
+		embeddingDuration, embeddingCache,
+		similarityCandidates,
+	)
+}
+
+// Middleware records the count, latency and in-flight number of requests.
+// Requests are labeled with the matched route pattern (e.g. /api/blogs/:path)
+// rather than the raw path, which keeps the number of series bounded.
+func Middleware() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		httpInFlight.Inc()
+		defer httpInFlight.Dec()
+		start := time.Now()
+		err := c.Next()
+
+		status := c.Response().StatusCode()
+		// Errors returned by handlers are rendered by the error handler after
+		// this middleware returns; use the status they will get.
+		var fe *fiber.Error
+		if errors.As(err, &fe) {
+			status = fe.Code
+		} else if err != nil {
+			status = fiber.StatusInternalServerError
+		}
+		labels := []string{c.Method(), c.Route().Path, strconv.Itoa(status)}
+		httpRequests.WithLabelValues(labels...).Inc()
+		httpDuration.WithLabelValues(labels...).Observe(time.Since(start).Seconds())
+		return err
+	}
+}
+
+// RegisterDB exports the connection pool statistics of db (open, in use and
+// idle connections, waits) under the given name.
+func RegisterDB(db *sql.DB, name string) {
+	err := Registry.Register(collectors.NewDBStatsCollector(db, name))
+	var are prometheus.AlreadyRegisteredError
+	if err != nil && !errors.As(err, &are) {
+		panic(err)
+	}
+}
+
+// ObserveEmbedding records the generation time of one embedding.
+func ObserveEmbedding(model string, d time.Duration) {
+	embeddingDuration.WithLabelValues(model).Observe(d.Seconds())
+}
+
+// CountEmbeddingCache records an embedding cache hit or miss.
+func CountEmbeddingCache(hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	embeddingCache.WithLabelValues(result).Inc()
+}
+
+// ObserveSimilarity records how many vectors one similarity computation compared.
+func ObserveSimilarity(op string, n int) {
+	similarityCandidates.WithLabelValues(op).Observe(float64(n))
+}
+
+// Handler serves Registry in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
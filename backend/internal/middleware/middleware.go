@@ -1,33 +1,46 @@
 package middleware
 
 import (
-	"time"
+	"strings"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/fiber/v2/middleware/compress"
-	"github.com/gofiber/fiber/v2/middleware/logger"
 	"github.com/gofiber/fiber/v2/middleware/requestid"
 	"github.com/gofiber/fiber/v2/middleware/recover"
 	"github.com/gofiber/helmet/v2"
+	"github.com/valyala/fasthttp"
 
+	"landing/backend/internal/apperr"
 	"landing/backend/internal/config"
+	"landing/backend/internal/logging"
+	"landing/backend/internal/metrics"
+	"landing/backend/internal/tracing"
 )
 
 // Register attaches global middleware to the Fiber app.
 func Register(app *fiber.App, cfg config.Config) {
+	// Request bodies are capped at fiber.Config.BodyLimit (MaxBodyBytes) while
+	// they are read; only the media upload may send a file of MediaMaxBytes,
+	// with headroom for multipart framing.
+	app.Server().HeaderReceived = RouteBodyLimits(map[string]int{
+		fiber.MethodPost + " /api/media": cfg.MediaMaxBytes + 1<<20,
+	})
+
 	// Panic recovery
 	app.Use(recover.New())
 
+	// Prometheus request count, latency and in-flight gauge
+	app.Use(metrics.Middleware())
+
 	// Unique request ID for tracing
 	app.Use(requestid.New())
 
-	// Structured logging
-	app.Use(logger.New(logger.Config{
-		Format:     "${time} ${ip} ${status} ${method} ${path} ${latency} req_id=${locals:requestid}\n",
-		TimeZone:   "Local",
-		TimeFormat: time.RFC3339,
-	}))
+	// OpenTelemetry server span, continuing the caller's W3C trace context
+	app.Use(tracing.Middleware())
+
+	// Request-scoped structured logger and access log
+	app.Use(logging.Middleware())
 
 	// CORS (permissive defaults for dev; tighten in prod)
 	app.Use(cors.New(cors.Config{
@@ -41,8 +54,30 @@ func Register(app *fiber.App, cfg config.Config) {
 	// Security headers
 	app.Use(helmet.New())
 
-	// Response compression
-	app.Use(compress.New())
+	// Response compression (except event streams, which must reach the client as written)
+	app.Use(compress.New(compress.Config{
+		Next: func(c *fiber.Ctx) bool {
+			return strings.Contains(c.Get(fiber.HeaderAccept), "text/event-stream")
+		},
+	}))
+}
+
+// RouteBodyLimits returns a fasthttp.Server.HeaderReceived callback that
+// replaces the server's body limit (fiber.Config.BodyLimit) for the routes in
+// limits, keyed by method and path such as "POST /api/media". Limits apply
+// while the body is read, before any handler runs; larger bodies are answered
+// with 413 payload_too_large.
+func RouteBodyLimits(limits map[string]int) func(*fasthttp.RequestHeader) fasthttp.RequestConfig {
+	return func(h *fasthttp.RequestHeader) fasthttp.RequestConfig {
+		path := string(h.RequestURI())
+		if i := strings.IndexByte(path, '?'); i >= 0 {
+			path = path[:i]
+		}
+		if len(path) > 1 {
+			path = strings.TrimSuffix(path, "/")
+		}
+		return fasthttp.RequestConfig{MaxRequestBodySize: limits[string(h.Method())+" "+path]}
+	}
 }
 
 // APIKey returns a middleware that enforces an API key when configured.
@@ -69,10 +104,9 @@ func APIKey(cfg config.Config) fiber.Handler {
             key = c.Query("api_key")
         }
         if key == cfg.APIKey {
+            c.Locals(logging.UserKey, "api-key")
             return c.Next()
         }
-        return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-            "error": "unauthorized",
-        })
+        return apperr.Unauthorized("missing or invalid API key")
     }
 }
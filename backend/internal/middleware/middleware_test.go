@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+
+	"landing/backend/internal/apperr"
+)
+
+func TestRouteBodyLimits(t *testing.T) {
+	app := fiber.New(fiber.Config{BodyLimit: 1 << 10, ErrorHandler: apperr.Handler, DisableStartupMessage: true})
+	app.Server().HeaderReceived = RouteBodyLimits(map[string]int{"POST /upload": 4 << 10})
+	ok := func(c *fiber.Ctx) error { return c.SendStatus(fiber.StatusNoContent) }
+	app.Post("/upload", ok)
+	app.Post("/other", ok)
+	// A real listener: app.Test returns the read error instead of the response
+	// the server's error handler writes for it.
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go app.Listener(ln)
+	t.Cleanup(func() { _ = app.Shutdown() })
+	base := "http://" + ln.Addr().String()
+
+	json := func(n int) (string, string) {
+		return fiber.MIMEApplicationJSON, `"` + strings.Repeat("a", n) + `"`
+	}
+	form := func(n int) (string, string) {
+		var buf bytes.Buffer
+		w := multipart.NewWriter(&buf)
+		fw, _ := w.CreateFormFile("file", "a.png")
+		fw.Write(bytes.Repeat([]byte{'a'}, n))
+		w.Close()
+		return w.FormDataContentType(), buf.String()
+	}
+	for _, tc := range []struct {
+		name   string
+		target string
+		body   func(int) (string, string)
+		size   int
+		want   int
+	}{
+		{"small json", "/other", json, 512, fiber.StatusNoContent},
+		{"large json", "/other", json, 2 << 10, fiber.StatusRequestEntityTooLarge},
+		// Multipart bodies are not exempt outside the upload route.
+		{"large form elsewhere", "/other", form, 2 << 10, fiber.StatusRequestEntityTooLarge},
+		{"upload", "/upload", form, 2 << 10, fiber.StatusNoContent},
+		{"upload with query", "/upload?x=1", form, 2 << 10, fiber.StatusNoContent},
+		{"upload over its limit", "/upload", form, 5 << 10, fiber.StatusRequestEntityTooLarge},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ct, body := tc.body(tc.size)
+			resp, err := http.Post(base+tc.target, ct, strings.NewReader(body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tc.want {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tc.want)
+			}
+			if tc.want == fiber.StatusRequestEntityTooLarge && !strings.HasPrefix(resp.Header.Get(fiber.HeaderContentType), apperr.ContentType) {
+				t.Errorf("Content-Type = %q, want a problem document", resp.Header.Get(fiber.HeaderContentType))
+			}
+		})
+	}
+}
@@ -0,0 +1,241 @@
+// Package placeholders fills {NAME} tokens in author HTML.
+//
+// Values are escaped for the context a token appears in: text nodes and
+// attribute values are HTML-escaped, while inside <script> and <style> only
+// "<" is escaped, in the element's own syntax, so a value cannot close it.
+// A URL attribute that starts with a token keeps its value only for http,
+// https, mailto and tel URLs or relative ones, so a value cannot turn a link
+// into a javascript: URL.
+package placeholders
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+// Var describes a placeholder available to authors.
+type Var struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Builtin     bool   `json:"builtin"`
+}
+
+// Builtins are the placeholders filled by the publishing pipeline.
+var Builtins = []Var{
+	{Name: "SITE_NAME", Description: "Site name"},
+	{Name: "SITE_LOGO", Description: "Site logo URL"},
+	{Name: "CANONICAL_URL", Description: "Canonical URL of the post"},
+	{Name: "KEYWORDS", Description: "Tag names separated by commas, for meta keywords"},
+	{Name: "TAGS", Description: "Tag names separated by Persian commas"},
+	{Name: "CATEGORY", Description: "Display name of the primary category"},
+	{Name: "AUTHOR", Description: "Author display name (site name when the post has no author)"},
+	{Name: "AUTHOR_BIO", Description: "Author biography"},
+	{Name: "FEATURED_IMAGE", Description: "Featured image URL"},
+	{Name: "PUBLISH_DATE", Description: "Publication time in RFC 3339"},
+	{Name: "MODIFIED_DATE", Description: "Last modification time in RFC 3339"},
+	{Name: "PUBLISH_DATE_FORMATTED", Description: "Publication date as YYYY-MM-DD"},
+	{Name: "MODIFIED_DATE_FORMATTED", Description: "Last modification date as YYYY-MM-DD"},
+	{Name: "READING_TIME", Description: "Estimated reading time in minutes"},
+	{Name: "SCHEMA_JSON", Description: "Renders nothing; the JSON-LD structured data is served as schema_json"},
+	{Name: "TOC", Description: "Marks where the table of contents (h2–h4) is inserted"},
+}
+
+func init() {
+	for i := range Builtins {
+		Builtins[i].Builtin = true
+	}
+}
+
+// NameRe matches a valid placeholder name.
+var NameRe = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+var tokenRe = regexp.MustCompile(`\{([A-Z][A-Z0-9_]*)\}`)
+
+// IsBuiltin reports whether name is a built-in placeholder.
+func IsBuiltin(name string) bool {
+	for _, v := range Builtins {
+		if v.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Token returns the {NAME} form of a placeholder name.
+func Token(name string) string { return "{" + name + "}" }
+
+// Find returns the distinct placeholder names used in s, sorted.
+func Find(s string) []string {
+	seen := map[string]bool{}
+	var out []string
+	for _, m := range tokenRe.FindAllStringSubmatch(s, -1) {
+		if !seen[m[1]] {
+			seen[m[1]] = true
+			out = append(out, m[1])
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// UnknownError lists placeholders that have no value in strict mode.
+type UnknownError struct {
+	Names []string
+}
+
+func (e *UnknownError) Error() string {
+	return fmt.Sprintf("unknown placeholders: %s", strings.Join(e.Names, ", "))
+}
+
+// Render replaces {NAME} tokens in doc with values keyed by name. Tokens
+// without a value are left untouched, or rejected with an *UnknownError when
+// strict is set. Markup outside the replaced tokens is preserved byte for byte.
+func Render(doc string, values map[string]string, strict bool) (string, error) {
+	if strict {
+		var unknown []string
+		for _, name := range Find(doc) {
+			if _, ok := values[name]; !ok {
+				unknown = append(unknown, name)
+			}
+		}
+		if len(unknown) > 0 {
+			return "", &UnknownError{Names: unknown}
+		}
+	}
+	if !strings.Contains(doc, "{") {
+		return doc, nil
+	}
+
+	var b strings.Builder
+	b.Grow(len(doc))
+	z := html.NewTokenizer(strings.NewReader(doc))
+	rawText := ""
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			break
+		}
+		raw := string(z.Raw())
+		switch tt {
+		case html.TextToken:
+			if rawText != "" {
+				b.WriteString(replace(raw, values, rawTextEscaper(rawText)))
+			} else {
+				b.WriteString(replace(raw, values, html.EscapeString))
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			tok := z.Token()
+			if tt == html.StartTagToken && isRawTextElement(tok.Data) {
+				rawText = tok.Data
+			}
+			if !tokenRe.MatchString(raw) {
+				b.WriteString(raw)
+				continue
+			}
+			writeTag(&b, tok, tt == html.SelfClosingTagToken, values)
+		case html.EndTagToken:
+			rawText = ""
+			b.WriteString(raw)
+		default:
+			b.WriteString(raw)
+		}
+	}
+	return b.String(), nil
+}
+
+// writeTag re-serializes a start tag with placeholders in attribute values filled.
+// Values are unescaped by the tokenizer and escaped again on output.
+func writeTag(b *strings.Builder, tok html.Token, selfClosing bool, values map[string]string) {
+	b.WriteByte('<')
+	b.WriteString(tok.Data)
+	for _, a := range tok.Attr {
+		b.WriteByte(' ')
+		if a.Namespace != "" {
+			b.WriteString(a.Namespace)
+			b.WriteByte(':')
+		}
+		b.WriteString(a.Key)
+		b.WriteString(`="`)
+		v := replace(a.Val, values, nil)
+		if urlAttrs[a.Key] && v != a.Val {
+			if loc := tokenRe.FindStringIndex(a.Val); loc[0] == 0 {
+				v = safeURL(v)
+			}
+		}
+		b.WriteString(html.EscapeString(v))
+		b.WriteByte('"')
+	}
+	if selfClosing {
+		b.WriteString("/>")
+	} else {
+		b.WriteByte('>')
+	}
+}
+
+// urlAttrs are the attributes browsers navigate to or load.
+var urlAttrs = map[string]bool{
+	"href": true, "src": true, "action": true, "formaction": true,
+	"poster": true, "cite": true, "background": true,
+}
+
+// safeURL returns u, or "#" when u has a scheme other than http, https,
+// mailto or tel. Browsers ignore ASCII whitespace and control characters in
+// schemes, so those are dropped before the check.
+func safeURL(u string) string {
+	s := strings.ToLower(strings.Map(func(r rune) rune {
+		if r <= ' ' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, u))
+	i := strings.IndexAny(s, ":/?#")
+	if i < 0 || s[i] != ':' {
+		return u
+	}
+	switch s[:i] {
+	case "http", "https", "mailto", "tel":
+		return u
+	}
+	return "#"
+}
+
+// replace substitutes known tokens in s, passing values through escape when non-nil.
+func replace(s string, values map[string]string, escape func(string) string) string {
+	return tokenRe.ReplaceAllStringFunc(s, func(tok string) string {
+		v, ok := values[tok[1:len(tok)-1]]
+		if !ok {
+			return tok
+		}
+		if escape != nil {
+			return escape(v)
+		}
+		return v
+	})
+}
+
+func isRawTextElement(name string) bool {
+	switch name {
+	case "script", "style", "textarea", "title":
+		return true
+	}
+	return false
+}
+
+// rawTextEscaper returns the escaping used for values inside the raw-text
+// element name. Script and style contents are not entity-decoded, so "<" is
+// escaped in the element's own syntax to keep a value from closing it.
+func rawTextEscaper(name string) func(string) string {
+	switch name {
+	case "script":
+		return strings.NewReplacer("<", "\\u003c").Replace
+	case "style":
+		return strings.NewReplacer("<", "\\3c ").Replace
+	default:
+		// textarea and title decode character references.
+		return html.EscapeString
+	}
+}
@@ -0,0 +1,88 @@
+package placeholders
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	values := map[string]string{
+		"X":    `"><script>alert(1)</script>`,
+		"JS":   "javascript:alert(1)",
+		"TABS": "java\tscript:alert(1)",
+		"URL":  "https://example.com/a?b=1&c=2",
+		"REL":  "/blog/post",
+		"CODE": "</script><script>alert(1)//",
+		"CSS":  "</style><script>alert(1)</script>",
+		"NAME": "Ali & Sara",
+	}
+	for _, tc := range []struct {
+		name, doc, want string
+	}{
+		{"text", `<p>{X}</p>`,
+			`<p>&#34;&gt;&lt;script&gt;alert(1)&lt;/script&gt;</p>`},
+		{"attribute", `<img alt="{X}">`,
+			`<img alt="&#34;&gt;&lt;script&gt;alert(1)&lt;/script&gt;">`},
+		{"unquoted attribute", `<img alt={NAME}>`,
+			`<img alt="Ali &amp; Sara">`},
+		{"javascript url", `<a href="{JS}">x</a>`,
+			`<a href="#">x</a>`},
+		{"javascript url with whitespace", `<a href="{TABS}">x</a>`,
+			`<a href="#">x</a>`},
+		{"https url", `<a href="{URL}">x</a>`,
+			`<a href="https://example.com/a?b=1&amp;c=2">x</a>`},
+		{"relative url", `<img src="{REL}.jpg">`,
+			`<img src="/blog/post.jpg">`},
+		// Only a leading token decides the scheme; elsewhere a colon is data.
+		{"token inside url", `<a href="/search?q={JS}">x</a>`,
+			`<a href="/search?q=javascript:alert(1)">x</a>`},
+		{"script", `<script>var s = "{CODE}";</script>`,
+			`<script>var s = "\u003c/script>\u003cscript>alert(1)//";</script>`},
+		{"style", `<style>a::after { content: "{CSS}" }</style>`,
+			`<style>a::after { content: "\3c /style>\3c script>alert(1)\3c /script>" }</style>`},
+		{"title", `<title>{X}</title>`,
+			`<title>&#34;&gt;&lt;script&gt;alert(1)&lt;/script&gt;</title>`},
+		{"after raw text", `<script>1</script><p>{NAME}</p>`,
+			`<script>1</script><p>Ali &amp; Sara</p>`},
+		{"markup kept byte for byte", `<P CLASS=x>{NAME}<br></P>`,
+			`<P CLASS=x>Ali &amp; Sara<br></P>`},
+		{"unknown token left alone", `<p>{NOPE} {NAME}</p>`,
+			`<p>{NOPE} Ali &amp; Sara</p>`},
+	} {
+		got, err := Render(tc.doc, values, false)
+		if err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("%s:\n got %s\nwant %s", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestRenderStrict(t *testing.T) {
+	values := map[string]string{"SITE_NAME": "Landing"}
+	doc := `<p title="{ZED}">{SITE_NAME} {NOPE} {ZED}</p>`
+
+	_, err := Render(doc, values, true)
+	var unknown *UnknownError
+	if !errors.As(err, &unknown) {
+		t.Fatalf("strict: err = %v, want *UnknownError", err)
+	}
+	if want := []string{"NOPE", "ZED"}; !reflect.DeepEqual(unknown.Names, want) {
+		t.Errorf("strict: Names = %v, want %v", unknown.Names, want)
+	}
+
+	got, err := Render(doc, values, false)
+	if err != nil {
+		t.Fatalf("lenient: %v", err)
+	}
+	if want := `<p title="{ZED}">Landing {NOPE} {ZED}</p>`; got != want {
+		t.Errorf("lenient: got %s, want %s", got, want)
+	}
+
+	if _, err := Render(`<p>{SITE_NAME}</p>`, values, true); err != nil {
+		t.Errorf("strict with known tokens only: %v", err)
+	}
+}
@@ -1,19 +1,30 @@
 package routes
 
 import (
+	"strings"
+
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/adaptor"
 	"github.com/gofiber/swagger"
 
 	"landing/backend/internal/config"
 	"landing/backend/internal/handlers"
+	"landing/backend/internal/metrics"
 	"landing/backend/internal/middleware"
+	"landing/backend/internal/storage"
 )
 
 // Register wires all HTTP routes.
-func Register(app *fiber.App, cfg config.Config) {
+func Register(app *fiber.App, cfg config.Config, store storage.Storage) {
 	// Swagger UI at /swagger/index.html
 	app.Get("/swagger/*", swagger.HandlerDefault)
 
+	// Prometheus metrics on the main port, behind the API key, unless they
+	// are served on a separate listener (see cmd/api).
+	if cfg.MetricsListenAddr() == "" {
+		app.Get("/metrics", middleware.APIKey(cfg), adaptor.HTTPHandler(metrics.Handler()))
+	}
+
 	api := app.Group("/api")
 
 	// health
@@ -29,6 +40,70 @@ func Register(app *fiber.App, cfg config.Config) {
 	api.Post("/blogs", handlers.CreateBlogHandler)
 	api.Get("/blogs", handlers.ListBlogsHandler)
 	api.Get("/blogs/:path", handlers.GetBlogByPathHandler)
+	api.Put("/blogs/:path", handlers.UpdateBlogHandler)
+	api.Post("/blogs/audit", handlers.AuditBlogHandler)
+	api.Post("/blogs/analyze", handlers.AnalyzeBlogHandler)
+	api.Get("/blogs/:path/audit", handlers.GetBlogAuditHandler)
+
+	// semantic search and question answering over the blog posts
+	api.Get("/search", handlers.SearchHandler)
+	api.Post("/ask", handlers.AskHandler)
+	api.Get("/embeddings/stats", handlers.EmbeddingStatsHandler(cfg))
+	api.Post("/embeddings/refresh", handlers.RefreshEmbeddingsHandler(cfg))
+
+	// categories
+	api.Get("/categories", handlers.ListCategoriesHandler)
+	api.Post("/categories", handlers.CreateCategoryHandler)
+	api.Get("/categories/:slug", handlers.GetCategoryHandler)
+	api.Put("/categories/:slug", handlers.UpdateCategoryHandler)
+	api.Delete("/categories/:slug", handlers.DeleteCategoryHandler)
+
+	// tags
+	api.Get("/tags", handlers.ListTagsHandler)
+	api.Get("/tags/:slug/blogs", handlers.ListBlogsByTagHandler)
+
+	// authors
+	api.Post("/authors", handlers.CreateAuthorHandler)
+	api.Get("/authors/:id", handlers.GetAuthorHandler)
+
+	// placeholders
+	api.Get("/placeholders", handlers.ListPlaceholdersHandler)
+	api.Post("/placeholders", handlers.CreatePlaceholderHandler)
+	api.Put("/placeholders/:name", handlers.UpdatePlaceholderHandler)
+	api.Delete("/placeholders/:name", handlers.DeletePlaceholderHandler)
+
+	// call-to-action blocks
+	api.Get("/cta-blocks", handlers.ListCTABlocksHandler)
+	api.Post("/cta-blocks", handlers.CreateCTABlockHandler)
+	api.Put("/cta-blocks/:id", handlers.UpdateCTABlockHandler)
+	api.Delete("/cta-blocks/:id", handlers.DeleteCTABlockHandler)
+
+	// media
+	api.Post("/media", handlers.UploadMediaHandler(store))
+	api.Get("/media", handlers.ListMediaHandler)
+	api.Get("/media/:id", handlers.GetMediaHandler)
+	api.Put("/media/:id", handlers.UpdateMediaHandler)
+	api.Delete("/media/:id", handlers.DeleteMediaHandler(store))
+
+	// Serve locally stored uploads when the public URL is a path on this server.
+	if cfg.MediaStorage == "local" && strings.HasPrefix(cfg.MediaPublicURL, "/") {
+		app.Static(cfg.MediaPublicURL, cfg.MediaDir, fiber.Static{
+			MaxAge: 31536000,
+			// Helmet defaults to same-origin; images are embedded by the frontend origin.
+			ModifyResponse: func(c *fiber.Ctx) error {
+				c.Set("Cross-Origin-Resource-Policy", "cross-origin")
+				return nil
+			},
+		})
+	}
+
+	// public sitemaps and feeds
+	app.Get("/sitemap.xml", handlers.SitemapHandler)
+	app.Get("/sitemap-index.xml", handlers.SitemapIndexHandler)
+	app.Get("/sitemap-:page.xml", handlers.SitemapPageHandler)
+	app.Get("/feed.xml", handlers.RSSFeedHandler)
+	app.Get("/atom.xml", handlers.AtomFeedHandler)
+	app.Get("/feed.json", handlers.JSONFeedHandler)
 
 	// convenience root routes
 	app.Get("/healthz", handlers.HealthHandler)
@@ -9,6 +9,11 @@ import (
 	"golang.org/x/net/html"
 )
 
+var (
+	srcSetRe = regexp.MustCompile(`^(?:(?:https?://|/)[^\s,"'<>]+(?:\s+\d+(?:\.\d+)?[wx])?)(?:\s*,\s*(?:https?://|/)[^\s,"'<>]+(?:\s+\d+(?:\.\d+)?[wx])?)*$`)
+	sizesRe  = regexp.MustCompile(`^[a-zA-Z0-9\s(),:.%-]+$`)
+)
+
 // SanitizeBlogHTML extracts <body> inner HTML if present, then sanitizes using a
 // safe allowlist suitable for blog content. It allows common structural and
 // formatting tags while stripping scripts/unsafe content.
@@ -48,10 +53,21 @@ func SanitizeBlogHTML(in string) string {
 	}
 
 	p := bluemonday.UGCPolicy()
-	p.AllowElements("article", "section", "figure", "figcaption", "footer", "time")
-	p.AllowAttrs("class", "id").OnElements("div", "span", "p", "article", "section", "figure", "figcaption", "h1", "h2", "h3", "h4", "ul", "ol", "li")
+	p.AllowElements("article", "section", "figure", "figcaption", "footer", "time", "nav")
+	p.AllowAttrs("class", "id").OnElements("div", "span", "p", "article", "section", "figure", "figcaption", "h1", "h2", "h3", "h4", "ul", "ol", "li", "nav")
 	p.AllowAttrs("itemprop", "itemscope", "itemtype").OnElements("article", "div", "span", "time")
 	p.AllowAttrs("src", "alt", "title", "width", "height", "loading", "decoding").OnElements("img")
+	// Responsive images: srcset candidates must be http(s) or site-relative URLs.
+	p.AllowElements("picture")
+	p.AllowAttrs("srcset").Matching(srcSetRe).OnElements("img", "source")
+	p.AllowAttrs("sizes").Matching(sizesRe).OnElements("img", "source")
+	p.AllowAttrs("type").Matching(regexp.MustCompile(`^image/[a-z0-9.+-]+$`)).OnElements("source")
+	// Rendered Markdown: per-block text direction, code fence languages, table
+	// alignment and footnote links.
+	p.AllowAttrs("dir").Matching(regexp.MustCompile(`(?i)^(rtl|ltr|auto)$`)).Globally()
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^language-[\w+#.-]+$`)).OnElements("code")
+	p.AllowAttrs("align").Matching(regexp.MustCompile(`^(left|center|right)$`)).OnElements("th", "td")
+	p.AllowAttrs("class").Matching(regexp.MustCompile(`^(footnote-ref|footnote-backref|footnotes)$`)).OnElements("a", "div")
 	p.AddTargetBlankToFullyQualifiedLinks(true)
 	// Allow JSON-LD scripts specifically (but no other scripts)
 	p.AllowElements("script")
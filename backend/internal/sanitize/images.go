@@ -0,0 +1,128 @@
+package sanitize
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Image describes a managed media object referenced from blog HTML.
+type Image struct {
+	URL    string
+	Alt    string
+	Width  int
+	Height int
+	// SrcSet lists same-format resized candidates ("url 640w, ..."), WebPSrcSet the
+	// WebP ones; Sizes is the matching `sizes` attribute. All are optional.
+	SrcSet     string
+	WebPSrcSet string
+	Sizes      string
+}
+
+// ImageResolver maps an <img src> value to a managed image. It returns false for
+// sources that are not ours (e.g. external URLs), which are left untouched.
+type ImageResolver func(src string) (Image, bool)
+
+// RewriteImages points every <img> whose src is recognized by resolve at the
+// canonical media URL, fills in missing alt text from the media library, and adds
+// intrinsic width/height when the author did not set them. When responsive variants
+// exist it adds srcset/sizes, and wraps the image in a <picture> with a WebP <source>.
+// It should run before SanitizeBlogHTML so that internal references (e.g. "media:42")
+// are turned into real URLs the sanitizer accepts.
+func RewriteImages(in string, resolve ImageResolver) string {
+	if resolve == nil || !strings.Contains(in, "<img") {
+		return in
+	}
+	doc, err := html.Parse(strings.NewReader(in))
+	if err != nil {
+		return in
+	}
+	// Collect first: wrapping images in <picture> while walking would break sibling iteration.
+	var imgs []*html.Node
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode && n.Data == "img" {
+			imgs = append(imgs, n)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(doc)
+
+	changed := false
+	for _, n := range imgs {
+		img, ok := resolve(strings.TrimSpace(attr(n, "src")))
+		if !ok {
+			continue
+		}
+		changed = true
+		setAttr(n, "src", img.URL)
+		if strings.TrimSpace(attr(n, "alt")) == "" && img.Alt != "" {
+			setAttr(n, "alt", img.Alt)
+		}
+		if attr(n, "width") == "" && attr(n, "height") == "" && img.Width > 0 && img.Height > 0 {
+			setAttr(n, "width", strconv.Itoa(img.Width))
+			setAttr(n, "height", strconv.Itoa(img.Height))
+		}
+		if attr(n, "srcset") != "" {
+			// Author-provided srcset wins.
+			continue
+		}
+		if img.SrcSet != "" {
+			setAttr(n, "srcset", img.SrcSet)
+			if img.Sizes != "" && attr(n, "sizes") == "" {
+				setAttr(n, "sizes", img.Sizes)
+			}
+		}
+		if img.WebPSrcSet != "" && n.Parent != nil && n.Parent.Data != "picture" {
+			wrapInPicture(n, img)
+		}
+	}
+	if !changed {
+		return in
+	}
+	var buf bytes.Buffer
+	if err := html.Render(&buf, doc); err != nil {
+		return in
+	}
+	return buf.String()
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+func setAttr(n *html.Node, key, val string) {
+	for i := range n.Attr {
+		if n.Attr[i].Key == key {
+			n.Attr[i].Val = val
+			return
+		}
+	}
+	n.Attr = append(n.Attr, html.Attribute{Key: key, Val: val})
+}
+
+// wrapInPicture replaces n with <picture><source type="image/webp" ...>n</picture>.
+func wrapInPicture(n *html.Node, img Image) {
+	source := &html.Node{Type: html.ElementNode, Data: "source", DataAtom: atom.Source}
+	setAttr(source, "type", "image/webp")
+	setAttr(source, "srcset", img.WebPSrcSet)
+	if img.Sizes != "" {
+		setAttr(source, "sizes", img.Sizes)
+	}
+	picture := &html.Node{Type: html.ElementNode, Data: "picture", DataAtom: atom.Picture}
+	parent := n.Parent
+	parent.InsertBefore(picture, n)
+	parent.RemoveChild(n)
+	picture.AppendChild(source)
+	picture.AppendChild(n)
+}
@@ -0,0 +1,379 @@
+package seo
+
+import (
+	"math"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"landing/backend/internal/cta"
+	"landing/backend/internal/textnorm"
+
+	"golang.org/x/net/html"
+)
+
+// Check statuses.
+const (
+	StatusPass = "pass"
+	StatusWarn = "warn"
+	StatusFail = "fail"
+)
+
+// Meta description length bounds (characters) used by the audit.
+const (
+	MetaDescriptionMin = 70
+	MetaDescriptionMax = 160
+)
+
+// Keyword density bounds (percent of words) considered natural.
+const (
+	KeywordDensityMin = 0.5
+	KeywordDensityMax = 2.5
+)
+
+// Options configures an audit.
+type Options struct {
+	// SiteBaseURL identifies absolute links to this site as internal.
+	SiteBaseURL string
+	// Keyword is the focus keyword for the density check; empty skips the check.
+	Keyword string
+	// MetaDescription is used when the document has no <meta name="description">.
+	MetaDescription string
+	// BlogExists reports whether a blog path is known; nil skips the broken link check.
+	BlogExists func(path string) bool
+	// KnownPlaceholders are tokens the publishing pipeline fills in; they are not
+	// reported when auditing a draft.
+	KnownPlaceholders []string
+}
+
+// Check is the outcome of one audit rule.
+type Check struct {
+	ID      string `json:"id"`
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	Weight  int    `json:"weight"`
+}
+
+// Stats holds the raw measurements behind the checks.
+type Stats struct {
+	Words                 int      `json:"words"`
+	H1Count               int      `json:"h1_count"`
+	Images                int      `json:"images"`
+	ImagesMissingAlt      int      `json:"images_missing_alt"`
+	InternalLinks         int      `json:"internal_links"`
+	ExternalLinks         int      `json:"external_links"`
+	BrokenLinks           []string `json:"broken_links"`
+	MetaDescription       string   `json:"meta_description"`
+	MetaDescriptionLength int      `json:"meta_description_length"`
+	KeywordOccurrences    int      `json:"keyword_occurrences"`
+	KeywordDensity        float64  `json:"keyword_density"`
+	Placeholders          []string `json:"placeholders"`
+	HasCTA                bool     `json:"has_cta"`
+}
+
+// Report is a scored SEO audit. Score is 0–100.
+type Report struct {
+	Score  int     `json:"score"`
+	Checks []Check `json:"checks"`
+	Stats  Stats   `json:"stats"`
+}
+
+var placeholderRe = regexp.MustCompile(`\{[A-Z][A-Z0-9_]*\}`)
+
+// Audit parses an HTML document (full page or fragment) and runs all checks.
+func Audit(doc string, opts Options) Report {
+	root, err := html.Parse(strings.NewReader(doc))
+	if err != nil {
+		return Report{Checks: []Check{{ID: "parse", Status: StatusFail, Message: "HTML could not be parsed", Weight: 1}}}
+	}
+
+	var st Stats
+	var headings []int
+	var text strings.Builder
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		switch n.Type {
+		case html.TextNode:
+			text.WriteString(n.Data)
+			text.WriteByte(' ')
+		case html.ElementNode:
+			switch n.Data {
+			case "script", "style", "title":
+				return
+			case "h1", "h2", "h3", "h4", "h5", "h6":
+				level := int(n.Data[1] - '0')
+				headings = append(headings, level)
+				if level == 1 {
+					st.H1Count++
+				}
+			case "img":
+				st.Images++
+				if strings.TrimSpace(attr(n, "alt")) == "" {
+					st.ImagesMissingAlt++
+				}
+			case "a":
+				auditLink(attr(n, "href"), opts, &st)
+			case "meta":
+				if strings.EqualFold(attr(n, "name"), "description") {
+					st.MetaDescription = strings.TrimSpace(attr(n, "content"))
+				}
+			}
+			if cta.IsCTA(n) {
+				st.HasCTA = true
+			}
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	walk(root)
+
+	body := text.String()
+	words := tokenize(body)
+	st.Words = len(words)
+	if st.MetaDescription == "" {
+		st.MetaDescription = strings.TrimSpace(opts.MetaDescription)
+	}
+	st.MetaDescriptionLength = utf8.RuneCountInString(st.MetaDescription)
+	st.Placeholders = unknownPlaceholders(placeholderRe.FindAllString(doc, -1), opts.KnownPlaceholders)
+	if kw := tokenize(opts.Keyword); len(kw) > 0 && st.Words > 0 {
+		st.KeywordOccurrences = countPhrase(words, kw)
+		st.KeywordDensity = math.Round(float64(st.KeywordOccurrences*len(kw))/float64(st.Words)*10000) / 100
+	}
+	if st.BrokenLinks == nil {
+		st.BrokenLinks = []string{}
+	}
+
+	checks := []Check{
+		checkH1(st),
+		checkHeadingOrder(headings),
+		checkImageAlt(st),
+		checkLinks(st),
+		checkBrokenLinks(st, opts.BlogExists != nil),
+		checkMetaDescription(st),
+		checkKeyword(st, opts.Keyword),
+		checkPlaceholders(st),
+		checkCTA(st),
+	}
+	return Report{Score: score(checks), Checks: checks, Stats: st}
+}
+
+// auditLink classifies a link as internal or external and records broken blog links.
+func auditLink(href string, opts Options, st *Stats) {
+	href = strings.TrimSpace(href)
+	if href == "" || strings.HasPrefix(href, "#") || strings.HasPrefix(href, "mailto:") || strings.HasPrefix(href, "tel:") {
+		return
+	}
+	u, err := url.Parse(href)
+	if err != nil {
+		return
+	}
+	internal := u.Host == ""
+	if !internal && opts.SiteBaseURL != "" {
+		if base, err := url.Parse(opts.SiteBaseURL); err == nil && strings.EqualFold(base.Host, u.Host) {
+			internal = true
+		}
+	}
+	if !internal {
+		st.ExternalLinks++
+		return
+	}
+	st.InternalLinks++
+	if opts.BlogExists == nil {
+		return
+	}
+	if p, ok := strings.CutPrefix(u.Path, "/blog/"); ok && p != "" {
+		p = strings.TrimSuffix(p, "/")
+		if !opts.BlogExists(p) {
+			st.BrokenLinks = append(st.BrokenLinks, href)
+		}
+	}
+}
+
+func checkH1(st Stats) Check {
+	c := Check{ID: "single_h1", Weight: 15}
+	switch st.H1Count {
+	case 1:
+		c.Status, c.Message = StatusPass, "exactly one h1"
+	case 0:
+		c.Status, c.Message = StatusFail, "no h1 heading"
+	default:
+		c.Status, c.Message = StatusFail, itoa(st.H1Count)+" h1 headings; use exactly one"
+	}
+	return c
+}
+
+func checkHeadingOrder(levels []int) Check {
+	c := Check{ID: "heading_hierarchy", Weight: 10, Status: StatusPass, Message: "heading levels are not skipped"}
+	prev := 0
+	for _, l := range levels {
+		if prev > 0 && l > prev+1 {
+			c.Status = StatusWarn
+			c.Message = "heading level jumps from h" + itoa(prev) + " to h" + itoa(l)
+			return c
+		}
+		prev = l
+	}
+	return c
+}
+
+func checkImageAlt(st Stats) Check {
+	c := Check{ID: "image_alt", Weight: 10}
+	switch {
+	case st.ImagesMissingAlt == 0:
+		c.Status, c.Message = StatusPass, "all images have alt text"
+	case st.ImagesMissingAlt == st.Images:
+		c.Status, c.Message = StatusFail, "no image has alt text"
+	default:
+		c.Status, c.Message = StatusWarn, itoa(st.ImagesMissingAlt)+" of "+itoa(st.Images)+" images lack alt text"
+	}
+	return c
+}
+
+func checkLinks(st Stats) Check {
+	c := Check{ID: "internal_links", Weight: 10}
+	if st.InternalLinks > 0 {
+		c.Status, c.Message = StatusPass, itoa(st.InternalLinks)+" internal, "+itoa(st.ExternalLinks)+" external links"
+	} else {
+		c.Status, c.Message = StatusWarn, "no internal links; link to related posts or pages"
+	}
+	return c
+}
+
+func checkBrokenLinks(st Stats, enabled bool) Check {
+	c := Check{ID: "broken_internal_links", Weight: 15, Status: StatusPass, Message: "no links to unknown blog posts"}
+	if !enabled {
+		c.Message = "not checked"
+		return c
+	}
+	if n := len(st.BrokenLinks); n > 0 {
+		c.Status, c.Message = StatusFail, itoa(n)+" links point to unknown blog posts"
+	}
+	return c
+}
+
+func checkMetaDescription(st Stats) Check {
+	c := Check{ID: "meta_description", Weight: 10}
+	n := st.MetaDescriptionLength
+	switch {
+	case n == 0:
+		c.Status, c.Message = StatusFail, "no meta description"
+	case n < MetaDescriptionMin:
+		c.Status, c.Message = StatusWarn, "meta description is short ("+itoa(n)+" chars, aim for "+itoa(MetaDescriptionMin)+"–"+itoa(MetaDescriptionMax)+")"
+	case n > MetaDescriptionMax:
+		c.Status, c.Message = StatusWarn, "meta description is long ("+itoa(n)+" chars, it will be truncated after "+itoa(MetaDescriptionMax)+")"
+	default:
+		c.Status, c.Message = StatusPass, "meta description length is "+itoa(n)+" chars"
+	}
+	return c
+}
+
+func checkKeyword(st Stats, keyword string) Check {
+	c := Check{ID: "keyword_density", Weight: 10}
+	if strings.TrimSpace(keyword) == "" {
+		c.Status, c.Message = StatusWarn, "no focus keyword given"
+		return c
+	}
+	d := st.KeywordDensity
+	switch {
+	case st.KeywordOccurrences == 0:
+		c.Status, c.Message = StatusFail, "focus keyword does not appear in the text"
+	case d < KeywordDensityMin:
+		c.Status, c.Message = StatusWarn, "keyword density is low ("+ftoa(d)+"%)"
+	case d > KeywordDensityMax:
+		c.Status, c.Message = StatusWarn, "keyword density is high ("+ftoa(d)+"%), avoid stuffing"
+	default:
+		c.Status, c.Message = StatusPass, "keyword density is "+ftoa(d)+"%"
+	}
+	return c
+}
+
+func checkPlaceholders(st Stats) Check {
+	c := Check{ID: "unreplaced_placeholders", Weight: 10, Status: StatusPass, Message: "no unreplaced placeholders"}
+	if len(st.Placeholders) > 0 {
+		c.Status, c.Message = StatusFail, "unreplaced placeholders: "+strings.Join(st.Placeholders, ", ")
+	}
+	return c
+}
+
+func checkCTA(st Stats) Check {
+	c := Check{ID: "cta", Weight: 10, Status: StatusPass, Message: "call to action present"}
+	if !st.HasCTA {
+		c.Status, c.Message = StatusWarn, "no call to action (a CTA block will be appended on publish)"
+	}
+	return c
+}
+
+func score(checks []Check) int {
+	var got, total float64
+	for _, c := range checks {
+		total += float64(c.Weight)
+		switch c.Status {
+		case StatusPass:
+			got += float64(c.Weight)
+		case StatusWarn:
+			got += float64(c.Weight) / 2
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return int(math.Round(got / total * 100))
+}
+
+// tokenize normalizes s and splits it into words, so a keyword written with
+// Arabic letter forms or without half-spaces still matches the text.
+func tokenize(s string) []string {
+	return textnorm.Tokenize(s)
+}
+
+// countPhrase counts occurrences of the token sequence phrase in words.
+func countPhrase(words, phrase []string) int {
+	n := 0
+	for i := 0; i+len(phrase) <= len(words); i++ {
+		match := true
+		for j := range phrase {
+			if words[i+j] != phrase[j] {
+				match = false
+				break
+			}
+		}
+		if match {
+			n++
+		}
+	}
+	return n
+}
+
+// unknownPlaceholders returns the distinct tokens in found that are not in known, sorted.
+func unknownPlaceholders(found, known []string) []string {
+	seen := map[string]bool{}
+	for _, k := range known {
+		seen[k] = true
+	}
+	out := []string{}
+	for _, s := range found {
+		if !seen[s] {
+			seen[s] = true
+			out = append(out, s)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+func itoa(n int) string { return strconv.Itoa(n) }
+
+func ftoa(f float64) string { return strconv.FormatFloat(f, 'f', 2, 64) }
@@ -0,0 +1,30 @@
+package slug
+
+import (
+	"strings"
+	"unicode"
+
+	"landing/backend/internal/textnorm"
+)
+
+// Make converts arbitrary input into a lowercase, hyphen-separated slug.
+// Letters and digits of any script are kept (so Persian slugs stay readable)
+// after textnorm normalization, so Arabic and Persian spellings of a word give
+// the same slug; every other run of characters, including half-spaces,
+// collapses into a single hyphen.
+func Make(in string) string {
+	var b strings.Builder
+	pendingDash := false
+	for _, r := range strings.ToLower(textnorm.Normalize(strings.TrimSpace(in))) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if pendingDash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			pendingDash = false
+			b.WriteRune(r)
+			continue
+		}
+		pendingDash = true
+	}
+	return b.String()
+}
@@ -0,0 +1,35 @@
+package slug
+
+import "testing"
+
+func TestMake(t *testing.T) {
+	cases := []struct {
+		in, want string
+	}{
+		{"هوش مصنوعی", "هوش-مصنوعی"},
+		{"AI & ML", "ai-ml"},
+		{"  Go Fiber!!  ", "go-fiber"},
+		// Arabic letter forms and Persian digits normalize.
+		{"علي", "علی"},
+		{"سال ۱۴۰۲", "سال-1402"},
+		// Half-spaces become hyphens.
+		{"می\u200cخواهم", "می-خواهم"},
+		{"Café Über", "café-über"},
+		{"---", ""},
+		{"", ""},
+	}
+	for _, tc := range cases {
+		if got := Make(tc.in); got != tc.want {
+			t.Errorf("Make(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestMakeIsIdempotent(t *testing.T) {
+	for _, s := range []string{"هوش مصنوعی", "علي ۱۲", "AI & ML"} {
+		once := Make(s)
+		if twice := Make(once); twice != once {
+			t.Errorf("Make(Make(%q)) = %q, want %q", s, twice, once)
+		}
+	}
+}
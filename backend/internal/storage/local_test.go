@@ -0,0 +1,80 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"landing/backend/internal/config"
+)
+
+func testConfig() config.Config {
+	return config.Config{MediaStorage: "local", MediaPublicURL: "/media"}
+}
+
+func TestLocalPutURLDelete(t *testing.T) {
+	dir := t.TempDir()
+	l, err := NewLocal(filepath.Join(dir, "media"), "/media/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	key := "2025/08/abc.jpg"
+	if err := l.Put(ctx, key, strings.NewReader("image"), 5, "image/jpeg"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	got, err := os.ReadFile(filepath.Join(dir, "media", "2025", "08", "abc.jpg"))
+	if err != nil || string(got) != "image" {
+		t.Fatalf("stored object = %q, %v; want %q", got, err, "image")
+	}
+	leftovers, _ := filepath.Glob(filepath.Join(dir, "media", "2025", "08", ".upload-*"))
+	if len(leftovers) > 0 {
+		t.Errorf("temp files left behind: %v", leftovers)
+	}
+	if u := l.URL(key); u != "/media/2025/08/abc.jpg" {
+		t.Errorf("URL = %q", u)
+	}
+
+	if err := l.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "media", "2025", "08", "abc.jpg")); !os.IsNotExist(err) {
+		t.Errorf("object still exists after Delete: %v", err)
+	}
+	if err := l.Delete(ctx, key); err != nil {
+		t.Errorf("Delete of a missing object = %v, want nil", err)
+	}
+}
+
+func TestLocalRejectsEscapingKeys(t *testing.T) {
+	l, err := NewLocal(t.TempDir(), "/media")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"", "/", "../secret", "a/../../b", ".."} {
+		if err := l.Put(context.Background(), key, strings.NewReader("x"), 1, "text/plain"); err == nil {
+			t.Errorf("Put(%q) succeeded, want an error", key)
+		}
+		if err := l.Delete(context.Background(), key); err == nil {
+			t.Errorf("Delete(%q) succeeded, want an error", key)
+		}
+	}
+}
+
+func TestNewSelectsBackend(t *testing.T) {
+	cfg := testConfig()
+	cfg.MediaDir = t.TempDir()
+	s, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s.(*Local); !ok {
+		t.Errorf("New with MEDIA_STORAGE=%q = %T, want *Local", cfg.MediaStorage, s)
+	}
+	cfg.MediaStorage = "ftp"
+	if _, err := New(cfg); err == nil {
+		t.Error("New with an unknown backend succeeded")
+	}
+}
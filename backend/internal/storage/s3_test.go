@@ -0,0 +1,153 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/minio/minio-go/v7"
+
+	"landing/backend/internal/config"
+)
+
+// fakeS3 is a minimal S3 endpoint that keeps objects in memory.
+type fakeS3 struct {
+	mu          sync.Mutex
+	objects     map[string][]byte
+	contentType map[string]string
+}
+
+func newFakeS3() *fakeS3 {
+	return &fakeS3{objects: map[string][]byte{}, contentType: map[string]string{}}
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		f.objects[r.URL.Path] = body
+		f.contentType[r.URL.Path] = r.Header.Get("Content-Type")
+		w.Header().Set("ETag", `"d41d8cd98f00b204e9800998ecf8427e"`)
+		w.WriteHeader(http.StatusOK)
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+func TestS3AgainstFakeEndpoint(t *testing.T) {
+	fake := newFakeS3()
+	srv := httptest.NewServer(fake)
+	defer srv.Close()
+
+	cfg := testConfig()
+	cfg.MediaStorage = "s3"
+	cfg.MediaPublicURL = ""
+	cfg.S3Endpoint = strings.TrimPrefix(srv.URL, "http://")
+	cfg.S3Region = "us-east-1"
+	cfg.S3Bucket = "media"
+	cfg.S3AccessKey = "access"
+	cfg.S3SecretKey = "secret"
+	s, err := New(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	data := []byte("png bytes")
+	if err := s.Put(ctx, "2025/08/a.png", bytes.NewReader(data), int64(len(data)), "image/png"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	fake.mu.Lock()
+	body, ok := fake.objects["/media/2025/08/a.png"]
+	ct := fake.contentType["/media/2025/08/a.png"]
+	fake.mu.Unlock()
+	// Over plain HTTP the body may be aws-chunked; the payload is in it either way.
+	if !ok || !bytes.Contains(body, data) {
+		t.Fatalf("object not stored at /media/2025/08/a.png: %q", body)
+	}
+	if ct != "image/png" {
+		t.Errorf("Content-Type = %q, want image/png", ct)
+	}
+	if u, want := s.URL("2025/08/a.png"), srv.URL+"/media/2025/08/a.png"; u != want {
+		t.Errorf("URL = %q, want %q", u, want)
+	}
+
+	if err := s.Delete(ctx, "2025/08/a.png"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	fake.mu.Lock()
+	_, ok = fake.objects["/media/2025/08/a.png"]
+	fake.mu.Unlock()
+	if ok {
+		t.Error("object still stored after Delete")
+	}
+}
+
+func TestNewS3RequiresEndpointAndBucket(t *testing.T) {
+	if _, err := NewS3(config.Config{S3Bucket: "media"}); err == nil {
+		t.Error("NewS3 without S3_ENDPOINT succeeded")
+	}
+	if _, err := NewS3(config.Config{S3Endpoint: "localhost:9000"}); err == nil {
+		t.Error("NewS3 without S3_BUCKET succeeded")
+	}
+}
+
+// TestS3AgainstMinIO round-trips an object through a real S3-compatible
+// server. It runs only when S3_TEST_ENDPOINT is set, e.g. for a local MinIO:
+//
+//	S3_TEST_ENDPOINT=localhost:9000 S3_TEST_BUCKET=media \
+//	S3_TEST_ACCESS_KEY=minioadmin S3_TEST_SECRET_KEY=minioadmin go test ./internal/storage
+func TestS3AgainstMinIO(t *testing.T) {
+	endpoint := os.Getenv("S3_TEST_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("S3_TEST_ENDPOINT not set")
+	}
+	cfg := config.Config{
+		MediaStorage: "s3",
+		S3Endpoint:   endpoint,
+		S3Region:     os.Getenv("S3_TEST_REGION"),
+		S3Bucket:     os.Getenv("S3_TEST_BUCKET"),
+		S3AccessKey:  os.Getenv("S3_TEST_ACCESS_KEY"),
+		S3SecretKey:  os.Getenv("S3_TEST_SECRET_KEY"),
+		S3UseSSL:     os.Getenv("S3_TEST_USE_SSL") == "true",
+	}
+	s, err := NewS3(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	key := "storage-test/" + time.Now().UTC().Format("20060102T150405.000000000") + ".txt"
+	data := []byte("hello from the storage test")
+	if err := s.Put(ctx, key, bytes.NewReader(data), int64(len(data)), "text/plain"); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+	obj, err := s.client.GetObject(ctx, s.bucket, key, minio.GetObjectOptions{})
+	if err != nil {
+		t.Fatalf("GetObject: %v", err)
+	}
+	got, err := io.ReadAll(obj)
+	_ = obj.Close()
+	if err != nil || !bytes.Equal(got, data) {
+		t.Fatalf("read back %q, %v; want %q", got, err, data)
+	}
+	if err := s.Delete(ctx, key); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if _, err := s.client.StatObject(ctx, s.bucket, key, minio.StatObjectOptions{}); err == nil {
+		t.Error("object still exists after Delete")
+	}
+}
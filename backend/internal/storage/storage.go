@@ -0,0 +1,36 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"landing/backend/internal/config"
+)
+
+// Storage persists uploaded media objects and resolves their public URLs.
+type Storage interface {
+	// Put stores the object under key, reading exactly size bytes from r.
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Delete removes the object stored under key. Missing objects are not an error.
+	Delete(ctx context.Context, key string) error
+	// URL returns the public URL of the object stored under key.
+	URL(key string) string
+}
+
+// New builds the Storage selected by cfg.MediaStorage ("local" or "s3").
+func New(cfg config.Config) (Storage, error) {
+	switch strings.ToLower(cfg.MediaStorage) {
+	case "", "local":
+		return NewLocal(cfg.MediaDir, cfg.MediaPublicURL)
+	case "s3":
+		return NewS3(cfg)
+	default:
+		return nil, fmt.Errorf("unknown MEDIA_STORAGE %q (want local or s3)", cfg.MediaStorage)
+	}
+}
+
+func joinURL(base, key string) string {
+	return strings.TrimRight(base, "/") + "/" + strings.TrimLeft(key, "/")
+}
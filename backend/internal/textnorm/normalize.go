@@ -0,0 +1,90 @@
+// Package textnorm normalizes and tokenizes Persian (and mixed Persian/English)
+// text so that spelling variants compare equal: Arabic and Persian letter
+// forms, diacritics, digits and the half-space (ZWNJ) used inside Persian words.
+package textnorm
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ZWNJ is the zero-width non-joiner, the Persian "half-space" that separates
+// the parts of a word such as "می‌خواهم" or "کتاب‌ها" without a visible space.
+const ZWNJ = '\u200c'
+
+const (
+	zwj        = '\u200d' // zero-width joiner
+	tatweel    = '\u0640' // kashida, stretches letters for justification
+	softHyphen = '\u00ad'
+)
+
+// letters maps Arabic letter forms to their Persian equivalents.
+var letters = map[rune]rune{
+	'ي': 'ی', // Arabic yeh
+	'ى': 'ی', // alef maksura
+	'ك': 'ک', // Arabic kaf
+	'ۀ': 'ه', // heh with yeh above
+	'ة': 'ه', // teh marbuta
+	'أ': 'ا',
+	'إ': 'ا',
+	'ٱ': 'ا',
+	'ؤ': 'و',
+}
+
+// Normalize returns s with Persian letter forms unified, diacritics (harakat)
+// and tatweel removed, Persian and Arabic-Indic digits converted to ASCII, and
+// half-spaces cleaned up: ZWJ and soft hyphens are dropped, repeated ZWNJs
+// collapse to one, and ZWNJs next to spaces or at the ends of s are removed.
+func Normalize(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	pendingZWNJ := false
+	for _, r := range s {
+		switch {
+		case r == ZWNJ:
+			pendingZWNJ = b.Len() > 0
+			continue
+		case r == zwj || r == tatweel || r == softHyphen:
+			continue
+		case isHaraka(r):
+			continue
+		case r >= '۰' && r <= '۹':
+			r = '0' + (r - '۰')
+		case r >= '٠' && r <= '٩':
+			r = '0' + (r - '٠')
+		default:
+			if m, ok := letters[r]; ok {
+				r = m
+			}
+		}
+		if pendingZWNJ && !unicode.IsSpace(r) {
+			b.WriteRune(ZWNJ)
+		}
+		pendingZWNJ = false
+		b.WriteRune(r)
+	}
+	return trimZWNJAroundSpace(b.String())
+}
+
+// trimZWNJAroundSpace drops a ZWNJ that directly follows whitespace.
+func trimZWNJAroundSpace(s string) string {
+	if !strings.ContainsRune(s, ZWNJ) {
+		return s
+	}
+	var b strings.Builder
+	prevSpace := true
+	for _, r := range s {
+		if r == ZWNJ && prevSpace {
+			continue
+		}
+		prevSpace = unicode.IsSpace(r)
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// isHaraka reports whether r is an Arabic-script diacritic (fatha, kasra,
+// damma, tanwin, shadda, sukun, superscript alef, hamza above/below).
+func isHaraka(r rune) bool {
+	return (r >= '\u064b' && r <= '\u0655') || r == '\u0670'
+}
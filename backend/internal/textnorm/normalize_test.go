@@ -0,0 +1,41 @@
+package textnorm
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	cases := []struct {
+		name, in, want string
+	}{
+		{"arabic yeh and kaf", "علي كتاب", "علی کتاب"},
+		{"alef maksura", "موسى", "موسی"},
+		{"heh and teh marbuta", "خانۀ مدرسة", "خانه مدرسه"},
+		{"hamza forms", "أحمد إيران مؤمن", "احمد ایران مومن"},
+		{"persian digits", "سال ۱۴۰۲", "سال 1402"},
+		{"arabic-indic digits", "٤٥٦", "456"},
+		{"diacritics", "مُحَمَّد", "محمد"},
+		{"tatweel", "خــوب", "خوب"},
+		{"zwnj kept inside a word", "می\u200cخواهم", "می\u200cخواهم"},
+		{"repeated zwnj collapses", "می\u200c\u200cخواهم", "می\u200cخواهم"},
+		{"zwnj at the ends", "\u200cسلام\u200c", "سلام"},
+		{"zwnj after a space", "کتاب \u200cها", "کتاب ها"},
+		{"zwnj before a space", "کتاب\u200c ها", "کتاب ها"},
+		{"zwj dropped", "zw\u200dj", "zwj"},
+		{"soft hyphen dropped", "soft\u00adhyphen", "softhyphen"},
+		{"latin untouched", "Go Fiber 2", "Go Fiber 2"},
+		{"empty", "", ""},
+	}
+	for _, tc := range cases {
+		if got := Normalize(tc.in); got != tc.want {
+			t.Errorf("%s: Normalize(%q) = %q, want %q", tc.name, tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestNormalizeIsIdempotent(t *testing.T) {
+	for _, s := range []string{"علي كتاب\u200cها ۱۲", "می\u200c\u200cخواهم", "مُحَمَّد"} {
+		once := Normalize(s)
+		if twice := Normalize(once); twice != once {
+			t.Errorf("Normalize(Normalize(%q)) = %q, want %q", s, twice, once)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package textnorm
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// minStemRunes is the shortest stem a suffix or prefix may be removed down to.
+const minStemRunes = 2
+
+// persianSuffixes are removed longest first: plural, comparative and
+// superlative endings and the indefinite/possessive clitics, with or without
+// the half-space that usually precedes them.
+var persianSuffixes = []string{
+	"\u200cهایی", "\u200cهای", "\u200cها", "هایی", "های", "ها",
+	"\u200cترین", "ترین", "\u200cتر",
+	"\u200cشان", "\u200cتان", "\u200cمان", "\u200cاش", "\u200cای", "\u200cام", "\u200cات",
+}
+
+// persianPrefixes are verbal prefixes, always written with a half-space.
+var persianPrefixes = []string{"نمی\u200c", "می\u200c"}
+
+// Stem is a light stemmer: it strips common Persian affixes (plural "ها",
+// comparative "تر"/"ترین", clitics after a half-space, the "می‌" verb prefix)
+// and English plural "s", so "کتاب‌ها" and "کتاب" or "posts" and "post" share
+// a term. It never reduces a word below two letters.
+func Stem(tok string) string {
+	for _, p := range persianPrefixes {
+		if rest, ok := strings.CutPrefix(tok, p); ok && utf8.RuneCountInString(rest) >= minStemRunes {
+			tok = rest
+			break
+		}
+	}
+	for _, suf := range persianSuffixes {
+		rest, ok := strings.CutSuffix(tok, suf)
+		if !ok {
+			continue
+		}
+		// Without a half-space the suffix may belong to the word itself
+		// ("بهترین"), so demand a longer stem.
+		min := minStemRunes
+		if !strings.HasPrefix(suf, string(ZWNJ)) {
+			min++
+		}
+		if utf8.RuneCountInString(rest) >= min {
+			return strings.TrimSuffix(rest, string(ZWNJ))
+		}
+	}
+	return stemEnglish(tok)
+}
+
+func stemEnglish(tok string) string {
+	if len(tok) < 4 || !isASCII(tok) {
+		return tok
+	}
+	switch {
+	case strings.HasSuffix(tok, "ies"):
+		return tok[:len(tok)-3] + "y"
+	case strings.HasSuffix(tok, "ss"), strings.HasSuffix(tok, "us"):
+		return tok
+	case strings.HasSuffix(tok, "s"):
+		return tok[:len(tok)-1]
+	}
+	return tok
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
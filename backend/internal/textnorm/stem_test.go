@@ -0,0 +1,33 @@
+package textnorm
+
+import "testing"
+
+func TestStem(t *testing.T) {
+	cases := []struct {
+		in, want string
+	}{
+		{"کتاب\u200cها", "کتاب"},
+		{"کتابها", "کتاب"},
+		{"کتاب\u200cهایی", "کتاب"},
+		{"بزرگ\u200cترین", "بزرگ"},
+		{"بزرگترین", "بزرگ"},
+		// Without a half-space the suffix may be part of the word.
+		{"بهترین", "بهترین"},
+		{"خانه\u200cام", "خانه"},
+		{"می\u200cخواهم", "خواهم"},
+		{"نمی\u200cدانم", "دانم"},
+		// Never below two letters.
+		{"ها", "ها"},
+		{"می\u200cا", "می\u200cا"},
+		{"posts", "post"},
+		{"stories", "story"},
+		{"class", "class"},
+		{"status", "status"},
+		{"go", "go"},
+	}
+	for _, tc := range cases {
+		if got := Stem(tc.in); got != tc.want {
+			t.Errorf("Stem(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package textnorm
+
+import "strings"
+
+// stopwords are frequent Persian and English function words that carry no
+// topic. Entries are normalized, like the tokens they are compared with.
+var stopwords = toSet(`
+و در به از که این را با است برای آن یک خود تا کرد بر هم نیز شد می ها های شده
+کند کنند کنید کنیم کرده کردن باید اما یا اگر هر ما شما او آنها ایشان من تو بود
+بودن باشد باشند دارد دارند داشت داشته شود شوند نیست هست هستند همه چه چون پس بین
+روی زیر پیش بعد قبل دیگر مانند مثل وی ای اینکه آنکه چنین چنان همین همان بسیار
+خیلی فقط تنها حتی البته هنوز اکنون ولی زیرا چرا کجا چگونه چطور نه بله آیا طور
+توسط درباره سوی میان ضمن طی جز غیر بدون علاوه همچنین شان مان تان اش ام دو سه
+بوده گفت وجود برخی چند
+the a an and or of to in on for is are was were be been with as by at from this
+that it its not but can will you we they i he she our your their has have had do
+does did so if than then there these those which who what when where how also more
+most into about
+` +
+	// Verbs with the "می" prefix, which is joined by a half-space.
+	"می\u200cشود می\u200cکند می\u200cکنند می\u200cتوان می\u200cتواند می\u200cتوانید")
+
+func toSet(words string) map[string]bool {
+	set := map[string]bool{}
+	for _, w := range strings.Fields(words) {
+		set[Normalize(w)] = true
+	}
+	return set
+}
+
+// IsStopword reports whether a normalized, lowercased token is a stopword.
+func IsStopword(tok string) bool { return stopwords[tok] }
@@ -0,0 +1,46 @@
+package textnorm
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Tokenize normalizes and lowercases s and splits it into words. Letters,
+// digits and half-spaces form words, so "می‌خواهم" is one token; everything
+// else separates tokens.
+func Tokenize(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(Normalize(s)), func(r rune) bool {
+		return !isWordRune(r)
+	})
+}
+
+// Terms returns the index terms of s: tokens without stopwords, numbers or
+// single letters, reduced with Stem.
+func Terms(s string) []string {
+	var out []string
+	for _, t := range Tokenize(s) {
+		if IsContent(t) {
+			out = append(out, Stem(t))
+		}
+	}
+	return out
+}
+
+// IsContent reports whether a token carries meaning: it is not a stopword, a
+// number or a single letter.
+func IsContent(tok string) bool {
+	if utf8.RuneCountInString(tok) < 2 || IsStopword(tok) {
+		return false
+	}
+	for _, r := range tok {
+		if !unicode.IsDigit(r) {
+			return true
+		}
+	}
+	return false
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r) || r == ZWNJ
+}
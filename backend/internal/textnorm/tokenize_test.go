@@ -0,0 +1,53 @@
+package textnorm
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	cases := []struct {
+		in   string
+		want []string
+	}{
+		{"Go و RAG: می\u200cخواهم ۳ کتاب\u200cها!", []string{"go", "و", "rag", "می\u200cخواهم", "3", "کتاب\u200cها"}},
+		{"علي، كتاب؟", []string{"علی", "کتاب"}},
+		{"  ", []string{}},
+	}
+	for _, tc := range cases {
+		if got := Tokenize(tc.in); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("Tokenize(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestTerms(t *testing.T) {
+	cases := []struct {
+		in   string
+		want []string
+	}{
+		// Stopwords, numbers and single letters are dropped; the rest is stemmed.
+		{"Go و RAG: می\u200cخواهم ۳ کتاب\u200cها را در posts", []string{"go", "rag", "خواهم", "کتاب", "post"}},
+		// Arabic and Persian spellings give the same terms.
+		{"كتابها علي", []string{"کتاب", "علی"}},
+		{"the a x 2025", nil},
+	}
+	for _, tc := range cases {
+		if got := Terms(tc.in); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("Terms(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestIsStopword(t *testing.T) {
+	for _, w := range []string{"و", "در", "می\u200cشود", "the"} {
+		if !IsStopword(w) {
+			t.Errorf("IsStopword(%q) = false", w)
+		}
+	}
+	for _, w := range []string{"کتاب", "golang"} {
+		if IsStopword(w) {
+			t.Errorf("IsStopword(%q) = true", w)
+		}
+	}
+}
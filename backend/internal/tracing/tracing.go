@@ -0,0 +1,77 @@
+// Package tracing sets up OpenTelemetry tracing: the tracer provider and
+// exporter, W3C trace context propagation and the per-request span.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"landing/backend/internal/config"
+)
+
+// Exporters accepted by config.TracingExporter.
+const (
+	ExporterNone   = "none"
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+)
+
+// instrumentation is the name spans of this module are reported under.
+const instrumentation = "landing/backend"
+
+// Tracer returns the tracer for spans of this module. Until Setup installs a
+// provider, spans are no-ops.
+func Tracer() trace.Tracer { return otel.Tracer(instrumentation) }
+
+// Setup installs the global tracer provider for cfg.TracingExporter:
+// "otlp" sends spans over OTLP/HTTP to the collector configured by the
+// standard OTEL_EXPORTER_OTLP_* variables, "stdout" prints them (useful in
+// tests and local debugging) and "none" disables tracing. Trace context is
+// propagated in W3C traceparent/tracestate headers either way. The returned
+// function flushes pending spans and must be called on shutdown.
+func Setup(ctx context.Context, cfg config.Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exp sdktrace.SpanExporter
+	var err error
+	switch cfg.TracingExporter {
+	case "", ExporterNone:
+		return func(context.Context) error { return nil }, nil
+	case ExporterOTLP:
+		exp, err = otlptracehttp.New(ctx)
+	case ExporterStdout:
+		exp, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout), stdouttrace.WithPrettyPrint())
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q", cfg.TracingExporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("tracing exporter: %w", err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		semconv.ServiceName(cfg.AppName),
+		semconv.ServiceVersion(cfg.VersionString()),
+		semconv.DeploymentEnvironment(cfg.Env),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("tracing resource: %w", err)
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exp),
+		sdktrace.WithResource(res),
+		// Follow the caller's sampling decision (e.g. the SvelteKit proxy's).
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.TracingSampleRatio))),
+	)
+	otel.SetTracerProvider(tp)
+	return tp.Shutdown, nil
+}
@@ -0,0 +1,258 @@
+// Package validate checks request structs against the same struct tags swag
+// reads to document them, so the OpenAPI spec and the enforced rules cannot
+// drift apart:
+//
+//	validate:"required,trim"  required (non-blank / non-empty / non-nil); trim strings in place first
+//	validate:"min=N,max=N"    slice and map lengths (rendered as minItems/maxItems)
+//	minLength, maxLength      string length in characters (runes)
+//	pattern                   regular expression a non-empty string must match
+//	enums                     comma-separated allowed values (case-insensitive)
+//	minimum, maximum          integer bounds
+//
+// swag does not render pattern, so fields using it describe the format in
+// their doc comment. Fields are named by their JSON (or query/form parameter)
+// names. Pointer fields are checked when set.
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"landing/backend/internal/apperr"
+)
+
+// rule holds the parsed tags of one struct field.
+type rule struct {
+	index    int
+	name     string
+	required bool
+	trim     bool
+
+	minLen, maxLen     int // -1 when unset
+	pattern            *regexp.Regexp
+	enums              []string
+	min, max           *int64
+	minItems, maxItems int // -1 when unset
+}
+
+var rulesCache sync.Map // reflect.Type -> []rule
+
+// Struct checks the struct v points to and returns the problems of every
+// invalid field, or nil when it is valid. Strings tagged trim are trimmed in
+// place, so call it before using the values. It panics if v is not a pointer
+// to a struct or a tag is malformed, both programming errors.
+func Struct(v any) []apperr.FieldError {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		panic(fmt.Sprintf("validate: %T is not a pointer to a struct", v))
+	}
+	rv = rv.Elem()
+	var errs []apperr.FieldError
+	for _, r := range rulesFor(rv.Type()) {
+		errs = append(errs, r.check(rv.Field(r.index))...)
+	}
+	return errs
+}
+
+// Request checks v with Struct and returns a validation_failed error listing
+// every invalid field, or nil.
+func Request(v any) error {
+	if errs := Struct(v); len(errs) > 0 {
+		return apperr.Validation(Summary(errs), errs...)
+	}
+	return nil
+}
+
+// Summary joins the messages of errs into one detail line.
+func Summary(errs []apperr.FieldError) string {
+	msgs := make([]string, len(errs))
+	for i, e := range errs {
+		msgs[i] = e.Message
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (r rule) check(f reflect.Value) []apperr.FieldError {
+	if f.Kind() == reflect.Pointer {
+		if f.IsNil() {
+			if r.required {
+				return r.fail(apperr.FieldRequired, "%s is required", r.name)
+			}
+			return nil
+		}
+		f = f.Elem()
+	}
+	switch f.Kind() {
+	case reflect.String:
+		return r.checkString(f)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return r.checkInt(f.Int())
+	case reflect.Slice, reflect.Map:
+		return r.checkLen(f.Len())
+	}
+	return nil
+}
+
+func (r rule) checkString(f reflect.Value) []apperr.FieldError {
+	s := f.String()
+	if r.trim && f.CanSet() {
+		s = strings.TrimSpace(s)
+		f.SetString(s)
+	}
+	if strings.TrimSpace(s) == "" {
+		if r.required {
+			return r.fail(apperr.FieldRequired, "%s is required", r.name)
+		}
+		return nil
+	}
+	n := utf8.RuneCountInString(s)
+	if r.maxLen >= 0 && n > r.maxLen {
+		return r.fail(apperr.FieldTooLong, "%s must be at most %d characters", r.name, r.maxLen)
+	}
+	if r.minLen >= 0 && n < r.minLen {
+		return r.fail(apperr.FieldTooShort, "%s must be at least %d characters", r.name, r.minLen)
+	}
+	if r.pattern != nil && !r.pattern.MatchString(s) {
+		return r.fail(apperr.FieldInvalid, "%s has an invalid format (must match %s)", r.name, r.pattern)
+	}
+	if len(r.enums) > 0 {
+		for _, e := range r.enums {
+			if strings.EqualFold(s, e) {
+				return nil
+			}
+		}
+		return r.fail(apperr.FieldNotAllowed, "%s must be one of %s", r.name, strings.Join(r.enums, ", "))
+	}
+	return nil
+}
+
+func (r rule) checkInt(n int64) []apperr.FieldError {
+	if (r.min != nil && n < *r.min) || (r.max != nil && n > *r.max) {
+		switch {
+		case r.min != nil && r.max != nil:
+			return r.fail(apperr.FieldOutOfRange, "%s must be between %d and %d", r.name, *r.min, *r.max)
+		case r.min != nil:
+			return r.fail(apperr.FieldOutOfRange, "%s must be at least %d", r.name, *r.min)
+		default:
+			return r.fail(apperr.FieldOutOfRange, "%s must be at most %d", r.name, *r.max)
+		}
+	}
+	return nil
+}
+
+func (r rule) checkLen(n int) []apperr.FieldError {
+	if n == 0 && r.required {
+		return r.fail(apperr.FieldRequired, "%s is required", r.name)
+	}
+	if r.maxItems >= 0 && n > r.maxItems {
+		return r.fail(apperr.FieldTooLong, "%s must have at most %d items", r.name, r.maxItems)
+	}
+	if r.minItems >= 0 && n < r.minItems {
+		return r.fail(apperr.FieldTooShort, "%s must have at least %d items", r.name, r.minItems)
+	}
+	return nil
+}
+
+func (r rule) fail(code, format string, args ...any) []apperr.FieldError {
+	return []apperr.FieldError{apperr.Field(r.name, code, fmt.Sprintf(format, args...))}
+}
+
+// rulesFor returns the parsed rules of t, caching them per type.
+func rulesFor(t reflect.Type) []rule {
+	if rs, ok := rulesCache.Load(t); ok {
+		return rs.([]rule)
+	}
+	var rs []rule
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		r, ok := parseRule(sf)
+		if !ok {
+			continue
+		}
+		r.index = i
+		rs = append(rs, r)
+	}
+	rulesCache.Store(t, rs)
+	return rs
+}
+
+func parseRule(sf reflect.StructField) (rule, bool) {
+	r := rule{name: fieldName(sf), minLen: -1, maxLen: -1, minItems: -1, maxItems: -1}
+	tagged := false
+	for _, opt := range strings.Split(sf.Tag.Get("validate"), ",") {
+		key, v, _ := strings.Cut(strings.TrimSpace(opt), "=")
+		switch key {
+		case "":
+		case "required":
+			r.required, tagged = true, true
+		case "trim":
+			r.trim, tagged = true, true
+		case "min", "max":
+			if k := indirect(sf.Type).Kind(); k != reflect.Slice && k != reflect.Map {
+				panic(fmt.Sprintf("validate: %s: %s applies to slices and maps, not %s", sf.Name, key, k))
+			}
+			if key == "min" {
+				r.minItems = mustInt(sf, key, v)
+			} else {
+				r.maxItems = mustInt(sf, key, v)
+			}
+			tagged = true
+		default:
+			panic(fmt.Sprintf("validate: %s: unknown option %q", sf.Name, opt))
+		}
+	}
+	intTag := func(key string, dst *int) {
+		if v, ok := sf.Tag.Lookup(key); ok {
+			*dst, tagged = mustInt(sf, key, v), true
+		}
+	}
+	intTag("minLength", &r.minLen)
+	intTag("maxLength", &r.maxLen)
+	for key, dst := range map[string]**int64{"minimum": &r.min, "maximum": &r.max} {
+		if v, ok := sf.Tag.Lookup(key); ok {
+			n := int64(mustInt(sf, key, v))
+			*dst, tagged = &n, true
+		}
+	}
+	if p, ok := sf.Tag.Lookup("pattern"); ok {
+		r.pattern, tagged = regexp.MustCompile(p), true
+	}
+	if e, ok := sf.Tag.Lookup("enums"); ok {
+		r.enums, tagged = strings.Split(e, ","), true
+	}
+	return r, tagged
+}
+
+// fieldName returns the name clients use for sf: its JSON name, or its query
+// or form parameter name.
+func fieldName(sf reflect.StructField) string {
+	for _, key := range []string{"json", "query", "form"} {
+		if name, _, _ := strings.Cut(sf.Tag.Get(key), ","); name != "" && name != "-" {
+			return name
+		}
+	}
+	return sf.Name
+}
+
+func indirect(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Pointer {
+		return t.Elem()
+	}
+	return t
+}
+
+func mustInt(sf reflect.StructField, key, v string) int {
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		panic(fmt.Sprintf("validate: %s: %s must be an integer, got %q", sf.Name, key, v))
+	}
+	return n
+}
@@ -0,0 +1,131 @@
+package validate
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"landing/backend/internal/apperr"
+)
+
+type request struct {
+	Path     string   `json:"path" validate:"required,trim" maxLength:"10" pattern:"^[a-z-]+$"`
+	Title    string   `json:"title,omitempty" validate:"trim" minLength:"3"`
+	Format   string   `json:"format" enums:"html,markdown"`
+	Name     string   `query:"name" maxLength:"3"`
+	K        int      `json:"k" minimum:"0" maximum:"20"`
+	Page     int      `json:"page" minimum:"1"`
+	Offset   *int     `json:"offset" maximum:"100"`
+	Tags     []string `json:"tags" validate:"min=1,max=2"`
+	Author   *int     `json:"author_id" validate:"required"`
+	Untagged string
+}
+
+func intp(n int) *int { return &n }
+
+// valid returns a request that passes every rule.
+func valid() request {
+	return request{Path: "hello", Title: "Hi!", Format: "html", Name: "ab", K: 5, Page: 1,
+		Tags: []string{"go"}, Author: intp(1)}
+}
+
+func TestStruct(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		modify func(*request)
+		want   []apperr.FieldError
+	}{
+		{"valid", func(*request) {}, nil},
+		{"required blank after trim", func(r *request) { r.Path = "   " },
+			[]apperr.FieldError{{Field: "path", Code: apperr.FieldRequired, Message: "path is required"}}},
+		{"required nil pointer", func(r *request) { r.Author = nil },
+			[]apperr.FieldError{{Field: "author_id", Code: apperr.FieldRequired, Message: "author_id is required"}}},
+		{"required empty slice", func(r *request) { r.Tags = nil },
+			[]apperr.FieldError{{Field: "tags", Code: apperr.FieldTooShort, Message: "tags must have at least 1 items"}}},
+		{"maxLength counts runes", func(r *request) { r.Path = "abcdefghijk" },
+			[]apperr.FieldError{{Field: "path", Code: apperr.FieldTooLong, Message: "path must be at most 10 characters"}}},
+		{"minLength", func(r *request) { r.Title = " ab " },
+			[]apperr.FieldError{{Field: "title", Code: apperr.FieldTooShort, Message: "title must be at least 3 characters"}}},
+		{"optional empty string skips its rules", func(r *request) { r.Title = "" }, nil},
+		{"pattern", func(r *request) { r.Path = "Hello" },
+			[]apperr.FieldError{{Field: "path", Code: apperr.FieldInvalid, Message: "path has an invalid format (must match ^[a-z-]+$)"}}},
+		{"enums are case-insensitive", func(r *request) { r.Format = "Markdown" }, nil},
+		{"enums", func(r *request) { r.Format = "pdf" },
+			[]apperr.FieldError{{Field: "format", Code: apperr.FieldNotAllowed, Message: "format must be one of html, markdown"}}},
+		{"query name", func(r *request) { r.Name = "long" },
+			[]apperr.FieldError{{Field: "name", Code: apperr.FieldTooLong, Message: "name must be at most 3 characters"}}},
+		{"between", func(r *request) { r.K = 21 },
+			[]apperr.FieldError{{Field: "k", Code: apperr.FieldOutOfRange, Message: "k must be between 0 and 20"}}},
+		{"minimum", func(r *request) { r.Page = 0 },
+			[]apperr.FieldError{{Field: "page", Code: apperr.FieldOutOfRange, Message: "page must be at least 1"}}},
+		{"maximum on a set pointer", func(r *request) { r.Offset = intp(101) },
+			[]apperr.FieldError{{Field: "offset", Code: apperr.FieldOutOfRange, Message: "offset must be at most 100"}}},
+		{"max items", func(r *request) { r.Tags = []string{"a", "b", "c"} },
+			[]apperr.FieldError{{Field: "tags", Code: apperr.FieldTooLong, Message: "tags must have at most 2 items"}}},
+		// Every invalid field is reported, in declaration order, one problem each.
+		{"aggregates per field", func(r *request) {
+			r.Path, r.Format, r.K, r.Author = "", "pdf", -1, nil
+		}, []apperr.FieldError{
+			{Field: "path", Code: apperr.FieldRequired, Message: "path is required"},
+			{Field: "format", Code: apperr.FieldNotAllowed, Message: "format must be one of html, markdown"},
+			{Field: "k", Code: apperr.FieldOutOfRange, Message: "k must be between 0 and 20"},
+			{Field: "author_id", Code: apperr.FieldRequired, Message: "author_id is required"},
+		}},
+	} {
+		r := valid()
+		tc.modify(&r)
+		if got := Struct(&r); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%s: Struct = %+v\nwant %+v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestStructTrims(t *testing.T) {
+	r := valid()
+	r.Path, r.Title, r.Name = "  hello\n", " Hi! ", " ab"
+	if errs := Struct(&r); errs != nil {
+		t.Fatalf("Struct = %+v", errs)
+	}
+	if r.Path != "hello" || r.Title != "Hi!" {
+		t.Errorf("trim fields = %q, %q; want them trimmed in place", r.Path, r.Title)
+	}
+	if r.Name != " ab" {
+		t.Errorf("name = %q; fields without trim must be left alone", r.Name)
+	}
+}
+
+func TestRequest(t *testing.T) {
+	r := valid()
+	if err := Request(&r); err != nil {
+		t.Fatalf("Request(valid) = %v", err)
+	}
+	r.Path, r.K = "", 99
+	var e *apperr.Error
+	if err := Request(&r); !errors.As(err, &e) {
+		t.Fatalf("Request = %v, want *apperr.Error", err)
+	}
+	if e.Status != 400 || e.Code != apperr.CodeValidation || len(e.Fields) != 2 {
+		t.Errorf("Request = %+v, want a 400 validation_failed with two fields", e)
+	}
+	if want := "path is required; k must be between 0 and 20"; e.Detail != want {
+		t.Errorf("detail = %q, want %q", e.Detail, want)
+	}
+}
+
+func TestStructPanicsOnMisuse(t *testing.T) {
+	for name, v := range map[string]any{
+		"not a pointer":   request{},
+		"unknown option":  &struct{ A string `validate:"nope"` }{},
+		"min on a string": &struct{ A string `validate:"min=1"` }{},
+		"bad integer":     &struct{ A string `maxLength:"x"` }{},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: Struct did not panic", name)
+				}
+			}()
+			Struct(v)
+		}()
+	}
+}
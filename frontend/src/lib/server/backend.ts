@@ -0,0 +1,58 @@
+import { env } from '$env/dynamic/private';
+
+// Headers forwarded to the backend so conditional GETs (ETag/Last-Modified) keep working.
+const FORWARDED_REQUEST_HEADERS = ['if-none-match', 'if-modified-since'];
+const FORWARDED_RESPONSE_HEADERS = ['content-type', 'etag', 'last-modified', 'cache-control'];
+
+/** Origin of the backend server, derived from BACKEND_API_BASE (without the /api suffix). */
+export function backendOrigin(): string {
+  const apiBase = (env.BACKEND_API_BASE ?? 'http://localhost:8080/api').trim();
+  return apiBase.replace(/\/+$/, '').replace(/\/api$/, '');
+}
+
+function randomHex(bytes: number): string {
+  const buf = crypto.getRandomValues(new Uint8Array(bytes));
+  return Array.from(buf, (b) => b.toString(16).padStart(2, '0')).join('');
+}
+
+/**
+ * W3C trace context headers for a backend call made on behalf of `request`:
+ * the incoming traceparent/tracestate when the browser or an edge proxy sent
+ * one, otherwise a new sampled trace, so the backend's spans share one trace
+ * per page request.
+ */
+export function traceHeaders(request: Request): Record<string, string> {
+  const headers: Record<string, string> = {};
+  const parent = request.headers.get('traceparent');
+  if (parent && /^[0-9a-f]{2}-[0-9a-f]{32}-[0-9a-f]{16}-[0-9a-f]{2}$/.test(parent.trim())) {
+    headers['traceparent'] = parent.trim();
+    const state = request.headers.get('tracestate');
+    if (state) headers['tracestate'] = state;
+  } else {
+    headers['traceparent'] = `00-${randomHex(16)}-${randomHex(8)}-01`;
+  }
+  return headers;
+}
+
+/** Proxies a public, non-API backend document such as /sitemap.xml or /feed.xml. */
+export async function proxyBackendDocument(
+  fetchFn: typeof fetch,
+  request: Request,
+  path: string
+): Promise<Response> {
+  const headers: Record<string, string> = traceHeaders(request);
+  for (const name of FORWARDED_REQUEST_HEADERS) {
+    const value = request.headers.get(name);
+    if (value) headers[name] = value;
+  }
+
+  const res = await fetchFn(`${backendOrigin()}${path}`, { headers });
+
+  const out = new Headers();
+  for (const name of FORWARDED_RESPONSE_HEADERS) {
+    const value = res.headers.get(name);
+    if (value) out.set(name, value);
+  }
+  const body = res.status === 304 ? null : await res.arrayBuffer();
+  return new Response(body, { status: res.status, headers: out });
+}
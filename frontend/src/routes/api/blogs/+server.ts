@@ -1,7 +1,8 @@
 import type { RequestHandler } from './$types';
 import { env } from '$env/dynamic/private';
+import { traceHeaders } from '$lib/server/backend';
 
-export const GET: RequestHandler = async ({ fetch, url }) => {
+export const GET: RequestHandler = async ({ fetch, url, request }) => {
   const backendBase = (env.BACKEND_API_BASE ?? 'http://localhost:8080/api').trim();
   const apiKey = (env.BACKEND_API_KEY ?? '').trim();
 
@@ -9,7 +10,7 @@ export const GET: RequestHandler = async ({ fetch, url }) => {
   const target = `${backendBase}/blogs${qs ? `?${qs}` : ''}`;
 
   const res = await fetch(target, {
-    headers: apiKey ? { 'X-API-Key': apiKey } : undefined
+    headers: { ...traceHeaders(request), ...(apiKey ? { 'X-API-Key': apiKey } : {}) }
   });
 
   const body = await res.text();
@@ -1,14 +1,15 @@
 import type { RequestHandler } from './$types';
 import { env } from '$env/dynamic/private';
+import { traceHeaders } from '$lib/server/backend';
 
-export const GET: RequestHandler = async ({ fetch, params }) => {
+export const GET: RequestHandler = async ({ fetch, params, request }) => {
   const backendBase = (env.BACKEND_API_BASE ?? 'http://localhost:8080/api').trim();
   const apiKey = (env.BACKEND_API_KEY ?? '').trim();
 
   const target = `${backendBase}/blogs/${encodeURIComponent(params.path)}`;
 
   const res = await fetch(target, {
-    headers: apiKey ? { 'X-API-Key': apiKey } : undefined
+    headers: { ...traceHeaders(request), ...(apiKey ? { 'X-API-Key': apiKey } : {}) }
   });
 
   const body = await res.text();
@@ -6,7 +6,9 @@ export type Blog = {
   category: string;
   text: string;
   path: string;
-  // ent typically adds created_at/updated_at if defined; we didn't, so keep minimal
+  // Computed by the backend on save; 0 for posts not yet measured.
+  reading_minutes?: number;
+  excerpt?: string;
 };
 
 export const load: PageLoad = async ({ fetch, url }) => {
@@ -0,0 +1,8 @@
+import type { RequestHandler } from '@sveltejs/kit';
+import { error } from '@sveltejs/kit';
+import { proxyBackendDocument } from '$lib/server/backend';
+
+export const GET: RequestHandler = ({ fetch, request, params }) => {
+  if (!/^\d+$/.test(params.page ?? '')) throw error(404, 'Not found');
+  return proxyBackendDocument(fetch, request, `/sitemap-${params.page}.xml`);
+};
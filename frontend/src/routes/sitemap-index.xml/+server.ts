@@ -0,0 +1,5 @@
+import type { RequestHandler } from '@sveltejs/kit';
+import { proxyBackendDocument } from '$lib/server/backend';
+
+export const GET: RequestHandler = ({ fetch, request }) =>
+  proxyBackendDocument(fetch, request, '/sitemap-index.xml');
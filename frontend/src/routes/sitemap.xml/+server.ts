@@ -1,34 +1,6 @@
 import type { RequestHandler } from '@sveltejs/kit';
+import { proxyBackendDocument } from '$lib/server/backend';
 
-const BASE = 'https://tehranbot.me';
-
-const routes = [
-  '/',
-  '/services',
-  '/contact'
-];
-
-export const GET: RequestHandler = async () => {
-  const urls = routes
-    .map((path) => `${BASE}${path}`)
-    .map(
-      (loc) => `  <url>
-    <loc>${loc}</loc>
-    <changefreq>weekly</changefreq>
-    <priority>0.8</priority>
-  </url>`
-    )
-    .join('\n');
-
-  const body = `<?xml version="1.0" encoding="UTF-8"?>
-<urlset xmlns="http://www.sitemaps.org/schemas/sitemap/0.9">
-${urls}
-</urlset>`;
-
-  return new Response(body, {
-    headers: {
-      'Content-Type': 'application/xml; charset=utf-8',
-      'Cache-Control': 'public, max-age=3600'
-    }
-  });
-};
+// The sitemap (static pages + every blog post) is generated by the backend.
+export const GET: RequestHandler = ({ fetch, request }) =>
+  proxyBackendDocument(fetch, request, '/sitemap.xml');